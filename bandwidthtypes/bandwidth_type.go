@@ -0,0 +1,80 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package bandwidthtypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = (*BandwidthType)(nil)
+
+// BandwidthType is an attribute type that represents a valid non-negative data rate, such as an interface speed or
+// QoS policy rate. Semantic equality logic is defined for BandwidthType, so that rates expressed with differing SI
+// (decimal) or IEC (binary) units, in bits or bytes per second, are considered equal when they normalize to the same
+// number of bits per second.
+//
+// All of the following are semantically equal:
+//   - 1Gbps
+//   - 1000Mbps
+//   - 1G
+//   - 1000000000
+//   - 125MBps
+type BandwidthType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t BandwidthType) String() string {
+	return "bandwidthtypes.BandwidthType"
+}
+
+// ValueType returns the Value type.
+func (t BandwidthType) ValueType(ctx context.Context) attr.Value {
+	return Bandwidth{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t BandwidthType) Equal(o attr.Type) bool {
+	other, ok := o.(BandwidthType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t BandwidthType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return Bandwidth{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t BandwidthType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
@@ -0,0 +1,171 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package bandwidthtypes
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Unit is a data rate unit that a number of bits per second can be formatted with via FormatBitsPerSecond.
+type Unit string
+
+const (
+	BitsPerSecond     Unit = "bps"
+	KilobitsPerSecond Unit = "Kbps"
+	MegabitsPerSecond Unit = "Mbps"
+	GigabitsPerSecond Unit = "Gbps"
+	TerabitsPerSecond Unit = "Tbps"
+	PetabitsPerSecond Unit = "Pbps"
+
+	KibibitsPerSecond Unit = "Kibps"
+	MebibitsPerSecond Unit = "Mibps"
+	GibibitsPerSecond Unit = "Gibps"
+	TebibitsPerSecond Unit = "Tibps"
+	PebibitsPerSecond Unit = "Pibps"
+
+	BytesPerSecond     Unit = "Bps"
+	KilobytesPerSecond Unit = "KBps"
+	MegabytesPerSecond Unit = "MBps"
+	GigabytesPerSecond Unit = "GBps"
+	TerabytesPerSecond Unit = "TBps"
+	PetabytesPerSecond Unit = "PBps"
+
+	KibibytesPerSecond Unit = "KiBps"
+	MebibytesPerSecond Unit = "MiBps"
+	GibibytesPerSecond Unit = "GiBps"
+	TebibytesPerSecond Unit = "TiBps"
+	PebibytesPerSecond Unit = "PiBps"
+)
+
+// canonicalUnits are the units considered by CanonicalBitsPerSecond, ordered from largest to smallest.
+var canonicalUnits = []Unit{
+	PetabitsPerSecond,
+	TerabitsPerSecond,
+	GigabitsPerSecond,
+	MegabitsPerSecond,
+	KilobitsPerSecond,
+}
+
+// unitPrefixes maps lowercased SI (decimal) and IEC (binary) prefixes to their multiplier.
+var unitPrefixes = map[string]*big.Int{
+	"":   big.NewInt(1),
+	"k":  big.NewInt(1e3),
+	"m":  big.NewInt(1e6),
+	"g":  big.NewInt(1e9),
+	"t":  big.NewInt(1e12),
+	"p":  big.NewInt(1e15),
+	"ki": big.NewInt(1 << 10),
+	"mi": big.NewInt(1 << 20),
+	"gi": big.NewInt(1 << 30),
+	"ti": big.NewInt(1 << 40),
+	"pi": big.NewInt(1 << 50),
+}
+
+// unitBits returns the number of bits per second represented by a single unit. Units may be written with a bits
+// suffix (bps, b/s, bit/s), a bytes suffix (Bps, B/s) or no suffix at all, in which case bits are assumed. The
+// SI or IEC prefix is case-insensitive.
+func unitBits(unit string) (*big.Int, bool) {
+	prefix, bitsPerSymbol := unit, int64(1)
+
+	switch {
+	case strings.HasSuffix(unit, "bit/s"):
+		prefix = strings.TrimSuffix(unit, "bit/s")
+	case strings.HasSuffix(unit, "bps"):
+		prefix = strings.TrimSuffix(unit, "bps")
+	case strings.HasSuffix(unit, "b/s"):
+		prefix = strings.TrimSuffix(unit, "b/s")
+	case strings.HasSuffix(unit, "Bps"):
+		prefix, bitsPerSymbol = strings.TrimSuffix(unit, "Bps"), 8
+	case strings.HasSuffix(unit, "B/s"):
+		prefix, bitsPerSymbol = strings.TrimSuffix(unit, "B/s"), 8
+	}
+
+	multiplier, ok := unitPrefixes[strings.ToLower(prefix)]
+	if !ok {
+		return nil, false
+	}
+
+	return new(big.Int).Mul(multiplier, big.NewInt(bitsPerSymbol)), true
+}
+
+// parseBandwidth parses a bandwidth string, such as `1Gbps`, `1.5 GiBps` or `1000000000`, into bits per second.
+func parseBandwidth(s string) (uint64, error) {
+	if strings.HasPrefix(s, "-") {
+		return 0, fmt.Errorf("bandwidth %q: must not be negative", s)
+	}
+
+	numberEnd := strings.IndexFunc(s, func(r rune) bool {
+		return (r < '0' || r > '9') && r != '.'
+	})
+	if numberEnd == -1 {
+		numberEnd = len(s)
+	}
+
+	number, unit := s[:numberEnd], strings.TrimLeft(s[numberEnd:], " ")
+
+	whole, fraction, hasFraction := strings.Cut(number, ".")
+	if whole == "" || (hasFraction && (fraction == "" || strings.Contains(fraction, "."))) {
+		return 0, fmt.Errorf("bandwidth %q: invalid number %q", s, number)
+	}
+
+	rate, ok := new(big.Rat).SetString(number)
+	if !ok {
+		return 0, fmt.Errorf("bandwidth %q: invalid number %q", s, number)
+	}
+
+	bits, ok := unitBits(unit)
+	if !ok {
+		return 0, fmt.Errorf("bandwidth %q: unknown unit %q", s, unit)
+	}
+
+	rate.Mul(rate, new(big.Rat).SetInt(bits))
+
+	if !rate.IsInt() {
+		return 0, fmt.Errorf("bandwidth %q: does not resolve to a whole number of bits per second", s)
+	}
+
+	if !rate.Num().IsUint64() {
+		return 0, fmt.Errorf("bandwidth %q: exceeds the maximum of %d bits per second", s, uint64(1<<64-1))
+	}
+
+	return rate.Num().Uint64(), nil
+}
+
+// FormatBitsPerSecond formats a number of bits per second in the given unit, for example when a remote API expects
+// a rate in a specific unit. Fractional results are written as exact decimals, such as `1.5Gbps` or `0.125Bps`.
+// An error is returned if the unit is not recognized.
+func FormatBitsPerSecond(bps uint64, unit Unit) (string, error) {
+	bits, ok := unitBits(string(unit))
+	if !ok {
+		return "", fmt.Errorf("unknown unit %q", unit)
+	}
+
+	rate := new(big.Rat).SetFrac(new(big.Int).SetUint64(bps), bits)
+
+	if rate.IsInt() {
+		return rate.Num().String() + string(unit), nil
+	}
+
+	// All supported unit sizes are products of powers of 2 and 10, so every quotient has a terminating decimal
+	// expansion well within this precision.
+	number := strings.TrimRight(rate.FloatString(64), "0")
+
+	return number + string(unit), nil
+}
+
+// CanonicalBitsPerSecond formats a number of bits per second using the largest SI (decimal) bits per second unit
+// that represents it exactly, such as `1Gbps` for 1000000000 or `1500Mbps` for 1500000000.
+func CanonicalBitsPerSecond(bps uint64) string {
+	for _, unit := range canonicalUnits {
+		bits, _ := unitBits(string(unit))
+
+		if bps != 0 && bps%bits.Uint64() == 0 {
+			return fmt.Sprintf("%d%s", bps/bits.Uint64(), unit)
+		}
+	}
+
+	return fmt.Sprintf("%d%s", bps, BitsPerSecond)
+}
@@ -0,0 +1,123 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package bandwidthtypes_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/bandwidthtypes"
+)
+
+func TestFormatBitsPerSecond(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		bps         uint64
+		unit        bandwidthtypes.Unit
+		expected    string
+		expectedErr string
+	}{
+		"bits": {
+			bps:      1000,
+			unit:     bandwidthtypes.BitsPerSecond,
+			expected: "1000bps",
+		},
+		"SI bits - whole": {
+			bps:      1000000000,
+			unit:     bandwidthtypes.MegabitsPerSecond,
+			expected: "1000Mbps",
+		},
+		"SI bits - fractional": {
+			bps:      1500000000,
+			unit:     bandwidthtypes.GigabitsPerSecond,
+			expected: "1.5Gbps",
+		},
+		"IEC bits - fractional": {
+			bps:      1,
+			unit:     bandwidthtypes.KibibitsPerSecond,
+			expected: "0.0009765625Kibps",
+		},
+		"bytes - fractional": {
+			bps:      1,
+			unit:     bandwidthtypes.BytesPerSecond,
+			expected: "0.125Bps",
+		},
+		"SI bytes - whole": {
+			bps:      1000000000,
+			unit:     bandwidthtypes.MegabytesPerSecond,
+			expected: "125MBps",
+		},
+		"unknown unit": {
+			bps:         1,
+			unit:        bandwidthtypes.Unit("furlongs"),
+			expectedErr: "unknown unit \"furlongs\"",
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := bandwidthtypes.FormatBitsPerSecond(testCase.bps, testCase.unit)
+			if err != nil {
+				if testCase.expectedErr == "" {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+				if testCase.expectedErr != err.Error() {
+					t.Fatalf("Expected error to be %q, got %q", testCase.expectedErr, err.Error())
+				}
+				return
+			}
+			if testCase.expectedErr != "" {
+				t.Fatalf("Expected error to be %q, didn't get an error", testCase.expectedErr)
+			}
+			if got != testCase.expected {
+				t.Errorf("Expected %q, got %q", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestCanonicalBitsPerSecond(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		bps      uint64
+		expected string
+	}{
+		"zero": {
+			bps:      0,
+			expected: "0bps",
+		},
+		"bits": {
+			bps:      1001,
+			expected: "1001bps",
+		},
+		"kilobits": {
+			bps:      64000,
+			expected: "64Kbps",
+		},
+		"gigabits": {
+			bps:      1000000000,
+			expected: "1Gbps",
+		},
+		"megabits - not a whole number of gigabits": {
+			bps:      1500000000,
+			expected: "1500Mbps",
+		},
+		"petabits": {
+			bps:      2000000000000000,
+			expected: "2Pbps",
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := bandwidthtypes.CanonicalBitsPerSecond(testCase.bps)
+			if got != testCase.expected {
+				t.Errorf("Expected %q, got %q", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,180 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package bandwidthtypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*Bandwidth)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*Bandwidth)(nil)
+	_ xattr.ValidateableAttribute                = (*Bandwidth)(nil)
+	_ function.ValidateableParameter             = (*Bandwidth)(nil)
+)
+
+// Bandwidth represents a valid non-negative data rate string, such as an interface speed or QoS policy rate. The
+// value is a decimal number, optionally followed by a unit with an SI (k, M, G, T, P) or IEC (Ki, Mi, Gi, Ti, Pi)
+// prefix and a bits (bps, b/s, bit/s) or bytes (Bps, B/s) per second suffix. A unit without a suffix, or no unit
+// at all, is interpreted as bits per second. Semantic equality logic is defined for Bandwidth, so that rates which
+// normalize to the same number of bits per second are considered equal.
+//
+// All of the following are semantically equal:
+//   - 1Gbps
+//   - 1000Mbps
+//   - 1G
+//   - 1000000000
+//   - 125MBps
+type Bandwidth struct {
+	basetypes.StringValue
+}
+
+// Type returns a BandwidthType.
+func (v Bandwidth) Type(_ context.Context) attr.Type {
+	return BandwidthType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v Bandwidth) Equal(o attr.Value) bool {
+	other, ok := o.(Bandwidth)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given bandwidth string value is semantically equal to the current bandwidth string value.
+// Both values are normalized to a number of bits per second before comparison, so the unit a rate is expressed in does not matter.
+//
+// All of the following are semantically equal:
+//   - 1Gbps
+//   - 1000Mbps
+//   - 1G
+//   - 1000000000
+//   - 125MBps
+func (v Bandwidth) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(Bandwidth)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// Bandwidths are already validated at this point, ignoring errors
+	newBps, _ := parseBandwidth(newValue.ValueString())
+	currentBps, _ := parseBandwidth(v.ValueString())
+
+	return currentBps == newBps, diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String
+// value that is a valid, non-negative bandwidth which resolves to a whole number of bits per second.
+func (v Bandwidth) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseBandwidth(v.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Bandwidth String Value",
+			"A string value was provided that is not valid bandwidth string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is a valid, non-negative bandwidth which resolves to a whole number of bits per second.
+func (v Bandwidth) ValidateParameter(_ context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseBandwidth(v.ValueString())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid Bandwidth String Value: "+
+				"A string value was provided that is not valid bandwidth string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueBitsPerSecond parses the Bandwidth StringValue and returns the rate in bits per second. Use FormatBitsPerSecond
+// or CanonicalBitsPerSecond to express the result in a specific unit. A null or unknown value will produce an error diagnostic.
+func (v Bandwidth) ValueBitsPerSecond() (uint64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("Bandwidth ValueBitsPerSecond Error", "bandwidth string value is null"))
+		return 0, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("Bandwidth ValueBitsPerSecond Error", "bandwidth string value is unknown"))
+		return 0, diags
+	}
+
+	bps, err := parseBandwidth(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("Bandwidth ValueBitsPerSecond Error", err.Error()))
+		return 0, diags
+	}
+
+	return bps, nil
+}
+
+// NewBandwidthNull creates a Bandwidth with a null value. Determine whether the value is null via IsNull method.
+func NewBandwidthNull() Bandwidth {
+	return Bandwidth{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewBandwidthUnknown creates a Bandwidth with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewBandwidthUnknown() Bandwidth {
+	return Bandwidth{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewBandwidthValue creates a Bandwidth with a known value. Access the value via ValueString method.
+func NewBandwidthValue(value string) Bandwidth {
+	return Bandwidth{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewBandwidthPointerValue creates a Bandwidth with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewBandwidthPointerValue(value *string) Bandwidth {
+	return Bandwidth{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package bandwidthtypes_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/bandwidthtypes"
+)
+
+type BandwidthResourceModel struct {
+	Bandwidth bandwidthtypes.Bandwidth `tfsdk:"bandwidth"`
+}
+
+func ExampleBandwidth_ValueBitsPerSecond() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := BandwidthResourceModel{
+		Bandwidth: bandwidthtypes.NewBandwidthValue("1.5G"),
+	}
+
+	// Check that the Bandwidth data is known and able to be converted to bits per second
+	if !data.Bandwidth.IsNull() && !data.Bandwidth.IsUnknown() {
+		bps, diags := data.Bandwidth.ValueBitsPerSecond()
+		if diags.HasError() {
+			return
+		}
+
+		// Convert to the unit expected by a remote API
+		kbps, err := bandwidthtypes.FormatBitsPerSecond(bps, bandwidthtypes.KilobitsPerSecond)
+		if err != nil {
+			return
+		}
+
+		// Output: 1500000000, 1500Mbps, 1500000Kbps
+		fmt.Printf("%d, %s, %s\n", bps, bandwidthtypes.CanonicalBitsPerSecond(bps), kbps)
+	}
+}
@@ -0,0 +1,336 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package bandwidthtypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/bandwidthtypes"
+)
+
+func TestBandwidthStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentBandwidth bandwidthtypes.Bandwidth
+		givenBandwidth   basetypes.StringValuable
+		expectedMatch    bool
+		expectedDiags    diag.Diagnostics
+	}{
+		"semantically equal - byte-for-byte match": {
+			currentBandwidth: bandwidthtypes.NewBandwidthValue("1Gbps"),
+			givenBandwidth:   bandwidthtypes.NewBandwidthValue("1Gbps"),
+			expectedMatch:    true,
+		},
+		"semantically equal - SI unit mismatch": {
+			currentBandwidth: bandwidthtypes.NewBandwidthValue("1Gbps"),
+			givenBandwidth:   bandwidthtypes.NewBandwidthValue("1000Mbps"),
+			expectedMatch:    true,
+		},
+		"semantically equal - suffix omitted": {
+			currentBandwidth: bandwidthtypes.NewBandwidthValue("1Gbps"),
+			givenBandwidth:   bandwidthtypes.NewBandwidthValue("1G"),
+			expectedMatch:    true,
+		},
+		"semantically equal - unit omitted": {
+			currentBandwidth: bandwidthtypes.NewBandwidthValue("1Gbps"),
+			givenBandwidth:   bandwidthtypes.NewBandwidthValue("1000000000"),
+			expectedMatch:    true,
+		},
+		"semantically equal - bits vs bytes": {
+			currentBandwidth: bandwidthtypes.NewBandwidthValue("1Gbps"),
+			givenBandwidth:   bandwidthtypes.NewBandwidthValue("125MBps"),
+			expectedMatch:    true,
+		},
+		"semantically equal - IEC vs plain": {
+			currentBandwidth: bandwidthtypes.NewBandwidthValue("1Kibps"),
+			givenBandwidth:   bandwidthtypes.NewBandwidthValue("1024"),
+			expectedMatch:    true,
+		},
+		"semantically equal - decimal number": {
+			currentBandwidth: bandwidthtypes.NewBandwidthValue("1.5Gbps"),
+			givenBandwidth:   bandwidthtypes.NewBandwidthValue("1500 Mbps"),
+			expectedMatch:    true,
+		},
+		"semantically equal - prefix case insensitive": {
+			currentBandwidth: bandwidthtypes.NewBandwidthValue("100kbps"),
+			givenBandwidth:   bandwidthtypes.NewBandwidthValue("100Kbps"),
+			expectedMatch:    true,
+		},
+		"not equal - SI vs IEC": {
+			currentBandwidth: bandwidthtypes.NewBandwidthValue("1Gbps"),
+			givenBandwidth:   bandwidthtypes.NewBandwidthValue("1Gibps"),
+			expectedMatch:    false,
+		},
+		"not equal - bits vs bytes": {
+			currentBandwidth: bandwidthtypes.NewBandwidthValue("1Mbps"),
+			givenBandwidth:   bandwidthtypes.NewBandwidthValue("1MBps"),
+			expectedMatch:    false,
+		},
+		"error - not given Bandwidth value": {
+			currentBandwidth: bandwidthtypes.NewBandwidthValue("1Gbps"),
+			givenBandwidth:   basetypes.NewStringValue("1Gbps"),
+			expectedMatch:    false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: bandwidthtypes.Bandwidth\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentBandwidth.StringSemanticEquals(context.Background(), testCase.givenBandwidth)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestBandwidthValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		bandwidth     bandwidthtypes.Bandwidth
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			bandwidth: bandwidthtypes.Bandwidth{},
+		},
+		"null": {
+			bandwidth: bandwidthtypes.NewBandwidthNull(),
+		},
+		"unknown": {
+			bandwidth: bandwidthtypes.NewBandwidthUnknown(),
+		},
+		"valid - zero": {
+			bandwidth: bandwidthtypes.NewBandwidthValue("0"),
+		},
+		"valid - SI bits": {
+			bandwidth: bandwidthtypes.NewBandwidthValue("10Gbps"),
+		},
+		"valid - IEC bytes": {
+			bandwidth: bandwidthtypes.NewBandwidthValue("1.5MiBps"),
+		},
+		"valid - slash notation": {
+			bandwidth: bandwidthtypes.NewBandwidthValue("100 Mbit/s"),
+		},
+		"invalid - negative": {
+			bandwidth: bandwidthtypes.NewBandwidthValue("-1Gbps"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Bandwidth String Value",
+					"A string value was provided that is not valid bandwidth string format.\n\n"+
+						"Given Value: -1Gbps\n"+
+						"Error: bandwidth \"-1Gbps\": must not be negative",
+				),
+			},
+		},
+		"invalid - unknown unit": {
+			bandwidth: bandwidthtypes.NewBandwidthValue("1Xbps"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Bandwidth String Value",
+					"A string value was provided that is not valid bandwidth string format.\n\n"+
+						"Given Value: 1Xbps\n"+
+						"Error: bandwidth \"1Xbps\": unknown unit \"Xbps\"",
+				),
+			},
+		},
+		"invalid - missing number": {
+			bandwidth: bandwidthtypes.NewBandwidthValue("Gbps"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Bandwidth String Value",
+					"A string value was provided that is not valid bandwidth string format.\n\n"+
+						"Given Value: Gbps\n"+
+						"Error: bandwidth \"Gbps\": invalid number \"\"",
+				),
+			},
+		},
+		"invalid - fractional bits": {
+			bandwidth: bandwidthtypes.NewBandwidthValue("0.5bps"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Bandwidth String Value",
+					"A string value was provided that is not valid bandwidth string format.\n\n"+
+						"Given Value: 0.5bps\n"+
+						"Error: bandwidth \"0.5bps\": does not resolve to a whole number of bits per second",
+				),
+			},
+		},
+		"invalid - overflow": {
+			bandwidth: bandwidthtypes.NewBandwidthValue("100000Pbps"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Bandwidth String Value",
+					"A string value was provided that is not valid bandwidth string format.\n\n"+
+						"Given Value: 100000Pbps\n"+
+						"Error: bandwidth \"100000Pbps\": exceeds the maximum of 18446744073709551615 bits per second",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.bandwidth.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{Path: path.Root("test")},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestBandwidthValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		bandwidth       bandwidthtypes.Bandwidth
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			bandwidth: bandwidthtypes.Bandwidth{},
+		},
+		"null": {
+			bandwidth: bandwidthtypes.NewBandwidthNull(),
+		},
+		"unknown": {
+			bandwidth: bandwidthtypes.NewBandwidthUnknown(),
+		},
+		"valid - SI bits": {
+			bandwidth: bandwidthtypes.NewBandwidthValue("10Gbps"),
+		},
+		"invalid - trailing dot": {
+			bandwidth: bandwidthtypes.NewBandwidthValue("1.Gbps"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid Bandwidth String Value: "+
+					"A string value was provided that is not valid bandwidth string format.\n\n"+
+					"Given Value: 1.Gbps\n"+
+					"Error: bandwidth \"1.Gbps\": invalid number \"1.\"",
+			),
+		},
+		"invalid - size instead of rate": {
+			bandwidth: bandwidthtypes.NewBandwidthValue("1GB"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid Bandwidth String Value: "+
+					"A string value was provided that is not valid bandwidth string format.\n\n"+
+					"Given Value: 1GB\n"+
+					"Error: bandwidth \"1GB\": unknown unit \"GB\"",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.bandwidth.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestBandwidthValueBitsPerSecond(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		bandwidth     bandwidthtypes.Bandwidth
+		expectedBps   uint64
+		expectedDiags diag.Diagnostics
+	}{
+		"bandwidth value is null": {
+			bandwidth: bandwidthtypes.NewBandwidthNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Bandwidth ValueBitsPerSecond Error",
+					"bandwidth string value is null",
+				),
+			},
+		},
+		"bandwidth value is unknown": {
+			bandwidth: bandwidthtypes.NewBandwidthUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Bandwidth ValueBitsPerSecond Error",
+					"bandwidth string value is unknown",
+				),
+			},
+		},
+		"valid bandwidth - SI bits": {
+			bandwidth:   bandwidthtypes.NewBandwidthValue("1Gbps"),
+			expectedBps: 1000000000,
+		},
+		"valid bandwidth - IEC bytes": {
+			bandwidth:   bandwidthtypes.NewBandwidthValue("2KiB/s"),
+			expectedBps: 16384,
+		},
+		"valid bandwidth - maximum": {
+			bandwidth:   bandwidthtypes.NewBandwidthValue("18446744073709551615"),
+			expectedBps: 18446744073709551615,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			bps, diags := testCase.bandwidth.ValueBitsPerSecond()
+
+			if bps != testCase.expectedBps {
+				t.Errorf("Unexpected difference in bits per second, got: %d, expected: %d", bps, testCase.expectedBps)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,5 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package bandwidthtypes contains Terraform Plugin Framework Custom Type implementations for bandwidth and data-rate strings.
+package bandwidthtypes
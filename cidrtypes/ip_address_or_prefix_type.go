@@ -0,0 +1,81 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cidrtypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = (*IPAddressOrPrefixType)(nil)
+
+// IPAddressOrPrefixType is an attribute type that represents a valid IPv4 or IPv6 address or CIDR string (RFC 791,
+// RFC 4632, RFC 4291), such as `10.0.0.1` or `10.0.0.0/24`. Semantic equality logic is defined for
+// IPAddressOrPrefixType such that an address string is considered equivalent to the CIDR string of the address with a
+// host-length prefix, and a string with the address zero bits `compressed` will be considered equivalent to the
+// `non-compressed` string.
+//
+// Examples:
+//   - `10.0.0.1` is semantically equal to `10.0.0.1/32`
+//   - `2001:DB8::1` is semantically equal to `2001:db8:0:0:0:0:0:1/128`
+//   - `2001:0DB8:0:0:0:0:0:0/32` is semantically equal to `2001:db8::/32`
+type IPAddressOrPrefixType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t IPAddressOrPrefixType) String() string {
+	return "cidrtypes.IPAddressOrPrefixType"
+}
+
+// ValueType returns the Value type.
+func (t IPAddressOrPrefixType) ValueType(ctx context.Context) attr.Value {
+	return IPAddressOrPrefix{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t IPAddressOrPrefixType) Equal(o attr.Type) bool {
+	other, ok := o.(IPAddressOrPrefixType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t IPAddressOrPrefixType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return IPAddressOrPrefix{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t IPAddressOrPrefixType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
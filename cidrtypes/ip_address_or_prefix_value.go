@@ -0,0 +1,200 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cidrtypes
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*IPAddressOrPrefix)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*IPAddressOrPrefix)(nil)
+	_ xattr.ValidateableAttribute                = (*IPAddressOrPrefix)(nil)
+	_ function.ValidateableParameter             = (*IPAddressOrPrefix)(nil)
+)
+
+// IPAddressOrPrefix represents a valid IPv4 or IPv6 address or CIDR string (RFC 791, RFC 4632, RFC 4291), such as
+// `10.0.0.1` or `10.0.0.0/24`, which is commonly accepted by allow-list attributes. Semantic equality logic is defined
+// for IPAddressOrPrefix such that an address string is considered equivalent to the CIDR string of the address with a
+// host-length prefix, and a string with the address zero bits `compressed` will be considered equivalent to the
+// `non-compressed` string.
+//
+// Examples:
+//   - `10.0.0.1` is semantically equal to `10.0.0.1/32`
+//   - `2001:DB8::1` is semantically equal to `2001:db8:0:0:0:0:0:1/128`
+//   - `2001:0DB8:0:0:0:0:0:0/32` is semantically equal to `2001:db8::/32`
+type IPAddressOrPrefix struct {
+	basetypes.StringValue
+}
+
+// Type returns an IPAddressOrPrefixType.
+func (v IPAddressOrPrefix) Type(_ context.Context) attr.Type {
+	return IPAddressOrPrefixType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v IPAddressOrPrefix) Equal(o attr.Value) bool {
+	other, ok := o.(IPAddressOrPrefix)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given IP address or CIDR string value is semantically equal to the current IP
+// address or CIDR string value. This comparison converts addresses into host-length prefixes and then compares the
+// resulting netip.Prefix representations (comparing (Prefix).Addr() and (Prefix).Bits() respectively). This means an
+// address is considered semantically equal to its /32 or /128 CIDR, and `compressed` IPv6 values are considered
+// semantically equal to `non-compressed` IPv6 values.
+//
+// Examples:
+//   - `10.0.0.1` is semantically equal to `10.0.0.1/32`
+//   - `2001:DB8::1` is semantically equal to `2001:db8:0:0:0:0:0:1/128`
+//   - `2001:0DB8:0:0:0:0:0:0/32` is semantically equal to `2001:db8::/32`
+func (v IPAddressOrPrefix) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(IPAddressOrPrefix)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// IP addresses and CIDRs are already validated at this point, ignoring errors
+	newIPPrefix, _ := parseIPAddressOrPrefix(newValue.ValueString())
+	currentIPPrefix, _ := parseIPAddressOrPrefix(v.ValueString())
+
+	return currentIPPrefix.Addr() == newIPPrefix.Addr() && currentIPPrefix.Bits() == newIPPrefix.Bits(), diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String value
+// that is a valid IP address or IP CIDR.
+func (v IPAddressOrPrefix) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseIPAddressOrPrefix(v.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IP Address or CIDR String Value",
+			"A string value was provided that is not valid IPv4 or IPv6 address or CIDR string format (RFC 791, RFC 4632, RFC 4291).\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is a valid IP address or IP CIDR.
+func (v IPAddressOrPrefix) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseIPAddressOrPrefix(v.ValueString())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid IP Address or CIDR String Value: "+
+				"A string value was provided that is not valid IPv4 or IPv6 address or CIDR string format (RFC 791, RFC 4632, RFC 4291).\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueIPAddressOrPrefix parses the IPAddressOrPrefix StringValue into a netip.Prefix, where an address is returned as
+// a host-length prefix, such as `10.0.0.1/32` for `10.0.0.1`. A null or unknown value will produce an error diagnostic.
+func (v IPAddressOrPrefix) ValueIPAddressOrPrefix() (netip.Prefix, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("IPAddressOrPrefix ValueIPAddressOrPrefix Error", "IP address or CIDR string value is null"))
+		return netip.Prefix{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("IPAddressOrPrefix ValueIPAddressOrPrefix Error", "IP address or CIDR string value is unknown"))
+		return netip.Prefix{}, diags
+	}
+
+	ipPrefix, err := parseIPAddressOrPrefix(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("IPAddressOrPrefix ValueIPAddressOrPrefix Error", err.Error()))
+		return netip.Prefix{}, diags
+	}
+
+	return ipPrefix, nil
+}
+
+// parseIPAddressOrPrefix calls netip.ParsePrefix with a CIDR string, or netip.ParseAddr with an address string, which
+// is converted into a host-length prefix. Addresses with a zone are not valid, as a prefix cannot have a zone.
+func parseIPAddressOrPrefix(value string) (netip.Prefix, error) {
+	if strings.Contains(value, "/") {
+		return netip.ParsePrefix(value)
+	}
+
+	ipAddr, err := netip.ParseAddr(value)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+
+	if ipAddr.Zone() != "" {
+		return netip.Prefix{}, fmt.Errorf("address %s must not have a zone", value)
+	}
+
+	return netip.PrefixFrom(ipAddr, ipAddr.BitLen()), nil
+}
+
+// NewIPAddressOrPrefixNull creates an IPAddressOrPrefix with a null value. Determine whether the value is null via IsNull method.
+func NewIPAddressOrPrefixNull() IPAddressOrPrefix {
+	return IPAddressOrPrefix{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewIPAddressOrPrefixUnknown creates an IPAddressOrPrefix with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewIPAddressOrPrefixUnknown() IPAddressOrPrefix {
+	return IPAddressOrPrefix{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewIPAddressOrPrefixValue creates an IPAddressOrPrefix with a known value. Access the value via ValueString method.
+func NewIPAddressOrPrefixValue(value string) IPAddressOrPrefix {
+	return IPAddressOrPrefix{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewIPAddressOrPrefixPointerValue creates an IPAddressOrPrefix with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewIPAddressOrPrefixPointerValue(value *string) IPAddressOrPrefix {
+	return IPAddressOrPrefix{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
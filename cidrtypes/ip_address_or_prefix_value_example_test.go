@@ -0,0 +1,46 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cidrtypes_test
+
+import (
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/cidrtypes"
+)
+
+type IPAddressOrPrefixResourceModel struct {
+	AllowedSources []cidrtypes.IPAddressOrPrefix `tfsdk:"allowed_sources"`
+}
+
+func ExampleIPAddressOrPrefix_ValueIPAddressOrPrefix() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := IPAddressOrPrefixResourceModel{
+		AllowedSources: []cidrtypes.IPAddressOrPrefix{
+			cidrtypes.NewIPAddressOrPrefixValue("10.0.0.1"),
+			cidrtypes.NewIPAddressOrPrefixValue("192.168.0.0/24"),
+		},
+	}
+
+	// Check that each allowed source is known and able to be converted to netip.Prefix
+	source := netip.MustParseAddr("192.168.0.7")
+
+	for _, allowedSource := range data.AllowedSources {
+		if allowedSource.IsNull() || allowedSource.IsUnknown() {
+			continue
+		}
+
+		ipPrefix, diags := allowedSource.ValueIPAddressOrPrefix()
+		if diags.HasError() {
+			return
+		}
+
+		fmt.Printf("%t, %s\n", ipPrefix.Contains(source), ipPrefix)
+	}
+
+	// Output:
+	// false, 10.0.0.1/32
+	// true, 192.168.0.0/24
+}
@@ -0,0 +1,317 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cidrtypes_test
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/cidrtypes"
+)
+
+func TestIPAddressOrPrefixStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentValue  cidrtypes.IPAddressOrPrefix
+		givenValue    basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"not equal - IPv4 address mismatch": {
+			currentValue:  cidrtypes.NewIPAddressOrPrefixValue("10.0.0.1"),
+			givenValue:    cidrtypes.NewIPAddressOrPrefixValue("10.0.0.2"),
+			expectedMatch: false,
+		},
+		"not equal - IPv4 address and network prefix": {
+			currentValue:  cidrtypes.NewIPAddressOrPrefixValue("10.0.0.0"),
+			givenValue:    cidrtypes.NewIPAddressOrPrefixValue("10.0.0.0/24"),
+			expectedMatch: false,
+		},
+		"not equal - IPv4 prefix length mismatch": {
+			currentValue:  cidrtypes.NewIPAddressOrPrefixValue("10.0.0.0/24"),
+			givenValue:    cidrtypes.NewIPAddressOrPrefixValue("10.0.0.0/16"),
+			expectedMatch: false,
+		},
+		"not equal - IPv4 and IPv4-mapped IPv6 address": {
+			currentValue:  cidrtypes.NewIPAddressOrPrefixValue("10.0.0.1"),
+			givenValue:    cidrtypes.NewIPAddressOrPrefixValue("::ffff:10.0.0.1"),
+			expectedMatch: false,
+		},
+		"semantically equal - byte-for-byte match": {
+			currentValue:  cidrtypes.NewIPAddressOrPrefixValue("10.0.0.0/24"),
+			givenValue:    cidrtypes.NewIPAddressOrPrefixValue("10.0.0.0/24"),
+			expectedMatch: true,
+		},
+		"semantically equal - IPv4 address and /32 prefix": {
+			currentValue:  cidrtypes.NewIPAddressOrPrefixValue("10.0.0.1"),
+			givenValue:    cidrtypes.NewIPAddressOrPrefixValue("10.0.0.1/32"),
+			expectedMatch: true,
+		},
+		"semantically equal - IPv4 /32 prefix and address": {
+			currentValue:  cidrtypes.NewIPAddressOrPrefixValue("10.0.0.1/32"),
+			givenValue:    cidrtypes.NewIPAddressOrPrefixValue("10.0.0.1"),
+			expectedMatch: true,
+		},
+		"semantically equal - IPv6 address and /128 prefix": {
+			currentValue:  cidrtypes.NewIPAddressOrPrefixValue("2001:DB8::1"),
+			givenValue:    cidrtypes.NewIPAddressOrPrefixValue("2001:db8:0:0:0:0:0:1/128"),
+			expectedMatch: true,
+		},
+		"semantically equal - IPv6 prefix compressed match": {
+			currentValue:  cidrtypes.NewIPAddressOrPrefixValue("2001:0DB8:0:0:0:0:0:0/32"),
+			givenValue:    cidrtypes.NewIPAddressOrPrefixValue("2001:db8::/32"),
+			expectedMatch: true,
+		},
+		"error - not given IPAddressOrPrefix value": {
+			currentValue:  cidrtypes.NewIPAddressOrPrefixValue("10.0.0.1"),
+			givenValue:    basetypes.NewStringValue("10.0.0.1"),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: cidrtypes.IPAddressOrPrefix\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentValue.StringSemanticEquals(context.Background(), testCase.givenValue)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestIPAddressOrPrefixValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         cidrtypes.IPAddressOrPrefix
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			value: cidrtypes.IPAddressOrPrefix{},
+		},
+		"null": {
+			value: cidrtypes.NewIPAddressOrPrefixNull(),
+		},
+		"unknown": {
+			value: cidrtypes.NewIPAddressOrPrefixUnknown(),
+		},
+		"valid IPv4 address": {
+			value: cidrtypes.NewIPAddressOrPrefixValue("10.0.0.1"),
+		},
+		"valid IPv4 CIDR": {
+			value: cidrtypes.NewIPAddressOrPrefixValue("10.0.0.0/24"),
+		},
+		"valid IPv6 address": {
+			value: cidrtypes.NewIPAddressOrPrefixValue("2001:db8::1"),
+		},
+		"valid IPv6 CIDR": {
+			value: cidrtypes.NewIPAddressOrPrefixValue("2001:db8::/32"),
+		},
+		"invalid - not an address": {
+			value: cidrtypes.NewIPAddressOrPrefixValue("not-an-address"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid IP Address or CIDR String Value",
+					"A string value was provided that is not valid IPv4 or IPv6 address or CIDR string format (RFC 791, RFC 4632, RFC 4291).\n\n"+
+						"Given Value: not-an-address\n"+
+						"Error: "+"ParseAddr(\"not-an-address\"): unable to parse IP",
+				),
+			},
+		},
+		"invalid - IPv4 CIDR prefix length": {
+			value: cidrtypes.NewIPAddressOrPrefixValue("10.0.0.0/33"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid IP Address or CIDR String Value",
+					"A string value was provided that is not valid IPv4 or IPv6 address or CIDR string format (RFC 791, RFC 4632, RFC 4291).\n\n"+
+						"Given Value: 10.0.0.0/33\n"+
+						"Error: "+"netip.ParsePrefix(\"10.0.0.0/33\"): prefix length out of range",
+				),
+			},
+		},
+		"invalid - IPv6 address with zone": {
+			value: cidrtypes.NewIPAddressOrPrefixValue("fe80::1%eth0"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid IP Address or CIDR String Value",
+					"A string value was provided that is not valid IPv4 or IPv6 address or CIDR string format (RFC 791, RFC 4632, RFC 4291).\n\n"+
+						"Given Value: fe80::1%eth0\n"+
+						"Error: "+"address fe80::1%eth0 must not have a zone",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.value.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{Path: path.Root("test")},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestIPAddressOrPrefixValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value           cidrtypes.IPAddressOrPrefix
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			value: cidrtypes.IPAddressOrPrefix{},
+		},
+		"null": {
+			value: cidrtypes.NewIPAddressOrPrefixNull(),
+		},
+		"unknown": {
+			value: cidrtypes.NewIPAddressOrPrefixUnknown(),
+		},
+		"valid IPv4 address": {
+			value: cidrtypes.NewIPAddressOrPrefixValue("10.0.0.1"),
+		},
+		"valid IPv6 CIDR": {
+			value: cidrtypes.NewIPAddressOrPrefixValue("2001:db8::/32"),
+		},
+		"invalid - not an address": {
+			value: cidrtypes.NewIPAddressOrPrefixValue("not-an-address"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid IP Address or CIDR String Value: "+
+					"A string value was provided that is not valid IPv4 or IPv6 address or CIDR string format (RFC 791, RFC 4632, RFC 4291).\n\n"+
+					"Given Value: not-an-address\n"+
+					"Error: "+"ParseAddr(\"not-an-address\"): unable to parse IP",
+			),
+		},
+		"invalid - IPv4 CIDR prefix length": {
+			value: cidrtypes.NewIPAddressOrPrefixValue("10.0.0.0/33"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid IP Address or CIDR String Value: "+
+					"A string value was provided that is not valid IPv4 or IPv6 address or CIDR string format (RFC 791, RFC 4632, RFC 4291).\n\n"+
+					"Given Value: 10.0.0.0/33\n"+
+					"Error: "+"netip.ParsePrefix(\"10.0.0.0/33\"): prefix length out of range",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.value.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestIPAddressOrPrefixValueIPAddressOrPrefix(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value            cidrtypes.IPAddressOrPrefix
+		expectedIPPrefix netip.Prefix
+		expectedDiags    diag.Diagnostics
+	}{
+		"IP address or CIDR value is null": {
+			value: cidrtypes.NewIPAddressOrPrefixNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"IPAddressOrPrefix ValueIPAddressOrPrefix Error",
+					"IP address or CIDR string value is null",
+				),
+			},
+		},
+		"IP address or CIDR value is unknown": {
+			value: cidrtypes.NewIPAddressOrPrefixUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"IPAddressOrPrefix ValueIPAddressOrPrefix Error",
+					"IP address or CIDR string value is unknown",
+				),
+			},
+		},
+		"valid IPv4 address": {
+			value:            cidrtypes.NewIPAddressOrPrefixValue("10.0.0.1"),
+			expectedIPPrefix: netip.MustParsePrefix("10.0.0.1/32"),
+		},
+		"valid IPv4 CIDR": {
+			value:            cidrtypes.NewIPAddressOrPrefixValue("10.0.0.0/24"),
+			expectedIPPrefix: netip.MustParsePrefix("10.0.0.0/24"),
+		},
+		"valid IPv6 address": {
+			value:            cidrtypes.NewIPAddressOrPrefixValue("2001:db8::1"),
+			expectedIPPrefix: netip.MustParsePrefix("2001:db8::1/128"),
+		},
+		"valid IPv6 CIDR": {
+			value:            cidrtypes.NewIPAddressOrPrefixValue("2001:db8::/32"),
+			expectedIPPrefix: netip.MustParsePrefix("2001:db8::/32"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ipPrefix, diags := testCase.value.ValueIPAddressOrPrefix()
+
+			if ipPrefix != testCase.expectedIPPrefix {
+				t.Errorf("Unexpected difference in netip.Prefix, got: %s, expected: %s", ipPrefix, testCase.expectedIPPrefix)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cryptotypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = (*CipherSuiteType)(nil)
+
+// CipherSuiteType is an attribute type that represents a valid TLS cipher suite, as an IANA name, an OpenSSL name or
+// a hexadecimal cipher suite ID. Semantic equality logic is defined for CipherSuiteType, so that different names of
+// the same cipher suite are considered equal.
+//
+// All of the following are semantically equal:
+//   - TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256
+//   - ECDHE-RSA-AES128-GCM-SHA256
+//   - 0xC02F
+type CipherSuiteType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t CipherSuiteType) String() string {
+	return "cryptotypes.CipherSuiteType"
+}
+
+// ValueType returns the Value type.
+func (t CipherSuiteType) ValueType(ctx context.Context) attr.Value {
+	return CipherSuite{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t CipherSuiteType) Equal(o attr.Type) bool {
+	other, ok := o.(CipherSuiteType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t CipherSuiteType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return CipherSuite{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t CipherSuiteType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
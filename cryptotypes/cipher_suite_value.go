@@ -0,0 +1,251 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cryptotypes
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*CipherSuite)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*CipherSuite)(nil)
+	_ xattr.ValidateableAttribute                = (*CipherSuite)(nil)
+	_ function.ValidateableParameter             = (*CipherSuite)(nil)
+)
+
+// CipherSuite represents a valid TLS cipher suite, as an IANA name such as TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256, an
+// OpenSSL name such as ECDHE-RSA-AES128-GCM-SHA256 or a hexadecimal cipher suite ID such as 0xC02F or 0xC0,0x2F.
+// Names are case-insensitive and must be a cipher suite known to OpenSSL or crypto/tls. Semantic equality logic is
+// defined for CipherSuite, so that different names of the same cipher suite are considered equal.
+//
+// All of the following are semantically equal:
+//   - TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256
+//   - ECDHE-RSA-AES128-GCM-SHA256
+//   - 0xC02F
+type CipherSuite struct {
+	basetypes.StringValue
+}
+
+// Type returns a CipherSuiteType.
+func (v CipherSuite) Type(_ context.Context) attr.Type {
+	return CipherSuiteType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v CipherSuite) Equal(o attr.Value) bool {
+	other, ok := o.(CipherSuite)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given cipher suite string value is semantically equal to the current
+// cipher suite string value. This comparison uses the cipher suite ID of both values, so that different names of the
+// same cipher suite are considered equal.
+//
+// All of the following are semantically equal:
+//   - TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256
+//   - ECDHE-RSA-AES128-GCM-SHA256
+//   - 0xC02F
+func (v CipherSuite) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(CipherSuite)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// Cipher suites are already validated at this point, ignoring errors
+	newSuite, _ := parseCipherSuite(newValue.ValueString())
+	currentSuite, _ := parseCipherSuite(v.ValueString())
+
+	return currentSuite.id == newSuite.id, diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String
+// value that is the IANA name, OpenSSL name or hexadecimal ID of a known TLS cipher suite.
+func (v CipherSuite) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseCipherSuite(v.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Cipher Suite String Value",
+			"A string value was provided that is not valid cipher suite string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is the IANA name, OpenSSL name or hexadecimal ID of a known TLS cipher suite.
+func (v CipherSuite) ValidateParameter(_ context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseCipherSuite(v.ValueString())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid Cipher Suite String Value: "+
+				"A string value was provided that is not valid cipher suite string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueCipherSuite returns the CipherSuite StringValue as a cipher suite ID, such as
+// tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256, for use in a tls.Config. A null or unknown value will produce an error
+// diagnostic.
+func (v CipherSuite) ValueCipherSuite() (uint16, diag.Diagnostics) {
+	suite, diags := v.valueCipherSuite("ValueCipherSuite")
+
+	return suite.id, diags
+}
+
+// ValueIANAName returns the IANA name of the CipherSuite StringValue, such as TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256.
+// A null or unknown value will produce an error diagnostic.
+func (v CipherSuite) ValueIANAName() (string, diag.Diagnostics) {
+	suite, diags := v.valueCipherSuite("ValueIANAName")
+
+	return suite.ianaName, diags
+}
+
+// ValueOpenSSLName returns the OpenSSL name of the CipherSuite StringValue, such as ECDHE-RSA-AES128-GCM-SHA256. A
+// null or unknown value will produce an error diagnostic.
+func (v CipherSuite) ValueOpenSSLName() (string, diag.Diagnostics) {
+	suite, diags := v.valueCipherSuite("ValueOpenSSLName")
+
+	return suite.openSSLName, diags
+}
+
+// valueCipherSuite looks up the CipherSuite StringValue in the cipher suite table, with diagnostics for the given
+// accessor method name.
+func (v CipherSuite) valueCipherSuite(method string) (tlsCipherSuite, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("CipherSuite "+method+" Error", "cipher suite string value is null"))
+		return tlsCipherSuite{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("CipherSuite "+method+" Error", "cipher suite string value is unknown"))
+		return tlsCipherSuite{}, diags
+	}
+
+	suite, err := parseCipherSuite(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("CipherSuite "+method+" Error", err.Error()))
+		return tlsCipherSuite{}, diags
+	}
+
+	return suite, nil
+}
+
+// parseCipherSuite looks up a cipher suite by case-insensitive IANA or OpenSSL name, or by hexadecimal ID in either
+// 0xC02F or 0xC0,0x2F notation.
+func parseCipherSuite(s string) (tlsCipherSuite, error) {
+	if id, ok := parseCipherSuiteID(s); ok {
+		for _, suite := range tlsCipherSuites {
+			if suite.id == id {
+				return suite, nil
+			}
+		}
+
+		return tlsCipherSuite{}, fmt.Errorf("cipher suite %q: cipher suite ID 0x%04X is not a known cipher suite", s, id)
+	}
+
+	for _, suite := range tlsCipherSuites {
+		if strings.EqualFold(s, suite.ianaName) || strings.EqualFold(s, suite.openSSLName) {
+			return suite, nil
+		}
+	}
+
+	return tlsCipherSuite{}, fmt.Errorf("cipher suite %q: must be a known IANA or OpenSSL cipher suite name, or a hexadecimal cipher suite ID such as 0xC02F", s)
+}
+
+// parseCipherSuiteID parses a hexadecimal cipher suite ID as a single 16-bit value, such as 0xC02F, or as two octets,
+// such as 0xC0,0x2F.
+func parseCipherSuiteID(s string) (uint16, bool) {
+	digits := strings.ToLower(s)
+
+	if first, second, found := strings.Cut(digits, ","); found {
+		if len(first) != 4 || len(second) != 4 || !strings.HasPrefix(second, "0x") {
+			return 0, false
+		}
+
+		digits = first + second[2:]
+	}
+
+	digits, found := strings.CutPrefix(digits, "0x")
+	if !found || len(digits) != 4 {
+		return 0, false
+	}
+
+	id, err := strconv.ParseUint(digits, 16, 16)
+	if err != nil {
+		return 0, false
+	}
+
+	return uint16(id), true
+}
+
+// NewCipherSuiteNull creates a CipherSuite with a null value. Determine whether the value is null via IsNull method.
+func NewCipherSuiteNull() CipherSuite {
+	return CipherSuite{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewCipherSuiteUnknown creates a CipherSuite with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewCipherSuiteUnknown() CipherSuite {
+	return CipherSuite{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewCipherSuiteValue creates a CipherSuite with a known value. Access the value via ValueString method.
+func NewCipherSuiteValue(value string) CipherSuite {
+	return CipherSuite{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewCipherSuitePointerValue creates a CipherSuite with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewCipherSuitePointerValue(value *string) CipherSuite {
+	return CipherSuite{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
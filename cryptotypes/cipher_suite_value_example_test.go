@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cryptotypes_test
+
+import (
+	"crypto/tls"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/cryptotypes"
+)
+
+type TLSPolicyResourceModel struct {
+	MinVersion   cryptotypes.TLSVersion    `tfsdk:"min_version"`
+	CipherSuites []cryptotypes.CipherSuite `tfsdk:"cipher_suites"`
+}
+
+func ExampleCipherSuite_ValueCipherSuite() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := TLSPolicyResourceModel{
+		MinVersion: cryptotypes.NewTLSVersionValue("TLSv1.2"),
+		CipherSuites: []cryptotypes.CipherSuite{
+			cryptotypes.NewCipherSuiteValue("ECDHE-RSA-AES128-GCM-SHA256"),
+			cryptotypes.NewCipherSuiteValue("TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384"),
+		},
+	}
+
+	// Build a tls.Config from the TLSVersion and CipherSuite data
+	config := &tls.Config{}
+
+	minVersion, diags := data.MinVersion.ValueTLSVersion()
+	if diags.HasError() {
+		return
+	}
+
+	config.MinVersion = minVersion
+
+	for _, cipherSuite := range data.CipherSuites {
+		id, diags := cipherSuite.ValueCipherSuite()
+		if diags.HasError() {
+			return
+		}
+
+		config.CipherSuites = append(config.CipherSuites, id)
+	}
+
+	for _, id := range config.CipherSuites {
+		fmt.Println(tls.CipherSuiteName(id))
+	}
+
+	// Output:
+	// TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256
+	// TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384
+}
@@ -0,0 +1,348 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cryptotypes_test
+
+import (
+	"context"
+	"crypto/tls"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/cryptotypes"
+)
+
+func TestCipherSuiteStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentSuite  cryptotypes.CipherSuite
+		givenSuite    basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"semantically equal - byte-for-byte match": {
+			currentSuite:  cryptotypes.NewCipherSuiteValue("TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"),
+			givenSuite:    cryptotypes.NewCipherSuiteValue("TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"),
+			expectedMatch: true,
+		},
+		"semantically equal - IANA and OpenSSL name": {
+			currentSuite:  cryptotypes.NewCipherSuiteValue("TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"),
+			givenSuite:    cryptotypes.NewCipherSuiteValue("ECDHE-RSA-AES128-GCM-SHA256"),
+			expectedMatch: true,
+		},
+		"semantically equal - OpenSSL name and hexadecimal ID": {
+			currentSuite:  cryptotypes.NewCipherSuiteValue("ECDHE-RSA-AES128-GCM-SHA256"),
+			givenSuite:    cryptotypes.NewCipherSuiteValue("0xC02F"),
+			expectedMatch: true,
+		},
+		"semantically equal - hexadecimal octets": {
+			currentSuite:  cryptotypes.NewCipherSuiteValue("0xc02f"),
+			givenSuite:    cryptotypes.NewCipherSuiteValue("0xC0,0x2F"),
+			expectedMatch: true,
+		},
+		"semantically equal - case-insensitive name": {
+			currentSuite:  cryptotypes.NewCipherSuiteValue("TLS_AES_128_GCM_SHA256"),
+			givenSuite:    cryptotypes.NewCipherSuiteValue("tls_aes_128_gcm_sha256"),
+			expectedMatch: true,
+		},
+		"not equal - different cipher suite": {
+			currentSuite:  cryptotypes.NewCipherSuiteValue("ECDHE-RSA-AES128-GCM-SHA256"),
+			givenSuite:    cryptotypes.NewCipherSuiteValue("ECDHE-RSA-AES256-GCM-SHA384"),
+			expectedMatch: false,
+		},
+		"error - not given CipherSuite value": {
+			currentSuite:  cryptotypes.NewCipherSuiteValue("ECDHE-RSA-AES128-GCM-SHA256"),
+			givenSuite:    basetypes.NewStringValue("ECDHE-RSA-AES128-GCM-SHA256"),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: cryptotypes.CipherSuite\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentSuite.StringSemanticEquals(context.Background(), testCase.givenSuite)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestCipherSuiteValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		suiteValue    cryptotypes.CipherSuite
+		expectedError string
+	}{
+		"empty-struct": {
+			suiteValue: cryptotypes.CipherSuite{},
+		},
+		"null": {
+			suiteValue: cryptotypes.NewCipherSuiteNull(),
+		},
+		"unknown": {
+			suiteValue: cryptotypes.NewCipherSuiteUnknown(),
+		},
+		"valid - IANA name": {
+			suiteValue: cryptotypes.NewCipherSuiteValue("TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256"),
+		},
+		"valid - OpenSSL name": {
+			suiteValue: cryptotypes.NewCipherSuiteValue("ECDHE-ECDSA-CHACHA20-POLY1305"),
+		},
+		"valid - TLS 1.3": {
+			suiteValue: cryptotypes.NewCipherSuiteValue("TLS_CHACHA20_POLY1305_SHA256"),
+		},
+		"valid - hexadecimal ID": {
+			suiteValue: cryptotypes.NewCipherSuiteValue("0x1302"),
+		},
+		"invalid - empty": {
+			suiteValue:    cryptotypes.NewCipherSuiteValue(""),
+			expectedError: "cipher suite \"\": must be a known IANA or OpenSSL cipher suite name, or a hexadecimal cipher suite ID such as 0xC02F",
+		},
+		"invalid - unknown name": {
+			suiteValue:    cryptotypes.NewCipherSuiteValue("TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA512"),
+			expectedError: "cipher suite \"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA512\": must be a known IANA or OpenSSL cipher suite name, or a hexadecimal cipher suite ID such as 0xC02F",
+		},
+		"invalid - OpenSSL cipher string": {
+			suiteValue:    cryptotypes.NewCipherSuiteValue("HIGH:!aNULL"),
+			expectedError: "cipher suite \"HIGH:!aNULL\": must be a known IANA or OpenSSL cipher suite name, or a hexadecimal cipher suite ID such as 0xC02F",
+		},
+		"invalid - unknown hexadecimal ID": {
+			suiteValue:    cryptotypes.NewCipherSuiteValue("0x0A0A"),
+			expectedError: "cipher suite \"0x0A0A\": cipher suite ID 0x0A0A is not a known cipher suite",
+		},
+		"invalid - short hexadecimal ID": {
+			suiteValue:    cryptotypes.NewCipherSuiteValue("0x2F"),
+			expectedError: "cipher suite \"0x2F\": must be a known IANA or OpenSSL cipher suite name, or a hexadecimal cipher suite ID such as 0xC02F",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var expectedDiags diag.Diagnostics
+
+			if testCase.expectedError != "" {
+				expectedDiags.AddAttributeError(
+					path.Root("test"),
+					"Invalid Cipher Suite String Value",
+					"A string value was provided that is not valid cipher suite string format.\n\n"+
+						"Given Value: "+testCase.suiteValue.ValueString()+"\n"+
+						"Error: "+testCase.expectedError,
+				)
+			}
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.suiteValue.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{Path: path.Root("test")},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestCipherSuiteValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		suiteValue      cryptotypes.CipherSuite
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			suiteValue: cryptotypes.CipherSuite{},
+		},
+		"null": {
+			suiteValue: cryptotypes.NewCipherSuiteNull(),
+		},
+		"unknown": {
+			suiteValue: cryptotypes.NewCipherSuiteUnknown(),
+		},
+		"valid - OpenSSL name": {
+			suiteValue: cryptotypes.NewCipherSuiteValue("AES128-SHA"),
+		},
+		"invalid - unknown name": {
+			suiteValue: cryptotypes.NewCipherSuiteValue("AES128-SHA512"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid Cipher Suite String Value: "+
+					"A string value was provided that is not valid cipher suite string format.\n\n"+
+					"Given Value: AES128-SHA512\n"+
+					"Error: cipher suite \"AES128-SHA512\": must be a known IANA or OpenSSL cipher suite name, or a hexadecimal cipher suite ID such as 0xC02F",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.suiteValue.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestCipherSuiteValueCipherSuite(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		suiteValue    cryptotypes.CipherSuite
+		expectedID    uint16
+		expectedDiags diag.Diagnostics
+	}{
+		"cipher suite value is null": {
+			suiteValue: cryptotypes.NewCipherSuiteNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"CipherSuite ValueCipherSuite Error",
+					"cipher suite string value is null",
+				),
+			},
+		},
+		"cipher suite value is unknown": {
+			suiteValue: cryptotypes.NewCipherSuiteUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"CipherSuite ValueCipherSuite Error",
+					"cipher suite string value is unknown",
+				),
+			},
+		},
+		"OpenSSL name": {
+			suiteValue: cryptotypes.NewCipherSuiteValue("ECDHE-RSA-AES128-GCM-SHA256"),
+			expectedID: tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+		},
+		"hexadecimal octets": {
+			suiteValue: cryptotypes.NewCipherSuiteValue("0x13,0x01"),
+			expectedID: tls.TLS_AES_128_GCM_SHA256,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			id, diags := testCase.suiteValue.ValueCipherSuite()
+
+			if id != testCase.expectedID {
+				t.Errorf("Unexpected difference in cipher suite ID, got: 0x%04X, expected: 0x%04X", id, testCase.expectedID)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestCipherSuiteValueCipherSuite_CryptoTLS(t *testing.T) {
+	t.Parallel()
+
+	// Every cipher suite implemented by crypto/tls is expected to be known by its IANA name
+	for _, suite := range append(tls.CipherSuites(), tls.InsecureCipherSuites()...) {
+		id, diags := cryptotypes.NewCipherSuiteValue(suite.Name).ValueCipherSuite()
+
+		if diags.HasError() {
+			t.Errorf("Unexpected diagnostics for %s: %v", suite.Name, diags)
+		}
+
+		if id != suite.ID {
+			t.Errorf("Unexpected difference in cipher suite ID for %s, got: 0x%04X, expected: 0x%04X", suite.Name, id, suite.ID)
+		}
+	}
+}
+
+func TestCipherSuiteValueNames(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		suiteValue          cryptotypes.CipherSuite
+		expectedIANAName    string
+		expectedOpenSSLName string
+		expectedDiags       diag.Diagnostics
+	}{
+		"cipher suite value is null": {
+			suiteValue: cryptotypes.NewCipherSuiteNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"CipherSuite ValueIANAName Error",
+					"cipher suite string value is null",
+				),
+				diag.NewErrorDiagnostic(
+					"CipherSuite ValueOpenSSLName Error",
+					"cipher suite string value is null",
+				),
+			},
+		},
+		"IANA name": {
+			suiteValue:          cryptotypes.NewCipherSuiteValue("tls_ecdhe_ecdsa_with_aes_256_gcm_sha384"),
+			expectedIANAName:    "TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384",
+			expectedOpenSSLName: "ECDHE-ECDSA-AES256-GCM-SHA384",
+		},
+		"hexadecimal ID": {
+			suiteValue:          cryptotypes.NewCipherSuiteValue("0x009C"),
+			expectedIANAName:    "TLS_RSA_WITH_AES_128_GCM_SHA256",
+			expectedOpenSSLName: "AES128-GCM-SHA256",
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ianaName, diags := testCase.suiteValue.ValueIANAName()
+			openSSLName, openSSLDiags := testCase.suiteValue.ValueOpenSSLName()
+			diags.Append(openSSLDiags...)
+
+			if ianaName != testCase.expectedIANAName {
+				t.Errorf("Unexpected difference in IANA name, got: %s, expected: %s", ianaName, testCase.expectedIANAName)
+			}
+
+			if openSSLName != testCase.expectedOpenSSLName {
+				t.Errorf("Unexpected difference in OpenSSL name, got: %s, expected: %s", openSSLName, testCase.expectedOpenSSLName)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cryptotypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = (*FingerprintType)(nil)
+
+// FingerprintType is an attribute type that represents a valid certificate or key fingerprint, as a hexadecimal digest
+// with or without colon separators, or a base64 digest prefixed with its hash algorithm as printed by `ssh-keygen -l`.
+// The hash algorithm is detected from the prefix or the digest length. Semantic equality logic is defined for
+// FingerprintType, so that the notation and letter case of the same digest are ignored.
+//
+// All of the following are semantically equal:
+//   - SHA256:mWPBdJzfeCDkqM9Xz8pP/Ef14LPG4IoZo39cA4gBkCU
+//   - 99:63:C1:74:9C:DF:78:20:E4:A8:CF:57:CF:CA:4F:FC:47:F5:E0:B3:C6:E0:8A:19:A3:7F:5C:03:88:01:90:25
+//   - 9963c1749cdf7820e4a8cf57cfca4ffc47f5e0b3c6e08a19a37f5c0388019025
+type FingerprintType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t FingerprintType) String() string {
+	return "cryptotypes.FingerprintType"
+}
+
+// ValueType returns the Value type.
+func (t FingerprintType) ValueType(ctx context.Context) attr.Value {
+	return Fingerprint{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t FingerprintType) Equal(o attr.Type) bool {
+	other, ok := o.(FingerprintType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t FingerprintType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return Fingerprint{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t FingerprintType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
@@ -0,0 +1,355 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cryptotypes
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*Fingerprint)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*Fingerprint)(nil)
+	_ xattr.ValidateableAttribute                = (*Fingerprint)(nil)
+	_ function.ValidateableParameter             = (*Fingerprint)(nil)
+)
+
+const (
+	// FingerprintAlgorithmMD5 is a 16 byte MD5 digest.
+	FingerprintAlgorithmMD5 = "MD5"
+
+	// FingerprintAlgorithmSHA1 is a 20 byte SHA-1 digest.
+	FingerprintAlgorithmSHA1 = "SHA1"
+
+	// FingerprintAlgorithmSHA256 is a 32 byte SHA-256 digest.
+	FingerprintAlgorithmSHA256 = "SHA256"
+
+	// FingerprintAlgorithmSHA384 is a 48 byte SHA-384 digest.
+	FingerprintAlgorithmSHA384 = "SHA384"
+
+	// FingerprintAlgorithmSHA512 is a 64 byte SHA-512 digest.
+	FingerprintAlgorithmSHA512 = "SHA512"
+)
+
+// fingerprintAlgorithms lists the supported hash algorithms and their digest lengths in bytes. Every digest length is
+// unique, so the algorithm of a fingerprint without a prefix can be detected from its length.
+var fingerprintAlgorithms = []struct {
+	name string
+	size int
+}{
+	{FingerprintAlgorithmMD5, 16},
+	{FingerprintAlgorithmSHA1, 20},
+	{FingerprintAlgorithmSHA256, 32},
+	{FingerprintAlgorithmSHA384, 48},
+	{FingerprintAlgorithmSHA512, 64},
+}
+
+// Fingerprint represents a valid certificate or key fingerprint, as a hexadecimal digest with or without colon
+// separators, or a base64 digest. Either notation can be prefixed with the hash algorithm name and a colon, as printed
+// by `ssh-keygen -l`, otherwise the hash algorithm is detected from the digest length. The MD5, SHA1, SHA256, SHA384
+// and SHA512 hash algorithms are supported. Semantic equality logic is defined for Fingerprint, so that the notation
+// and letter case of the same digest are ignored.
+//
+// All of the following are semantically equal:
+//   - SHA256:mWPBdJzfeCDkqM9Xz8pP/Ef14LPG4IoZo39cA4gBkCU
+//   - 99:63:C1:74:9C:DF:78:20:E4:A8:CF:57:CF:CA:4F:FC:47:F5:E0:B3:C6:E0:8A:19:A3:7F:5C:03:88:01:90:25
+//   - 9963c1749cdf7820e4a8cf57cfca4ffc47f5e0b3c6e08a19a37f5c0388019025
+type Fingerprint struct {
+	basetypes.StringValue
+}
+
+// Type returns a FingerprintType.
+func (v Fingerprint) Type(_ context.Context) attr.Type {
+	return FingerprintType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v Fingerprint) Equal(o attr.Value) bool {
+	other, ok := o.(Fingerprint)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given fingerprint string value is semantically equal to the current
+// fingerprint string value. This comparison decodes both values into their hash algorithm and digest, so that the
+// notation and letter case of the same digest are ignored.
+//
+// All of the following are semantically equal:
+//   - SHA256:mWPBdJzfeCDkqM9Xz8pP/Ef14LPG4IoZo39cA4gBkCU
+//   - 99:63:C1:74:9C:DF:78:20:E4:A8:CF:57:CF:CA:4F:FC:47:F5:E0:B3:C6:E0:8A:19:A3:7F:5C:03:88:01:90:25
+//   - 9963c1749cdf7820e4a8cf57cfca4ffc47f5e0b3c6e08a19a37f5c0388019025
+func (v Fingerprint) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(Fingerprint)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// Fingerprints are already validated at this point, ignoring errors
+	newAlgorithm, newDigest, _ := parseFingerprint(newValue.ValueString())
+	currentAlgorithm, currentDigest, _ := parseFingerprint(v.ValueString())
+
+	return currentAlgorithm == newAlgorithm && bytes.Equal(currentDigest, newDigest), diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String
+// value that is a valid hexadecimal or base64 fingerprint, where the digest length matches the hash algorithm prefix
+// or a supported hash algorithm.
+func (v Fingerprint) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, _, err := parseFingerprint(v.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Fingerprint String Value",
+			"A string value was provided that is not valid fingerprint string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is a valid hexadecimal or base64 fingerprint, where the digest length matches
+// the hash algorithm prefix or a supported hash algorithm.
+func (v Fingerprint) ValidateParameter(_ context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, _, err := parseFingerprint(v.ValueString())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid Fingerprint String Value: "+
+				"A string value was provided that is not valid fingerprint string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueAlgorithm returns the hash algorithm of the Fingerprint StringValue, such as SHA256. A null or unknown value
+// will produce an error diagnostic.
+func (v Fingerprint) ValueAlgorithm() (string, diag.Diagnostics) {
+	algorithm, _, diags := v.valueFingerprint("ValueAlgorithm")
+
+	return algorithm, diags
+}
+
+// ValueDigest returns the decoded digest bytes of the Fingerprint StringValue. A null or unknown value will produce an
+// error diagnostic.
+func (v Fingerprint) ValueDigest() ([]byte, diag.Diagnostics) {
+	_, digest, diags := v.valueFingerprint("ValueDigest")
+
+	return digest, diags
+}
+
+// valueFingerprint parses the Fingerprint StringValue into its hash algorithm and digest, with diagnostics for the
+// given accessor method name.
+func (v Fingerprint) valueFingerprint(method string) (string, []byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("Fingerprint "+method+" Error", "fingerprint string value is null"))
+		return "", nil, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("Fingerprint "+method+" Error", "fingerprint string value is unknown"))
+		return "", nil, diags
+	}
+
+	algorithm, digest, err := parseFingerprint(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("Fingerprint "+method+" Error", err.Error()))
+		return "", nil, diags
+	}
+
+	return algorithm, digest, nil
+}
+
+// parseFingerprint parses a fingerprint into its hash algorithm and decoded digest. A leading hash algorithm name and
+// colon is matched case-insensitively, otherwise the hash algorithm is detected from the digest length. Hexadecimal
+// notation takes precedence over base64 when a digest is valid in both.
+func parseFingerprint(s string) (string, []byte, error) {
+	digestString := s
+	algorithm := ""
+
+	if prefix, rest, found := strings.Cut(s, ":"); found && !isFingerprintHexOctet(prefix) {
+		for _, candidate := range fingerprintAlgorithms {
+			if strings.EqualFold(prefix, candidate.name) {
+				algorithm = candidate.name
+			}
+		}
+
+		if algorithm == "" {
+			return "", nil, fmt.Errorf("fingerprint %q: unsupported hash algorithm %q, expected one of: %s", s, prefix, fingerprintAlgorithmNames())
+		}
+
+		digestString = rest
+	}
+
+	digest, ok := decodeFingerprintHex(digestString)
+	if !ok || (algorithm != "" && len(digest) != fingerprintDigestSize(algorithm)) {
+		digest, ok = decodeFingerprintBase64(digestString)
+	}
+
+	if !ok {
+		return "", nil, fmt.Errorf("fingerprint %q: digest must be hexadecimal, colon separated hexadecimal or base64", s)
+	}
+
+	if algorithm != "" {
+		if size := fingerprintDigestSize(algorithm); len(digest) != size {
+			return "", nil, fmt.Errorf("fingerprint %q: %s digest must be %d bytes, got %d", s, algorithm, size, len(digest))
+		}
+
+		return algorithm, digest, nil
+	}
+
+	for _, candidate := range fingerprintAlgorithms {
+		if len(digest) == candidate.size {
+			return candidate.name, digest, nil
+		}
+	}
+
+	return "", nil, fmt.Errorf("fingerprint %q: digest length of %d bytes does not match a supported hash algorithm, expected one of: %s", s, len(digest), fingerprintAlgorithmNames())
+}
+
+// decodeFingerprintHex decodes a digest in bare hexadecimal notation or with colon separators between each octet.
+func decodeFingerprintHex(s string) ([]byte, bool) {
+	if strings.Contains(s, ":") {
+		octets := strings.Split(s, ":")
+
+		for _, octet := range octets {
+			if !isFingerprintHexOctet(octet) {
+				return nil, false
+			}
+		}
+
+		s = strings.Join(octets, "")
+	}
+
+	if s == "" {
+		return nil, false
+	}
+
+	digest, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, false
+	}
+
+	return digest, true
+}
+
+// decodeFingerprintBase64 decodes a digest in standard base64 notation, with or without padding.
+func decodeFingerprintBase64(s string) ([]byte, bool) {
+	if s == "" {
+		return nil, false
+	}
+
+	encoding := base64.StdEncoding.Strict()
+
+	if !strings.HasSuffix(s, "=") {
+		encoding = base64.RawStdEncoding.Strict()
+	}
+
+	digest, err := encoding.DecodeString(s)
+	if err != nil {
+		return nil, false
+	}
+
+	return digest, true
+}
+
+// isFingerprintHexOctet returns true if s is exactly two hexadecimal digits.
+func isFingerprintHexOctet(s string) bool {
+	if len(s) != 2 {
+		return false
+	}
+
+	_, err := hex.DecodeString(s)
+
+	return err == nil
+}
+
+// fingerprintDigestSize returns the digest length in bytes of a supported hash algorithm.
+func fingerprintDigestSize(algorithm string) int {
+	for _, candidate := range fingerprintAlgorithms {
+		if candidate.name == algorithm {
+			return candidate.size
+		}
+	}
+
+	return 0
+}
+
+// fingerprintAlgorithmNames returns the supported hash algorithms and their digest lengths for error messages.
+func fingerprintAlgorithmNames() string {
+	names := make([]string, len(fingerprintAlgorithms))
+
+	for i, candidate := range fingerprintAlgorithms {
+		names[i] = fmt.Sprintf("%s (%d bytes)", candidate.name, candidate.size)
+	}
+
+	return strings.Join(names, ", ")
+}
+
+// NewFingerprintNull creates a Fingerprint with a null value. Determine whether the value is null via IsNull method.
+func NewFingerprintNull() Fingerprint {
+	return Fingerprint{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewFingerprintUnknown creates a Fingerprint with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewFingerprintUnknown() Fingerprint {
+	return Fingerprint{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewFingerprintValue creates a Fingerprint with a known value. Access the value via ValueString method.
+func NewFingerprintValue(value string) Fingerprint {
+	return Fingerprint{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewFingerprintPointerValue creates a Fingerprint with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewFingerprintPointerValue(value *string) Fingerprint {
+	return Fingerprint{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
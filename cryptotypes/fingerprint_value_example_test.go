@@ -0,0 +1,36 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cryptotypes_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/cryptotypes"
+)
+
+type PinnedHostKeyResourceModel struct {
+	Fingerprint cryptotypes.Fingerprint `tfsdk:"fingerprint"`
+	Algorithm   string                  `tfsdk:"algorithm"`
+}
+
+func ExampleFingerprint_ValueAlgorithm() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := PinnedHostKeyResourceModel{
+		Fingerprint: cryptotypes.NewFingerprintValue("99:63:C1:74:9C:DF:78:20:E4:A8:CF:57:CF:CA:4F:FC:47:F5:E0:B3:C6:E0:8A:19:A3:7F:5C:03:88:01:90:25"),
+	}
+
+	// Check that the Fingerprint data is known and detect its hash algorithm
+	if !data.Fingerprint.IsNull() && !data.Fingerprint.IsUnknown() {
+		algorithm, diags := data.Fingerprint.ValueAlgorithm()
+		if diags.HasError() {
+			return
+		}
+
+		data.Algorithm = algorithm
+
+		// Output: SHA256
+		fmt.Println(data.Algorithm)
+	}
+}
@@ -0,0 +1,342 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cryptotypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/cryptotypes"
+)
+
+func TestFingerprintStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentFingerprint cryptotypes.Fingerprint
+		givenFingerprint   basetypes.StringValuable
+		expectedMatch      bool
+		expectedDiags      diag.Diagnostics
+	}{
+		"semantically equal - byte-for-byte match": {
+			currentFingerprint: cryptotypes.NewFingerprintValue("SHA256:mWPBdJzfeCDkqM9Xz8pP/Ef14LPG4IoZo39cA4gBkCU"),
+			givenFingerprint:   cryptotypes.NewFingerprintValue("SHA256:mWPBdJzfeCDkqM9Xz8pP/Ef14LPG4IoZo39cA4gBkCU"),
+			expectedMatch:      true,
+		},
+		"semantically equal - base64 and colon separated hexadecimal": {
+			currentFingerprint: cryptotypes.NewFingerprintValue("SHA256:mWPBdJzfeCDkqM9Xz8pP/Ef14LPG4IoZo39cA4gBkCU"),
+			givenFingerprint:   cryptotypes.NewFingerprintValue("99:63:C1:74:9C:DF:78:20:E4:A8:CF:57:CF:CA:4F:FC:47:F5:E0:B3:C6:E0:8A:19:A3:7F:5C:03:88:01:90:25"),
+			expectedMatch:      true,
+		},
+		"semantically equal - base64 and bare hexadecimal": {
+			currentFingerprint: cryptotypes.NewFingerprintValue("SHA256:mWPBdJzfeCDkqM9Xz8pP/Ef14LPG4IoZo39cA4gBkCU"),
+			givenFingerprint:   cryptotypes.NewFingerprintValue("9963c1749cdf7820e4a8cf57cfca4ffc47f5e0b3c6e08a19a37f5c0388019025"),
+			expectedMatch:      true,
+		},
+		"semantically equal - padded base64 and lowercase prefix": {
+			currentFingerprint: cryptotypes.NewFingerprintValue("SHA256:mWPBdJzfeCDkqM9Xz8pP/Ef14LPG4IoZo39cA4gBkCU"),
+			givenFingerprint:   cryptotypes.NewFingerprintValue("sha256:mWPBdJzfeCDkqM9Xz8pP/Ef14LPG4IoZo39cA4gBkCU="),
+			expectedMatch:      true,
+		},
+		"semantically equal - prefixed and unprefixed MD5": {
+			currentFingerprint: cryptotypes.NewFingerprintValue("MD5:5d:41:40:2a:bc:4b:2a:76:b9:71:9d:91:10:17:c5:92"),
+			givenFingerprint:   cryptotypes.NewFingerprintValue("5D41402ABC4B2A76B9719D911017C592"),
+			expectedMatch:      true,
+		},
+		"semantically equal - unprefixed base64 and hexadecimal SHA1": {
+			currentFingerprint: cryptotypes.NewFingerprintValue("qvTGHdzF6KLavt4PO0gs2a6pQ00="),
+			givenFingerprint:   cryptotypes.NewFingerprintValue("aaf4c61ddcc5e8a2dabede0f3b482cd9aea9434d"),
+			expectedMatch:      true,
+		},
+		"not equal - different digest": {
+			currentFingerprint: cryptotypes.NewFingerprintValue("SHA256:mWPBdJzfeCDkqM9Xz8pP/Ef14LPG4IoZo39cA4gBkCU"),
+			givenFingerprint:   cryptotypes.NewFingerprintValue("SHA256:mZemQaH1gJtgOKBeTM/kRrOurDbuIaciI3rQYJSMdDc"),
+			expectedMatch:      false,
+		},
+		"not equal - different algorithm": {
+			currentFingerprint: cryptotypes.NewFingerprintValue("5d41402abc4b2a76b9719d911017c592"),
+			givenFingerprint:   cryptotypes.NewFingerprintValue("aaf4c61ddcc5e8a2dabede0f3b482cd9aea9434d"),
+			expectedMatch:      false,
+		},
+		"error - not given Fingerprint value": {
+			currentFingerprint: cryptotypes.NewFingerprintValue("5d41402abc4b2a76b9719d911017c592"),
+			givenFingerprint:   basetypes.NewStringValue("5d41402abc4b2a76b9719d911017c592"),
+			expectedMatch:      false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: cryptotypes.Fingerprint\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentFingerprint.StringSemanticEquals(context.Background(), testCase.givenFingerprint)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFingerprintValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		fingerprint   cryptotypes.Fingerprint
+		expectedError string
+	}{
+		"empty-struct": {
+			fingerprint: cryptotypes.Fingerprint{},
+		},
+		"null": {
+			fingerprint: cryptotypes.NewFingerprintNull(),
+		},
+		"unknown": {
+			fingerprint: cryptotypes.NewFingerprintUnknown(),
+		},
+		"valid MD5 - colon separated": {
+			fingerprint: cryptotypes.NewFingerprintValue("5d:41:40:2a:bc:4b:2a:76:b9:71:9d:91:10:17:c5:92"),
+		},
+		"valid SHA1 - bare hexadecimal": {
+			fingerprint: cryptotypes.NewFingerprintValue("AAF4C61DDCC5E8A2DABEDE0F3B482CD9AEA9434D"),
+		},
+		"valid SHA256 - prefixed base64": {
+			fingerprint: cryptotypes.NewFingerprintValue("SHA256:mWPBdJzfeCDkqM9Xz8pP/Ef14LPG4IoZo39cA4gBkCU"),
+		},
+		"valid SHA384 - prefixed base64": {
+			fingerprint: cryptotypes.NewFingerprintValue("SHA384:WeF0h3dEjGnea4ANejO7+5/xtGPkQ1TDVTvNucZm+pASWjx5+QOXvfX2oT3oKGhP"),
+		},
+		"valid SHA512 - bare hexadecimal": {
+			fingerprint: cryptotypes.NewFingerprintValue("9b71d224bd62f3785d96d46ad3ea3d73319bfbc2890caadae2dff72519673ca72323c3d99ba5c11d7c7acc6e14b8c5da0c4663475c2e5c3adef46f73bcdec043"),
+		},
+		"invalid - empty": {
+			fingerprint:   cryptotypes.NewFingerprintValue(""),
+			expectedError: "fingerprint \"\": digest must be hexadecimal, colon separated hexadecimal or base64",
+		},
+		"invalid - unsupported algorithm prefix": {
+			fingerprint: cryptotypes.NewFingerprintValue("SHA224:mWPBdJzfeCDkqM9Xz8pP/Ef14LPG4IoZo39cA4gBkCU"),
+			expectedError: "fingerprint \"SHA224:mWPBdJzfeCDkqM9Xz8pP/Ef14LPG4IoZo39cA4gBkCU\": unsupported hash algorithm \"SHA224\", " +
+				"expected one of: MD5 (16 bytes), SHA1 (20 bytes), SHA256 (32 bytes), SHA384 (48 bytes), SHA512 (64 bytes)",
+		},
+		"invalid - digest length does not match prefix": {
+			fingerprint:   cryptotypes.NewFingerprintValue("SHA256:5d41402abc4b2a76b9719d911017c592"),
+			expectedError: "fingerprint \"SHA256:5d41402abc4b2a76b9719d911017c592\": SHA256 digest must be 32 bytes, got 24",
+		},
+		"invalid - unsupported digest length": {
+			fingerprint: cryptotypes.NewFingerprintValue("5d41402abc4b2a76"),
+			expectedError: "fingerprint \"5d41402abc4b2a76\": digest length of 8 bytes does not match a supported hash algorithm, " +
+				"expected one of: MD5 (16 bytes), SHA1 (20 bytes), SHA256 (32 bytes), SHA384 (48 bytes), SHA512 (64 bytes)",
+		},
+		"invalid - uneven colon separated groups": {
+			fingerprint:   cryptotypes.NewFingerprintValue("5d:41:40:2a:bc:4b:2a:76:b9:71:9d:91:10:17:c5:9"),
+			expectedError: "fingerprint \"5d:41:40:2a:bc:4b:2a:76:b9:71:9d:91:10:17:c5:9\": digest must be hexadecimal, colon separated hexadecimal or base64",
+		},
+		"invalid - not a digest": {
+			fingerprint:   cryptotypes.NewFingerprintValue("not a fingerprint"),
+			expectedError: "fingerprint \"not a fingerprint\": digest must be hexadecimal, colon separated hexadecimal or base64",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var expectedDiags diag.Diagnostics
+
+			if testCase.expectedError != "" {
+				expectedDiags.AddAttributeError(
+					path.Root("test"),
+					"Invalid Fingerprint String Value",
+					"A string value was provided that is not valid fingerprint string format.\n\n"+
+						"Given Value: "+testCase.fingerprint.ValueString()+"\n"+
+						"Error: "+testCase.expectedError,
+				)
+			}
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.fingerprint.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{Path: path.Root("test")},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFingerprintValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		fingerprint     cryptotypes.Fingerprint
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			fingerprint: cryptotypes.Fingerprint{},
+		},
+		"null": {
+			fingerprint: cryptotypes.NewFingerprintNull(),
+		},
+		"unknown": {
+			fingerprint: cryptotypes.NewFingerprintUnknown(),
+		},
+		"valid SHA256 - prefixed base64": {
+			fingerprint: cryptotypes.NewFingerprintValue("SHA256:mWPBdJzfeCDkqM9Xz8pP/Ef14LPG4IoZo39cA4gBkCU"),
+		},
+		"invalid - digest length does not match prefix": {
+			fingerprint: cryptotypes.NewFingerprintValue("MD5:aaf4c61ddcc5e8a2dabede0f3b482cd9aea9434d"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid Fingerprint String Value: "+
+					"A string value was provided that is not valid fingerprint string format.\n\n"+
+					"Given Value: MD5:aaf4c61ddcc5e8a2dabede0f3b482cd9aea9434d\n"+
+					"Error: fingerprint \"MD5:aaf4c61ddcc5e8a2dabede0f3b482cd9aea9434d\": MD5 digest must be 16 bytes, got 30",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.fingerprint.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFingerprintValueAlgorithm(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		fingerprint       cryptotypes.Fingerprint
+		expectedAlgorithm string
+		expectedDiags     diag.Diagnostics
+	}{
+		"fingerprint value is null": {
+			fingerprint: cryptotypes.NewFingerprintNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Fingerprint ValueAlgorithm Error",
+					"fingerprint string value is null",
+				),
+			},
+		},
+		"fingerprint value is unknown": {
+			fingerprint: cryptotypes.NewFingerprintUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Fingerprint ValueAlgorithm Error",
+					"fingerprint string value is unknown",
+				),
+			},
+		},
+		"prefixed": {
+			fingerprint:       cryptotypes.NewFingerprintValue("sha256:mWPBdJzfeCDkqM9Xz8pP/Ef14LPG4IoZo39cA4gBkCU"),
+			expectedAlgorithm: cryptotypes.FingerprintAlgorithmSHA256,
+		},
+		"detected from length": {
+			fingerprint:       cryptotypes.NewFingerprintValue("aa:f4:c6:1d:dc:c5:e8:a2:da:be:de:0f:3b:48:2c:d9:ae:a9:43:4d"),
+			expectedAlgorithm: cryptotypes.FingerprintAlgorithmSHA1,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			algorithm, diags := testCase.fingerprint.ValueAlgorithm()
+
+			if algorithm != testCase.expectedAlgorithm {
+				t.Errorf("Unexpected difference in algorithm, got: %s, expected: %s", algorithm, testCase.expectedAlgorithm)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFingerprintValueDigest(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		fingerprint    cryptotypes.Fingerprint
+		expectedDigest []byte
+		expectedDiags  diag.Diagnostics
+	}{
+		"fingerprint value is null": {
+			fingerprint: cryptotypes.NewFingerprintNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Fingerprint ValueDigest Error",
+					"fingerprint string value is null",
+				),
+			},
+		},
+		"invalid fingerprint": {
+			fingerprint: cryptotypes.NewFingerprintValue("5d41402abc4b2a76"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Fingerprint ValueDigest Error",
+					"fingerprint \"5d41402abc4b2a76\": digest length of 8 bytes does not match a supported hash algorithm, "+
+						"expected one of: MD5 (16 bytes), SHA1 (20 bytes), SHA256 (32 bytes), SHA384 (48 bytes), SHA512 (64 bytes)",
+				),
+			},
+		},
+		"MD5 - colon separated": {
+			fingerprint:    cryptotypes.NewFingerprintValue("MD5:5D:41:40:2A:BC:4B:2A:76:B9:71:9D:91:10:17:C5:92"),
+			expectedDigest: []byte{0x5d, 0x41, 0x40, 0x2a, 0xbc, 0x4b, 0x2a, 0x76, 0xb9, 0x71, 0x9d, 0x91, 0x10, 0x17, 0xc5, 0x92},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			digest, diags := testCase.fingerprint.ValueDigest()
+
+			if diff := cmp.Diff(digest, testCase.expectedDigest); diff != "" {
+				t.Errorf("Unexpected difference in digest (-got, +expected): %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cryptotypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = (*PEMCertificateChainType)(nil)
+
+// PEMCertificateChainType is an attribute type that represents one or more valid X.509 certificates in PEM encoding,
+// such as a leaf certificate followed by its intermediate certificates. Semantic equality logic is defined for
+// PEMCertificateChainType, so that chains containing the same DER encoded certificates are considered equal regardless
+// of certificate order, line wrapping, line endings and surrounding whitespace.
+type PEMCertificateChainType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t PEMCertificateChainType) String() string {
+	return "cryptotypes.PEMCertificateChainType"
+}
+
+// ValueType returns the Value type.
+func (t PEMCertificateChainType) ValueType(ctx context.Context) attr.Value {
+	return PEMCertificateChain{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t PEMCertificateChainType) Equal(o attr.Type) bool {
+	other, ok := o.(PEMCertificateChainType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t PEMCertificateChainType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return PEMCertificateChain{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t PEMCertificateChainType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
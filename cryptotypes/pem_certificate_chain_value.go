@@ -0,0 +1,184 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cryptotypes
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*PEMCertificateChain)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*PEMCertificateChain)(nil)
+	_ xattr.ValidateableAttribute                = (*PEMCertificateChain)(nil)
+	_ function.ValidateableParameter             = (*PEMCertificateChain)(nil)
+)
+
+// PEMCertificateChain represents one or more valid X.509 certificates in PEM encoding, such as a leaf certificate
+// followed by its intermediate certificates. Semantic equality logic is defined for PEMCertificateChain, so that
+// chains containing the same DER encoded certificates are considered equal regardless of certificate order, line
+// wrapping, line endings and surrounding whitespace.
+type PEMCertificateChain struct {
+	basetypes.StringValue
+}
+
+// Type returns a PEMCertificateChainType.
+func (v PEMCertificateChain) Type(_ context.Context) attr.Type {
+	return PEMCertificateChainType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v PEMCertificateChain) Equal(o attr.Value) bool {
+	other, ok := o.(PEMCertificateChain)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given PEM certificate chain string value is semantically equal to the
+// current PEM certificate chain string value. This comparison decodes both values and compares the sets of DER
+// encoded certificates, so that certificate order, line wrapping, line endings and surrounding whitespace are ignored.
+func (v PEMCertificateChain) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(PEMCertificateChain)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// PEM certificate chains are already validated at this point, ignoring errors
+	newCerts, _ := parsePEMCertificates(newValue.ValueString())
+	currentCerts, _ := parsePEMCertificates(v.ValueString())
+
+	return slices.EqualFunc(sortedDERCertificates(currentCerts), sortedDERCertificates(newCerts), bytes.Equal), diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String
+// value that contains one or more PEM encoded CERTIFICATE blocks, which can each be parsed by the Go `crypto/x509`
+// library.
+func (v PEMCertificateChain) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parsePEMCertificates(v.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid PEM Certificate Chain String Value",
+			"A string value was provided that is not valid PEM certificate chain string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that contains one or more PEM encoded CERTIFICATE blocks, which can each be parsed by
+// the Go `crypto/x509` library.
+func (v PEMCertificateChain) ValidateParameter(_ context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parsePEMCertificates(v.ValueString())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid PEM Certificate Chain String Value: "+
+				"A string value was provided that is not valid PEM certificate chain string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueCertificates parses the PEMCertificateChain StringValue into x509.Certificate values, in the order they were
+// given. A null or unknown value will produce an error diagnostic.
+func (v PEMCertificateChain) ValueCertificates() ([]*x509.Certificate, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("PEMCertificateChain ValueCertificates Error", "PEM certificate chain string value is null"))
+		return nil, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("PEMCertificateChain ValueCertificates Error", "PEM certificate chain string value is unknown"))
+		return nil, diags
+	}
+
+	certs, err := parsePEMCertificates(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("PEMCertificateChain ValueCertificates Error", err.Error()))
+		return nil, diags
+	}
+
+	return certs, nil
+}
+
+// sortedDERCertificates returns the DER encodings of the given certificates in a stable sorted order.
+func sortedDERCertificates(certs []*x509.Certificate) [][]byte {
+	der := make([][]byte, len(certs))
+
+	for i, cert := range certs {
+		der[i] = cert.Raw
+	}
+
+	slices.SortFunc(der, bytes.Compare)
+
+	return der
+}
+
+// NewPEMCertificateChainNull creates a PEMCertificateChain with a null value. Determine whether the value is null via IsNull method.
+func NewPEMCertificateChainNull() PEMCertificateChain {
+	return PEMCertificateChain{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewPEMCertificateChainUnknown creates a PEMCertificateChain with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewPEMCertificateChainUnknown() PEMCertificateChain {
+	return PEMCertificateChain{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewPEMCertificateChainValue creates a PEMCertificateChain with a known value. Access the value via ValueString method.
+func NewPEMCertificateChainValue(value string) PEMCertificateChain {
+	return PEMCertificateChain{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewPEMCertificateChainPointerValue creates a PEMCertificateChain with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewPEMCertificateChainPointerValue(value *string) PEMCertificateChain {
+	return PEMCertificateChain{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
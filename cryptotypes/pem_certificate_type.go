@@ -0,0 +1,72 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cryptotypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = (*PEMCertificateType)(nil)
+
+// PEMCertificateType is an attribute type that represents a single valid X.509 certificate in PEM encoding. Semantic
+// equality logic is defined for PEMCertificateType, so that certificates with the same DER encoding are considered
+// equal regardless of line wrapping, line endings and surrounding whitespace.
+type PEMCertificateType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t PEMCertificateType) String() string {
+	return "cryptotypes.PEMCertificateType"
+}
+
+// ValueType returns the Value type.
+func (t PEMCertificateType) ValueType(ctx context.Context) attr.Value {
+	return PEMCertificate{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t PEMCertificateType) Equal(o attr.Type) bool {
+	other, ok := o.(PEMCertificateType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t PEMCertificateType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return PEMCertificate{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t PEMCertificateType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
@@ -0,0 +1,265 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cryptotypes
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/iptypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*PEMCertificate)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*PEMCertificate)(nil)
+	_ xattr.ValidateableAttribute                = (*PEMCertificate)(nil)
+	_ function.ValidateableParameter             = (*PEMCertificate)(nil)
+)
+
+// pemBlockTypeCertificate is the PEM block type of an X.509 certificate (RFC 7468 Section 5).
+const pemBlockTypeCertificate = "CERTIFICATE"
+
+// PEMCertificate represents a single valid X.509 certificate in PEM encoding. Semantic equality logic is defined for
+// PEMCertificate, so that certificates with the same DER encoding are considered equal regardless of line wrapping,
+// line endings and surrounding whitespace.
+type PEMCertificate struct {
+	basetypes.StringValue
+}
+
+// Type returns a PEMCertificateType.
+func (v PEMCertificate) Type(_ context.Context) attr.Type {
+	return PEMCertificateType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v PEMCertificate) Equal(o attr.Value) bool {
+	other, ok := o.(PEMCertificate)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given PEM certificate string value is semantically equal to the current PEM
+// certificate string value. This comparison decodes both values and compares the DER encoded certificates, so that
+// line wrapping, line endings and surrounding whitespace are ignored.
+func (v PEMCertificate) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(PEMCertificate)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// PEM certificates are already validated at this point, ignoring errors
+	newCert, _ := parsePEMCertificate(newValue.ValueString())
+	currentCert, _ := parsePEMCertificate(v.ValueString())
+
+	if newCert == nil || currentCert == nil {
+		return false, diags
+	}
+
+	return bytes.Equal(currentCert.Raw, newCert.Raw), diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String
+// value that contains exactly one PEM encoded CERTIFICATE block, which can be parsed by the Go `crypto/x509` library.
+func (v PEMCertificate) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parsePEMCertificate(v.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid PEM Certificate String Value",
+			"A string value was provided that is not valid PEM certificate string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that contains exactly one PEM encoded CERTIFICATE block, which can be parsed by the
+// Go `crypto/x509` library.
+func (v PEMCertificate) ValidateParameter(_ context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parsePEMCertificate(v.ValueString())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid PEM Certificate String Value: "+
+				"A string value was provided that is not valid PEM certificate string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueCertificate parses the PEMCertificate StringValue into an x509.Certificate. A null or unknown value will
+// produce an error diagnostic.
+func (v PEMCertificate) ValueCertificate() (*x509.Certificate, diag.Diagnostics) {
+	return v.valueCertificate("ValueCertificate")
+}
+
+// ValueIPAddressSANs returns the IP address subject alternative names of the certificate as iptypes.IPAddress values.
+// A null or unknown value will produce an error diagnostic.
+func (v PEMCertificate) ValueIPAddressSANs() ([]iptypes.IPAddress, diag.Diagnostics) {
+	cert, diags := v.valueCertificate("ValueIPAddressSANs")
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	ipAddresses := make([]iptypes.IPAddress, 0, len(cert.IPAddresses))
+
+	for _, ip := range cert.IPAddresses {
+		ipAddresses = append(ipAddresses, iptypes.NewIPAddressValue(ip.String()))
+	}
+
+	return ipAddresses, nil
+}
+
+// ValueDNSNameSANs returns the DNS name subject alternative names of the certificate, which may include wildcard
+// names such as *.example.com. A null or unknown value will produce an error diagnostic.
+func (v PEMCertificate) ValueDNSNameSANs() ([]string, diag.Diagnostics) {
+	cert, diags := v.valueCertificate("ValueDNSNameSANs")
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return cert.DNSNames, nil
+}
+
+// valueCertificate parses the PEMCertificate StringValue, with diagnostics for the given accessor method name.
+func (v PEMCertificate) valueCertificate(method string) (*x509.Certificate, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("PEMCertificate "+method+" Error", "PEM certificate string value is null"))
+		return nil, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("PEMCertificate "+method+" Error", "PEM certificate string value is unknown"))
+		return nil, diags
+	}
+
+	cert, err := parsePEMCertificate(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("PEMCertificate "+method+" Error", err.Error()))
+		return nil, diags
+	}
+
+	return cert, nil
+}
+
+// parsePEMCertificate decodes and parses a string containing exactly one PEM encoded certificate.
+func parsePEMCertificate(s string) (*x509.Certificate, error) {
+	certs, err := parsePEMCertificates(s)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(certs) != 1 {
+		return nil, fmt.Errorf("PEM certificate must contain exactly one %s block, got %d", pemBlockTypeCertificate, len(certs))
+	}
+
+	return certs[0], nil
+}
+
+// parsePEMCertificates decodes and parses a string containing one or more PEM encoded certificates. Whitespace
+// between blocks is permitted, but any other content is not.
+func parsePEMCertificates(s string) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+
+	rest := bytes.TrimSpace([]byte(s))
+
+	for len(rest) > 0 {
+		var block *pem.Block
+
+		// pem.Decode skips any content preceding a block, which is rejected here instead
+		if bytes.HasPrefix(rest, []byte("-----BEGIN ")) {
+			block, rest = pem.Decode(rest)
+			rest = bytes.TrimSpace(rest)
+		}
+
+		if block == nil {
+			return nil, errors.New("PEM certificate contains data that is not a PEM encoded block")
+		}
+
+		if block.Type != pemBlockTypeCertificate {
+			return nil, fmt.Errorf("PEM block %d has type %q, expected %s", len(certs)+1, block.Type, pemBlockTypeCertificate)
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("PEM block %d: %w", len(certs)+1, err)
+		}
+
+		certs = append(certs, cert)
+	}
+
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("PEM certificate must contain a %s block", pemBlockTypeCertificate)
+	}
+
+	return certs, nil
+}
+
+// NewPEMCertificateNull creates a PEMCertificate with a null value. Determine whether the value is null via IsNull method.
+func NewPEMCertificateNull() PEMCertificate {
+	return PEMCertificate{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewPEMCertificateUnknown creates a PEMCertificate with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewPEMCertificateUnknown() PEMCertificate {
+	return PEMCertificate{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewPEMCertificateValue creates a PEMCertificate with a known value. Access the value via ValueString method.
+func NewPEMCertificateValue(value string) PEMCertificate {
+	return PEMCertificate{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewPEMCertificatePointerValue creates a PEMCertificate with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewPEMCertificatePointerValue(value *string) PEMCertificate {
+	return PEMCertificate{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cryptotypes_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/cryptotypes"
+)
+
+type TLSListenerResourceModel struct {
+	Certificate      cryptotypes.PEMCertificate      `tfsdk:"certificate"`
+	CertificateChain cryptotypes.PEMCertificateChain `tfsdk:"certificate_chain"`
+}
+
+func ExamplePEMCertificate_ValueIPAddressSANs() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := TLSListenerResourceModel{
+		Certificate: cryptotypes.NewPEMCertificateValue(testPEMLeafCertificate),
+	}
+
+	// Check that the PEMCertificate data is known and read its IP address subject alternative names
+	if !data.Certificate.IsNull() && !data.Certificate.IsUnknown() {
+		ipAddresses, diags := data.Certificate.ValueIPAddressSANs()
+		if diags.HasError() {
+			return
+		}
+
+		for _, ipAddress := range ipAddresses {
+			fmt.Println(ipAddress.ValueString())
+		}
+
+		// Output:
+		// 192.0.2.1
+		// 2001:db8::1
+	}
+}
+
+func ExamplePEMCertificateChain_ValueCertificates() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := TLSListenerResourceModel{
+		CertificateChain: cryptotypes.NewPEMCertificateChainValue(testPEMLeafCertificate + testPEMCACertificate),
+	}
+
+	// Check that the PEMCertificateChain data is known and parse each certificate
+	if !data.CertificateChain.IsNull() && !data.CertificateChain.IsUnknown() {
+		certs, diags := data.CertificateChain.ValueCertificates()
+		if diags.HasError() {
+			return
+		}
+
+		for _, cert := range certs {
+			fmt.Println(cert.Subject.CommonName)
+		}
+
+		// Output:
+		// example.com
+		// Example CA
+	}
+}
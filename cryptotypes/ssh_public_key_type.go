@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cryptotypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = (*SSHPublicKeyType)(nil)
+
+// SSHPublicKeyType is an attribute type that represents a valid SSH public key in the OpenSSH authorized_keys format
+// of a key type, base64 encoded key blob and optional comment. Semantic equality logic is defined for
+// SSHPublicKeyType, so that keys with a differing or missing comment and varying whitespace are considered equal.
+//
+// All of the following are semantically equal:
+//   - ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIJV01RkGQDa2y/XWUWeLkwWq8msTquVCcm88nLXjSe5s user@example.com
+//   - ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIJV01RkGQDa2y/XWUWeLkwWq8msTquVCcm88nLXjSe5s
+//   - "ssh-ed25519  AAAAC3NzaC1lZDI1NTE5AAAAIJV01RkGQDa2y/XWUWeLkwWq8msTquVCcm88nLXjSe5s deploy key\n"
+type SSHPublicKeyType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t SSHPublicKeyType) String() string {
+	return "cryptotypes.SSHPublicKeyType"
+}
+
+// ValueType returns the Value type.
+func (t SSHPublicKeyType) ValueType(ctx context.Context) attr.Value {
+	return SSHPublicKey{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t SSHPublicKeyType) Equal(o attr.Type) bool {
+	other, ok := o.(SSHPublicKeyType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t SSHPublicKeyType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return SSHPublicKey{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t SSHPublicKeyType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
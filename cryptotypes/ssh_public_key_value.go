@@ -0,0 +1,386 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cryptotypes
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdh"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*SSHPublicKey)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*SSHPublicKey)(nil)
+	_ xattr.ValidateableAttribute                = (*SSHPublicKey)(nil)
+	_ function.ValidateableParameter             = (*SSHPublicKey)(nil)
+)
+
+const (
+	// SSHKeyTypeEd25519 is an Ed25519 public key (RFC 8709).
+	SSHKeyTypeEd25519 = "ssh-ed25519"
+
+	// SSHKeyTypeECDSAP256 is an ECDSA public key on the NIST P-256 curve (RFC 5656).
+	SSHKeyTypeECDSAP256 = "ecdsa-sha2-nistp256"
+
+	// SSHKeyTypeECDSAP384 is an ECDSA public key on the NIST P-384 curve (RFC 5656).
+	SSHKeyTypeECDSAP384 = "ecdsa-sha2-nistp384"
+
+	// SSHKeyTypeECDSAP521 is an ECDSA public key on the NIST P-521 curve (RFC 5656).
+	SSHKeyTypeECDSAP521 = "ecdsa-sha2-nistp521"
+
+	// SSHKeyTypeRSA is an RSA public key (RFC 4253).
+	SSHKeyTypeRSA = "ssh-rsa"
+
+	// SSHKeyTypeSKEd25519 is an Ed25519 public key held by a FIDO/U2F security key (OpenSSH PROTOCOL.u2f).
+	SSHKeyTypeSKEd25519 = "sk-ssh-ed25519@openssh.com"
+
+	// SSHKeyTypeSKECDSAP256 is an ECDSA P-256 public key held by a FIDO/U2F security key (OpenSSH PROTOCOL.u2f).
+	SSHKeyTypeSKECDSAP256 = "sk-ecdsa-sha2-nistp256@openssh.com"
+)
+
+// SSHPublicKey represents a valid SSH public key in the OpenSSH authorized_keys format of a key type, base64 encoded
+// key blob and optional comment. The ssh-ed25519, ecdsa-sha2-nistp256, ecdsa-sha2-nistp384, ecdsa-sha2-nistp521,
+// ssh-rsa, sk-ssh-ed25519@openssh.com and sk-ecdsa-sha2-nistp256@openssh.com key types are supported. The size of an
+// RSA modulus is not checked, so providers that require a minimum RSA key size must validate it separately. Semantic
+// equality logic is defined for SSHPublicKey, so that keys with a differing or missing comment and varying whitespace
+// are considered equal.
+//
+// All of the following are semantically equal:
+//   - ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIJV01RkGQDa2y/XWUWeLkwWq8msTquVCcm88nLXjSe5s user@example.com
+//   - ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIJV01RkGQDa2y/XWUWeLkwWq8msTquVCcm88nLXjSe5s
+//   - "ssh-ed25519  AAAAC3NzaC1lZDI1NTE5AAAAIJV01RkGQDa2y/XWUWeLkwWq8msTquVCcm88nLXjSe5s deploy key\n"
+type SSHPublicKey struct {
+	basetypes.StringValue
+}
+
+// Type returns an SSHPublicKeyType.
+func (v SSHPublicKey) Type(_ context.Context) attr.Type {
+	return SSHPublicKeyType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v SSHPublicKey) Equal(o attr.Value) bool {
+	other, ok := o.(SSHPublicKey)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given SSH public key string value is semantically equal to the current SSH
+// public key string value. This comparison decodes both values into their key blobs, so that keys with a differing or
+// missing comment and varying whitespace are considered equal.
+//
+// All of the following are semantically equal:
+//   - ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIJV01RkGQDa2y/XWUWeLkwWq8msTquVCcm88nLXjSe5s user@example.com
+//   - ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIJV01RkGQDa2y/XWUWeLkwWq8msTquVCcm88nLXjSe5s
+//   - "ssh-ed25519  AAAAC3NzaC1lZDI1NTE5AAAAIJV01RkGQDa2y/XWUWeLkwWq8msTquVCcm88nLXjSe5s deploy key\n"
+func (v SSHPublicKey) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(SSHPublicKey)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// SSH public keys are already validated at this point, ignoring errors
+	_, newBlob, _ := parseSSHPublicKey(newValue.ValueString())
+	_, currentBlob, _ := parseSSHPublicKey(v.ValueString())
+
+	return bytes.Equal(currentBlob, newBlob), diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String
+// value that is a valid SSH public key of a supported key type, where the base64 encoded key blob is well-formed for
+// that key type. RSA keys of any modulus size are accepted.
+func (v SSHPublicKey) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, _, err := parseSSHPublicKey(v.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid SSH Public Key String Value",
+			"A string value was provided that is not valid SSH public key string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is a valid SSH public key of a supported key type, where the base64 encoded key
+// blob is well-formed for that key type. RSA keys of any modulus size are accepted.
+func (v SSHPublicKey) ValidateParameter(_ context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, _, err := parseSSHPublicKey(v.ValueString())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid SSH Public Key String Value: "+
+				"A string value was provided that is not valid SSH public key string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueKeyType returns the key type of the SSHPublicKey StringValue, such as ssh-ed25519. A null or unknown value will
+// produce an error diagnostic.
+func (v SSHPublicKey) ValueKeyType() (string, diag.Diagnostics) {
+	keyType, _, diags := v.valueSSHPublicKey("ValueKeyType")
+
+	return keyType, diags
+}
+
+// ValueFingerprintSHA256 returns the SHA256 fingerprint of the SSHPublicKey StringValue in the format printed by
+// `ssh-keygen -l`, such as SHA256:mWPBdJzfeCDkqM9Xz8pP/Ef14LPG4IoZo39cA4gBkCU. A null or unknown value will produce an
+// error diagnostic.
+func (v SSHPublicKey) ValueFingerprintSHA256() (string, diag.Diagnostics) {
+	_, blob, diags := v.valueSSHPublicKey("ValueFingerprintSHA256")
+	if diags.HasError() {
+		return "", diags
+	}
+
+	digest := sha256.Sum256(blob)
+
+	return "SHA256:" + base64.RawStdEncoding.EncodeToString(digest[:]), nil
+}
+
+// valueSSHPublicKey parses the SSHPublicKey StringValue into its key type and blob, with diagnostics for the given
+// accessor method name.
+func (v SSHPublicKey) valueSSHPublicKey(method string) (string, []byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("SSHPublicKey "+method+" Error", "SSH public key string value is null"))
+		return "", nil, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("SSHPublicKey "+method+" Error", "SSH public key string value is unknown"))
+		return "", nil, diags
+	}
+
+	keyType, blob, err := parseSSHPublicKey(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("SSHPublicKey "+method+" Error", err.Error()))
+		return "", nil, diags
+	}
+
+	return keyType, blob, nil
+}
+
+// parseSSHPublicKey parses an SSH public key in authorized_keys format into its key type and decoded key blob,
+// discarding any comment, and validates the blob contents for the key type.
+func parseSSHPublicKey(s string) (string, []byte, error) {
+	fields := strings.Fields(s)
+
+	if len(fields) < 2 {
+		return "", nil, errors.New("SSH public key must be a key type and base64 encoded key, optionally followed by a comment")
+	}
+
+	keyType := fields[0]
+
+	blob, err := base64.StdEncoding.DecodeString(fields[1])
+	if err != nil {
+		return "", nil, fmt.Errorf("SSH public key %s: key is not valid base64", keyType)
+	}
+
+	err = validateSSHPublicKeyBlob(keyType, blob)
+	if err != nil {
+		return "", nil, fmt.Errorf("SSH public key %s: %w", keyType, err)
+	}
+
+	return keyType, blob, nil
+}
+
+// validateSSHPublicKeyBlob validates the SSH wire format encoding of a public key (RFC 4253 Section 6.6).
+func validateSSHPublicKeyBlob(keyType string, blob []byte) error {
+	r := sshWireReader(blob)
+
+	blobKeyType, ok := r.readString()
+	if !ok {
+		return errors.New("key is truncated")
+	}
+
+	if string(blobKeyType) != keyType {
+		return fmt.Errorf("key type does not match encoded key type %q", blobKeyType)
+	}
+
+	switch keyType {
+	case SSHKeyTypeEd25519, SSHKeyTypeSKEd25519:
+		publicKey, ok := r.readString()
+		if !ok {
+			return errors.New("key is truncated")
+		}
+
+		if len(publicKey) != 32 {
+			return fmt.Errorf("Ed25519 key must be 32 bytes, got %d", len(publicKey))
+		}
+	case SSHKeyTypeECDSAP256, SSHKeyTypeECDSAP384, SSHKeyTypeECDSAP521, SSHKeyTypeSKECDSAP256:
+		curveName, curve := "nistp256", ecdh.P256()
+
+		switch keyType {
+		case SSHKeyTypeECDSAP384:
+			curveName, curve = "nistp384", ecdh.P384()
+		case SSHKeyTypeECDSAP521:
+			curveName, curve = "nistp521", ecdh.P521()
+		}
+
+		blobCurveName, ok := r.readString()
+		if !ok {
+			return errors.New("key is truncated")
+		}
+
+		if string(blobCurveName) != curveName {
+			return fmt.Errorf("key curve must be %s, got %q", curveName, blobCurveName)
+		}
+
+		point, ok := r.readString()
+		if !ok {
+			return errors.New("key is truncated")
+		}
+
+		_, err := curve.NewPublicKey(point)
+		if err != nil {
+			return fmt.Errorf("ECDSA key is not a valid %s point", curveName)
+		}
+	case SSHKeyTypeRSA:
+		exponent, ok := r.readMPInt()
+		if !ok {
+			return errors.New("key is truncated")
+		}
+
+		modulus, ok := r.readMPInt()
+		if !ok {
+			return errors.New("key is truncated")
+		}
+
+		if exponent.Sign() <= 0 || modulus.Sign() <= 0 {
+			return errors.New("RSA key exponent and modulus must be positive")
+		}
+	default:
+		return fmt.Errorf("unsupported key type, expected one of: %s", strings.Join([]string{
+			SSHKeyTypeEd25519,
+			SSHKeyTypeECDSAP256,
+			SSHKeyTypeECDSAP384,
+			SSHKeyTypeECDSAP521,
+			SSHKeyTypeRSA,
+			SSHKeyTypeSKEd25519,
+			SSHKeyTypeSKECDSAP256,
+		}, ", "))
+	}
+
+	// Security key types are followed by the FIDO application string, usually "ssh:"
+	if strings.HasPrefix(keyType, "sk-") {
+		if _, ok := r.readString(); !ok {
+			return errors.New("key is truncated")
+		}
+	}
+
+	if len(r) != 0 {
+		return errors.New("key contains unexpected trailing data")
+	}
+
+	return nil
+}
+
+// sshWireReader reads values from the SSH wire format (RFC 4251 Section 5).
+type sshWireReader []byte
+
+// readString reads a uint32 length-prefixed byte string.
+func (r *sshWireReader) readString() ([]byte, bool) {
+	if len(*r) < 4 {
+		return nil, false
+	}
+
+	length := binary.BigEndian.Uint32(*r)
+
+	if uint64(len(*r)-4) < uint64(length) {
+		return nil, false
+	}
+
+	value := (*r)[4 : 4+length]
+	*r = (*r)[4+length:]
+
+	return value, true
+}
+
+// readMPInt reads a two's complement multiple precision integer.
+func (r *sshWireReader) readMPInt() (*big.Int, bool) {
+	value, ok := r.readString()
+	if !ok {
+		return nil, false
+	}
+
+	result := new(big.Int).SetBytes(value)
+
+	// Negative values have the most significant bit set
+	if len(value) > 0 && value[0]&0x80 != 0 {
+		result.Sub(result, new(big.Int).Lsh(big.NewInt(1), uint(len(value))*8))
+	}
+
+	return result, true
+}
+
+// NewSSHPublicKeyNull creates an SSHPublicKey with a null value. Determine whether the value is null via IsNull method.
+func NewSSHPublicKeyNull() SSHPublicKey {
+	return SSHPublicKey{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewSSHPublicKeyUnknown creates an SSHPublicKey with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewSSHPublicKeyUnknown() SSHPublicKey {
+	return SSHPublicKey{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewSSHPublicKeyValue creates an SSHPublicKey with a known value. Access the value via ValueString method.
+func NewSSHPublicKeyValue(value string) SSHPublicKey {
+	return SSHPublicKey{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewSSHPublicKeyPointerValue creates an SSHPublicKey with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewSSHPublicKeyPointerValue(value *string) SSHPublicKey {
+	return SSHPublicKey{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
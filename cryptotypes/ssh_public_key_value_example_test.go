@@ -0,0 +1,36 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cryptotypes_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/cryptotypes"
+)
+
+type AuthorizedKeyResourceModel struct {
+	PublicKey   cryptotypes.SSHPublicKey `tfsdk:"public_key"`
+	Fingerprint string                   `tfsdk:"fingerprint"`
+}
+
+func ExampleSSHPublicKey_ValueFingerprintSHA256() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := AuthorizedKeyResourceModel{
+		PublicKey: cryptotypes.NewSSHPublicKeyValue("ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIJV01RkGQDa2y/XWUWeLkwWq8msTquVCcm88nLXjSe5s user@example.com"),
+	}
+
+	// Check that the SSHPublicKey data is known and compute its fingerprint
+	if !data.PublicKey.IsNull() && !data.PublicKey.IsUnknown() {
+		fingerprint, diags := data.PublicKey.ValueFingerprintSHA256()
+		if diags.HasError() {
+			return
+		}
+
+		data.Fingerprint = fingerprint
+
+		// Output: SHA256:mWPBdJzfeCDkqM9Xz8pP/Ef14LPG4IoZo39cA4gBkCU
+		fmt.Println(data.Fingerprint)
+	}
+}
@@ -0,0 +1,352 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cryptotypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/cryptotypes"
+)
+
+const (
+	testSSHEd25519Key   = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIJV01RkGQDa2y/XWUWeLkwWq8msTquVCcm88nLXjSe5s"
+	testSSHECDSAP256Key = "ecdsa-sha2-nistp256 AAAAE2VjZHNhLXNoYTItbmlzdHAyNTYAAAAIbmlzdHAyNTYAAABBBHZhZoL7wJNDUDIDvL+a0G5yk84BQHD+wjzXN2c3MjxqVkTbFZ+2VJldfWlNeWyuZ97ZzOSMP8sF9uiOnjYciXk="
+	testSSHECDSAP384Key = "ecdsa-sha2-nistp384 AAAAE2VjZHNhLXNoYTItbmlzdHAzODQAAAAIbmlzdHAzODQAAABhBJZYgzH/fxyhK7tcrzZ9gjrS1URZ4qZEUmy1+8RFP/lAgX5XOf0HlAfxDC0fYJy9ERfhJ8z7VdB7nhMS6/1e5ipfSIULFsV/S1VlRzlTEkbKOtySPuhsUsxgsKW/BCFSwA=="
+	testSSHECDSAP521Key = "ecdsa-sha2-nistp521 AAAAE2VjZHNhLXNoYTItbmlzdHA1MjEAAAAIbmlzdHA1MjEAAACFBADLisQBU5/DpS/b7py3kV+8ydwrWDwB8k/ihHylHkZ4xyiPizawm19vBSmVUe0lMfFFFefAnB0tPDTI1O/Xpu2dKQBSQ+P2rdMWBTWuV83uwkl5Tau6eNRQ/VfCf/a7P5qP+s/lfu6eqjcTJfDOHy9OX2kG1OkzA8yOCAp3y/hxw3WBrQ=="
+	testSSHRSAKey       = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQDIo+syXpYmaEpgLx1cEKpY33nEqedmAhMC1ZlVGDuvY991h1r1VMQHGif2BahlPhO2iIleg1aDcD3o4AMeqPyY46U8rDowow4ScYOvutgUocHR+C9ZIWYhll+vCc52QAhi1TE95RXtKl9UUcY6Qu7VS6k1/GrN7bHffGxkFFSOwAte6FO80PkHqegzbv5EJcZBOIPDQr9yPOOjaxBDk8b0iwxECUjaSGffd58o3IkYcqHTaFtb+/k+f9H6K7zyRW+Hipd54TO7GidfmhCTm/5zzzGBM9HRXdRGDFncPcKV5AbNdFNGQ73Z+0i9dcrAP/5M1w4yTXpbwXali9QGXlvH"
+	testSSHSKEd25519Key = "sk-ssh-ed25519@openssh.com AAAAGnNrLXNzaC1lZDI1NTE5QG9wZW5zc2guY29tAAAAIJV01RkGQDa2y/XWUWeLkwWq8msTquVCcm88nLXjSe5sAAAABHNzaDo="
+)
+
+func TestSSHPublicKeyStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentKey    cryptotypes.SSHPublicKey
+		givenKey      basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"semantically equal - byte-for-byte match": {
+			currentKey:    cryptotypes.NewSSHPublicKeyValue(testSSHEd25519Key + " user@example.com"),
+			givenKey:      cryptotypes.NewSSHPublicKeyValue(testSSHEd25519Key + " user@example.com"),
+			expectedMatch: true,
+		},
+		"semantically equal - comment removed": {
+			currentKey:    cryptotypes.NewSSHPublicKeyValue(testSSHEd25519Key + " user@example.com"),
+			givenKey:      cryptotypes.NewSSHPublicKeyValue(testSSHEd25519Key),
+			expectedMatch: true,
+		},
+		"semantically equal - comment changed": {
+			currentKey:    cryptotypes.NewSSHPublicKeyValue(testSSHRSAKey + " user@example.com"),
+			givenKey:      cryptotypes.NewSSHPublicKeyValue(testSSHRSAKey + " deploy key"),
+			expectedMatch: true,
+		},
+		"semantically equal - whitespace": {
+			currentKey:    cryptotypes.NewSSHPublicKeyValue("ssh-ed25519\tAAAAC3NzaC1lZDI1NTE5AAAAIJV01RkGQDa2y/XWUWeLkwWq8msTquVCcm88nLXjSe5s  user@example.com\n"),
+			givenKey:      cryptotypes.NewSSHPublicKeyValue(testSSHEd25519Key),
+			expectedMatch: true,
+		},
+		"not equal - different keys": {
+			currentKey:    cryptotypes.NewSSHPublicKeyValue(testSSHEd25519Key),
+			givenKey:      cryptotypes.NewSSHPublicKeyValue(testSSHSKEd25519Key),
+			expectedMatch: false,
+		},
+		"error - not given SSHPublicKey value": {
+			currentKey:    cryptotypes.NewSSHPublicKeyValue(testSSHEd25519Key),
+			givenKey:      basetypes.NewStringValue(testSSHEd25519Key),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: cryptotypes.SSHPublicKey\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentKey.StringSemanticEquals(context.Background(), testCase.givenKey)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSSHPublicKeyValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		keyValue      cryptotypes.SSHPublicKey
+		expectedError string
+	}{
+		"empty-struct": {
+			keyValue: cryptotypes.SSHPublicKey{},
+		},
+		"null": {
+			keyValue: cryptotypes.NewSSHPublicKeyNull(),
+		},
+		"unknown": {
+			keyValue: cryptotypes.NewSSHPublicKeyUnknown(),
+		},
+		"valid ssh-ed25519": {
+			keyValue: cryptotypes.NewSSHPublicKeyValue(testSSHEd25519Key + " user@example.com"),
+		},
+		"valid ecdsa-sha2-nistp256": {
+			keyValue: cryptotypes.NewSSHPublicKeyValue(testSSHECDSAP256Key),
+		},
+		"valid ecdsa-sha2-nistp384": {
+			keyValue: cryptotypes.NewSSHPublicKeyValue(testSSHECDSAP384Key),
+		},
+		"valid ecdsa-sha2-nistp521": {
+			keyValue: cryptotypes.NewSSHPublicKeyValue(testSSHECDSAP521Key),
+		},
+		"valid ssh-rsa": {
+			keyValue: cryptotypes.NewSSHPublicKeyValue(testSSHRSAKey),
+		},
+		"valid sk-ssh-ed25519@openssh.com": {
+			keyValue: cryptotypes.NewSSHPublicKeyValue(testSSHSKEd25519Key + " yubikey"),
+		},
+		"invalid SSH public key - missing key": {
+			keyValue:      cryptotypes.NewSSHPublicKeyValue("ssh-ed25519"),
+			expectedError: "SSH public key must be a key type and base64 encoded key, optionally followed by a comment",
+		},
+		"invalid SSH public key - invalid base64": {
+			keyValue:      cryptotypes.NewSSHPublicKeyValue("ssh-ed25519 AAAA!"),
+			expectedError: "SSH public key ssh-ed25519: key is not valid base64",
+		},
+		"invalid SSH public key - unsupported key type": {
+			keyValue:      cryptotypes.NewSSHPublicKeyValue("ssh-dss AAAAB3NzaC1kc3M="),
+			expectedError: "SSH public key ssh-dss: unsupported key type, expected one of: ssh-ed25519, ecdsa-sha2-nistp256, ecdsa-sha2-nistp384, ecdsa-sha2-nistp521, ssh-rsa, sk-ssh-ed25519@openssh.com, sk-ecdsa-sha2-nistp256@openssh.com",
+		},
+		"invalid SSH public key - key type mismatch": {
+			keyValue:      cryptotypes.NewSSHPublicKeyValue("ssh-rsa AAAAC3NzaC1lZDI1NTE5AAAAIJV01RkGQDa2y/XWUWeLkwWq8msTquVCcm88nLXjSe5s"),
+			expectedError: "SSH public key ssh-rsa: key type does not match encoded key type \"ssh-ed25519\"",
+		},
+		"invalid SSH public key - truncated": {
+			keyValue:      cryptotypes.NewSSHPublicKeyValue("ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIJV01RkGQDa2y/XWUWeLkwWq8msTquVCcm88nLU="),
+			expectedError: "SSH public key ssh-ed25519: key is truncated",
+		},
+		"invalid SSH public key - trailing data": {
+			keyValue:      cryptotypes.NewSSHPublicKeyValue("ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIJV01RkGQDa2y/XWUWeLkwWq8msTquVCcm88nLXjSe5sAA=="),
+			expectedError: "SSH public key ssh-ed25519: key contains unexpected trailing data",
+		},
+		"invalid SSH public key - ECDSA point not on curve": {
+			keyValue:      cryptotypes.NewSSHPublicKeyValue("ecdsa-sha2-nistp256 AAAAE2VjZHNhLXNoYTItbmlzdHAyNTYAAAAIbmlzdHAyNTYAAABBBHZhZoL7wJNDUDIDvL+a0G5yk84BQHD+wjzXN2c3MjxqVkTbFZ+2VJldfWlNeWyuZ97ZzOSMP8sF9uiOnjYciXg="),
+			expectedError: "SSH public key ecdsa-sha2-nistp256: ECDSA key is not a valid nistp256 point",
+		},
+		"valid SSH public key - RSA modulus size is not checked": {
+			keyValue: cryptotypes.NewSSHPublicKeyValue("ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAAAQQCAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAB"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var expectedDiags diag.Diagnostics
+
+			if testCase.expectedError != "" {
+				expectedDiags.AddAttributeError(
+					path.Root("test"),
+					"Invalid SSH Public Key String Value",
+					"A string value was provided that is not valid SSH public key string format.\n\n"+
+						"Given Value: "+testCase.keyValue.ValueString()+"\n"+
+						"Error: "+testCase.expectedError,
+				)
+			}
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.keyValue.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{Path: path.Root("test")},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSSHPublicKeyValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		keyValue        cryptotypes.SSHPublicKey
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			keyValue: cryptotypes.SSHPublicKey{},
+		},
+		"null": {
+			keyValue: cryptotypes.NewSSHPublicKeyNull(),
+		},
+		"unknown": {
+			keyValue: cryptotypes.NewSSHPublicKeyUnknown(),
+		},
+		"valid SSH public key": {
+			keyValue: cryptotypes.NewSSHPublicKeyValue(testSSHEd25519Key),
+		},
+		"invalid SSH public key - missing key": {
+			keyValue: cryptotypes.NewSSHPublicKeyValue("ssh-ed25519"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid SSH Public Key String Value: "+
+					"A string value was provided that is not valid SSH public key string format.\n\n"+
+					"Given Value: ssh-ed25519\n"+
+					"Error: SSH public key must be a key type and base64 encoded key, optionally followed by a comment",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.keyValue.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSSHPublicKeyValueKeyType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		keyValue        cryptotypes.SSHPublicKey
+		expectedKeyType string
+		expectedDiags   diag.Diagnostics
+	}{
+		"SSH public key value is null": {
+			keyValue: cryptotypes.NewSSHPublicKeyNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"SSHPublicKey ValueKeyType Error",
+					"SSH public key string value is null",
+				),
+			},
+		},
+		"SSH public key value is unknown": {
+			keyValue: cryptotypes.NewSSHPublicKeyUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"SSHPublicKey ValueKeyType Error",
+					"SSH public key string value is unknown",
+				),
+			},
+		},
+		"ecdsa-sha2-nistp384": {
+			keyValue:        cryptotypes.NewSSHPublicKeyValue(testSSHECDSAP384Key + " user@example.com"),
+			expectedKeyType: cryptotypes.SSHKeyTypeECDSAP384,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			keyType, diags := testCase.keyValue.ValueKeyType()
+
+			if keyType != testCase.expectedKeyType {
+				t.Errorf("Unexpected difference in key type, got: %s, expected: %s", keyType, testCase.expectedKeyType)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSSHPublicKeyValueFingerprintSHA256(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		keyValue            cryptotypes.SSHPublicKey
+		expectedFingerprint string
+		expectedDiags       diag.Diagnostics
+	}{
+		"SSH public key value is null": {
+			keyValue: cryptotypes.NewSSHPublicKeyNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"SSHPublicKey ValueFingerprintSHA256 Error",
+					"SSH public key string value is null",
+				),
+			},
+		},
+		"invalid SSH public key": {
+			keyValue: cryptotypes.NewSSHPublicKeyValue("ssh-ed25519"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"SSHPublicKey ValueFingerprintSHA256 Error",
+					"SSH public key must be a key type and base64 encoded key, optionally followed by a comment",
+				),
+			},
+		},
+		// Expected fingerprints are the output of ssh-keygen -l
+		"ssh-ed25519": {
+			keyValue:            cryptotypes.NewSSHPublicKeyValue(testSSHEd25519Key + " user@example.com"),
+			expectedFingerprint: "SHA256:mWPBdJzfeCDkqM9Xz8pP/Ef14LPG4IoZo39cA4gBkCU",
+		},
+		"ecdsa-sha2-nistp256": {
+			keyValue:            cryptotypes.NewSSHPublicKeyValue(testSSHECDSAP256Key),
+			expectedFingerprint: "SHA256:mZemQaH1gJtgOKBeTM/kRrOurDbuIaciI3rQYJSMdDc",
+		},
+		"ssh-rsa": {
+			keyValue:            cryptotypes.NewSSHPublicKeyValue(testSSHRSAKey),
+			expectedFingerprint: "SHA256:HXazqKqeZZGdhc5vwQqoeNtBjvCiUK6FRgDu51WUnHs",
+		},
+		"sk-ssh-ed25519@openssh.com": {
+			keyValue:            cryptotypes.NewSSHPublicKeyValue(testSSHSKEd25519Key),
+			expectedFingerprint: "SHA256:RgoypyNvPpAebplYm5FMrS0Dp3ofMQqpdKRlMFElPu0",
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			fingerprint, diags := testCase.keyValue.ValueFingerprintSHA256()
+
+			if fingerprint != testCase.expectedFingerprint {
+				t.Errorf("Unexpected difference in fingerprint, got: %s, expected: %s", fingerprint, testCase.expectedFingerprint)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cryptotypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = (*TLSVersionType)(nil)
+
+// TLSVersionType is an attribute type that represents a valid TLS protocol version, in OpenSSL notation such as
+// TLSv1.2, underscore notation such as tls1_2, a bare version number such as 1.2 or a hexadecimal protocol version
+// such as 0x0303. Semantic equality logic is defined for TLSVersionType, so that different notations of the same
+// protocol version are considered equal.
+//
+// All of the following are semantically equal:
+//   - TLSv1.2
+//   - tls1_2
+//   - 1.2
+//   - 0x0303
+type TLSVersionType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t TLSVersionType) String() string {
+	return "cryptotypes.TLSVersionType"
+}
+
+// ValueType returns the Value type.
+func (t TLSVersionType) ValueType(ctx context.Context) attr.Value {
+	return TLSVersion{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t TLSVersionType) Equal(o attr.Type) bool {
+	other, ok := o.(TLSVersionType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t TLSVersionType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return TLSVersion{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t TLSVersionType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
@@ -0,0 +1,247 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cryptotypes
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*TLSVersion)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*TLSVersion)(nil)
+	_ xattr.ValidateableAttribute                = (*TLSVersion)(nil)
+	_ function.ValidateableParameter             = (*TLSVersion)(nil)
+)
+
+// tlsVersions maps normalized protocol version names, as returned by normalizeTLSVersion, to their protocol version
+// IDs. SSL 3.0 is not referenced via crypto/tls, as its constant is deprecated.
+var tlsVersions = []struct {
+	name string
+	id   uint16
+}{
+	{"ssl3.0", 0x0300},
+	{"tls1.0", tls.VersionTLS10},
+	{"tls1.1", tls.VersionTLS11},
+	{"tls1.2", tls.VersionTLS12},
+	{"tls1.3", tls.VersionTLS13},
+}
+
+// TLSVersion represents a valid TLS protocol version, in OpenSSL notation such as TLSv1.2, underscore notation such
+// as tls1_2, a bare version number such as 1.2 or a hexadecimal protocol version such as 0x0303. SSLv3 and TLSv1 to
+// TLSv1.3 are supported, where TLSv1 and TLSv1.0 are the same protocol version. Semantic equality logic is defined for
+// TLSVersion, so that different notations of the same protocol version are considered equal.
+//
+// All of the following are semantically equal:
+//   - TLSv1.2
+//   - tls1_2
+//   - 1.2
+//   - 0x0303
+type TLSVersion struct {
+	basetypes.StringValue
+}
+
+// Type returns a TLSVersionType.
+func (v TLSVersion) Type(_ context.Context) attr.Type {
+	return TLSVersionType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v TLSVersion) Equal(o attr.Value) bool {
+	other, ok := o.(TLSVersion)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given TLS version string value is semantically equal to the current TLS
+// version string value. This comparison uses the protocol version ID of both values, so that different notations of
+// the same protocol version are considered equal.
+//
+// All of the following are semantically equal:
+//   - TLSv1.2
+//   - tls1_2
+//   - 1.2
+//   - 0x0303
+func (v TLSVersion) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(TLSVersion)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// TLS versions are already validated at this point, ignoring errors
+	newVersion, _ := parseTLSVersion(newValue.ValueString())
+	currentVersion, _ := parseTLSVersion(v.ValueString())
+
+	return currentVersion == newVersion, diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String
+// value that is a known TLS protocol version.
+func (v TLSVersion) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseTLSVersion(v.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid TLS Version String Value",
+			"A string value was provided that is not valid TLS version string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is a known TLS protocol version.
+func (v TLSVersion) ValidateParameter(_ context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseTLSVersion(v.ValueString())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid TLS Version String Value: "+
+				"A string value was provided that is not valid TLS version string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueTLSVersion returns the TLSVersion StringValue as a protocol version ID, such as tls.VersionTLS12, for use in
+// a tls.Config. A null or unknown value will produce an error diagnostic.
+func (v TLSVersion) ValueTLSVersion() (uint16, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("TLSVersion ValueTLSVersion Error", "TLS version string value is null"))
+		return 0, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("TLSVersion ValueTLSVersion Error", "TLS version string value is unknown"))
+		return 0, diags
+	}
+
+	version, err := parseTLSVersion(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("TLSVersion ValueTLSVersion Error", err.Error()))
+		return 0, diags
+	}
+
+	return version, nil
+}
+
+// parseTLSVersion parses a TLS protocol version name or hexadecimal protocol version into its protocol version ID.
+func parseTLSVersion(s string) (uint16, error) {
+	normalized := normalizeTLSVersion(s)
+
+	if hexVersion, found := strings.CutPrefix(normalized, "0x"); found && len(hexVersion) == 4 {
+		id, err := strconv.ParseUint(hexVersion, 16, 16)
+		if err == nil {
+			for _, version := range tlsVersions {
+				if version.id == uint16(id) {
+					return version.id, nil
+				}
+			}
+		}
+	}
+
+	for _, version := range tlsVersions {
+		if version.name == normalized {
+			return version.id, nil
+		}
+	}
+
+	return 0, fmt.Errorf("TLS version %q: must be one of SSLv3, TLSv1, TLSv1.1, TLSv1.2 or TLSv1.3, or a hexadecimal protocol version such as 0x0303", s)
+}
+
+// normalizeTLSVersion lowercases a TLS protocol version name and rewrites its notation to a protocol, major version,
+// dot and minor version, such as tls1.2. A bare version number is assumed to be TLS.
+func normalizeTLSVersion(s string) string {
+	normalized := strings.ReplaceAll(strings.ToLower(s), "_", ".")
+
+	protocol := ""
+
+	for _, candidate := range []string{"tls", "ssl"} {
+		if rest, found := strings.CutPrefix(normalized, candidate); found {
+			protocol = candidate
+			normalized = strings.TrimPrefix(strings.TrimPrefix(rest, " "), "v")
+
+			break
+		}
+	}
+
+	switch {
+	case protocol == "" && !strings.Contains(normalized, "."):
+		// Bare version numbers require a minor version, as 1 or 3 alone are ambiguous
+		return normalized
+	case protocol == "":
+		protocol = "tls"
+	case !strings.Contains(normalized, "."):
+		normalized += ".0"
+	}
+
+	return protocol + normalized
+}
+
+// NewTLSVersionNull creates a TLSVersion with a null value. Determine whether the value is null via IsNull method.
+func NewTLSVersionNull() TLSVersion {
+	return TLSVersion{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewTLSVersionUnknown creates a TLSVersion with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewTLSVersionUnknown() TLSVersion {
+	return TLSVersion{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewTLSVersionValue creates a TLSVersion with a known value. Access the value via ValueString method.
+func NewTLSVersionValue(value string) TLSVersion {
+	return TLSVersion{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewTLSVersionPointerValue creates a TLSVersion with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewTLSVersionPointerValue(value *string) TLSVersion {
+	return TLSVersion{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
@@ -0,0 +1,292 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cryptotypes_test
+
+import (
+	"context"
+	"crypto/tls"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/cryptotypes"
+)
+
+func TestTLSVersionStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentVersion cryptotypes.TLSVersion
+		givenVersion   basetypes.StringValuable
+		expectedMatch  bool
+		expectedDiags  diag.Diagnostics
+	}{
+		"semantically equal - byte-for-byte match": {
+			currentVersion: cryptotypes.NewTLSVersionValue("TLSv1.2"),
+			givenVersion:   cryptotypes.NewTLSVersionValue("TLSv1.2"),
+			expectedMatch:  true,
+		},
+		"semantically equal - underscore notation": {
+			currentVersion: cryptotypes.NewTLSVersionValue("TLSv1.2"),
+			givenVersion:   cryptotypes.NewTLSVersionValue("tls1_2"),
+			expectedMatch:  true,
+		},
+		"semantically equal - bare version number": {
+			currentVersion: cryptotypes.NewTLSVersionValue("TLSv1.3"),
+			givenVersion:   cryptotypes.NewTLSVersionValue("1.3"),
+			expectedMatch:  true,
+		},
+		"semantically equal - hexadecimal protocol version": {
+			currentVersion: cryptotypes.NewTLSVersionValue("TLS 1.2"),
+			givenVersion:   cryptotypes.NewTLSVersionValue("0x0303"),
+			expectedMatch:  true,
+		},
+		"semantically equal - TLSv1 and TLSv1.0": {
+			currentVersion: cryptotypes.NewTLSVersionValue("TLSv1"),
+			givenVersion:   cryptotypes.NewTLSVersionValue("TLSv1.0"),
+			expectedMatch:  true,
+		},
+		"not equal - different version": {
+			currentVersion: cryptotypes.NewTLSVersionValue("TLSv1.2"),
+			givenVersion:   cryptotypes.NewTLSVersionValue("TLSv1.3"),
+			expectedMatch:  false,
+		},
+		"error - not given TLSVersion value": {
+			currentVersion: cryptotypes.NewTLSVersionValue("TLSv1.2"),
+			givenVersion:   basetypes.NewStringValue("TLSv1.2"),
+			expectedMatch:  false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: cryptotypes.TLSVersion\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentVersion.StringSemanticEquals(context.Background(), testCase.givenVersion)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestTLSVersionValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		versionValue cryptotypes.TLSVersion
+		expectError  bool
+	}{
+		"empty-struct": {
+			versionValue: cryptotypes.TLSVersion{},
+		},
+		"null": {
+			versionValue: cryptotypes.NewTLSVersionNull(),
+		},
+		"unknown": {
+			versionValue: cryptotypes.NewTLSVersionUnknown(),
+		},
+		"valid - SSLv3": {
+			versionValue: cryptotypes.NewTLSVersionValue("SSLv3"),
+		},
+		"valid - TLSv1.1": {
+			versionValue: cryptotypes.NewTLSVersionValue("TLSv1.1"),
+		},
+		"valid - TLSv1_2": {
+			versionValue: cryptotypes.NewTLSVersionValue("TLSv1_2"),
+		},
+		"valid - TLS1.3": {
+			versionValue: cryptotypes.NewTLSVersionValue("TLS1.3"),
+		},
+		"valid - uppercase hexadecimal": {
+			versionValue: cryptotypes.NewTLSVersionValue("0X0304"),
+		},
+		"invalid - empty": {
+			versionValue: cryptotypes.NewTLSVersionValue(""),
+			expectError:  true,
+		},
+		"invalid - unknown version": {
+			versionValue: cryptotypes.NewTLSVersionValue("TLSv1.4"),
+			expectError:  true,
+		},
+		"invalid - bare major version": {
+			versionValue: cryptotypes.NewTLSVersionValue("1"),
+			expectError:  true,
+		},
+		"invalid - unknown hexadecimal protocol version": {
+			versionValue: cryptotypes.NewTLSVersionValue("0x0305"),
+			expectError:  true,
+		},
+		"invalid - DTLS": {
+			versionValue: cryptotypes.NewTLSVersionValue("DTLSv1.2"),
+			expectError:  true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var expectedDiags diag.Diagnostics
+
+			if testCase.expectError {
+				expectedDiags.AddAttributeError(
+					path.Root("test"),
+					"Invalid TLS Version String Value",
+					"A string value was provided that is not valid TLS version string format.\n\n"+
+						"Given Value: "+testCase.versionValue.ValueString()+"\n"+
+						"Error: TLS version \""+testCase.versionValue.ValueString()+"\": must be one of SSLv3, TLSv1, TLSv1.1, TLSv1.2 or TLSv1.3, "+
+						"or a hexadecimal protocol version such as 0x0303",
+				)
+			}
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.versionValue.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{Path: path.Root("test")},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestTLSVersionValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		versionValue    cryptotypes.TLSVersion
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			versionValue: cryptotypes.TLSVersion{},
+		},
+		"null": {
+			versionValue: cryptotypes.NewTLSVersionNull(),
+		},
+		"unknown": {
+			versionValue: cryptotypes.NewTLSVersionUnknown(),
+		},
+		"valid - TLSv1.2": {
+			versionValue: cryptotypes.NewTLSVersionValue("TLSv1.2"),
+		},
+		"invalid - unknown version": {
+			versionValue: cryptotypes.NewTLSVersionValue("TLSv2"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid TLS Version String Value: "+
+					"A string value was provided that is not valid TLS version string format.\n\n"+
+					"Given Value: TLSv2\n"+
+					"Error: TLS version \"TLSv2\": must be one of SSLv3, TLSv1, TLSv1.1, TLSv1.2 or TLSv1.3, "+
+					"or a hexadecimal protocol version such as 0x0303",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.versionValue.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestTLSVersionValueTLSVersion(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		versionValue    cryptotypes.TLSVersion
+		expectedVersion uint16
+		expectedDiags   diag.Diagnostics
+	}{
+		"TLS version value is null": {
+			versionValue: cryptotypes.NewTLSVersionNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"TLSVersion ValueTLSVersion Error",
+					"TLS version string value is null",
+				),
+			},
+		},
+		"TLS version value is unknown": {
+			versionValue: cryptotypes.NewTLSVersionUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"TLSVersion ValueTLSVersion Error",
+					"TLS version string value is unknown",
+				),
+			},
+		},
+		"SSLv3": {
+			versionValue:    cryptotypes.NewTLSVersionValue("ssl3"),
+			expectedVersion: 0x0300,
+		},
+		"TLSv1": {
+			versionValue:    cryptotypes.NewTLSVersionValue("TLSv1"),
+			expectedVersion: tls.VersionTLS10,
+		},
+		"tls1_1": {
+			versionValue:    cryptotypes.NewTLSVersionValue("tls1_1"),
+			expectedVersion: tls.VersionTLS11,
+		},
+		"1.2": {
+			versionValue:    cryptotypes.NewTLSVersionValue("1.2"),
+			expectedVersion: tls.VersionTLS12,
+		},
+		"0x0304": {
+			versionValue:    cryptotypes.NewTLSVersionValue("0x0304"),
+			expectedVersion: tls.VersionTLS13,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			version, diags := testCase.versionValue.ValueTLSVersion()
+
+			if version != testCase.expectedVersion {
+				t.Errorf("Unexpected difference in TLS version, got: 0x%04X, expected: 0x%04X", version, testCase.expectedVersion)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cryptotypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = (*WireGuardKeyType)(nil)
+
+// WireGuardKeyType is an attribute type that represents a valid WireGuard public, private or preshared key, which is
+// 32 bytes encoded as base64. Semantic equality logic is defined for WireGuardKeyType, so that keys encoded with the
+// standard or URL-safe base64 alphabets, with or without padding and with stray whitespace are considered equal.
+//
+// All of the following are semantically equal:
+//   - yAnz5TF+lXXJte14tji3zlMNq+hd2rYUIgJBgB3fBmk=
+//   - yAnz5TF-lXXJte14tji3zlMNq-hd2rYUIgJBgB3fBmk=
+//   - yAnz5TF+lXXJte14tji3zlMNq+hd2rYUIgJBgB3fBmk
+//   - " yAnz5TF+lXXJte14tji3zlMNq+hd2rYUIgJBgB3fBmk=\n"
+type WireGuardKeyType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t WireGuardKeyType) String() string {
+	return "cryptotypes.WireGuardKeyType"
+}
+
+// ValueType returns the Value type.
+func (t WireGuardKeyType) ValueType(ctx context.Context) attr.Value {
+	return WireGuardKey{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t WireGuardKeyType) Equal(o attr.Type) bool {
+	other, ok := o.(WireGuardKeyType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t WireGuardKeyType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return WireGuardKey{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t WireGuardKeyType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
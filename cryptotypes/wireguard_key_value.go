@@ -0,0 +1,238 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cryptotypes
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdh"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*WireGuardKey)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*WireGuardKey)(nil)
+	_ xattr.ValidateableAttribute                = (*WireGuardKey)(nil)
+	_ function.ValidateableParameter             = (*WireGuardKey)(nil)
+)
+
+// wireGuardKeyLength is the length in bytes of WireGuard Curve25519 public and private keys and preshared keys.
+const wireGuardKeyLength = 32
+
+// wireGuardKeyEncodings are the base64 encodings accepted for WireGuard keys, in order of preference.
+var wireGuardKeyEncodings = []*base64.Encoding{
+	base64.StdEncoding.Strict(),
+	base64.URLEncoding.Strict(),
+	base64.RawStdEncoding.Strict(),
+	base64.RawURLEncoding.Strict(),
+}
+
+// WireGuardKey represents a valid WireGuard public, private or preshared key, which is 32 bytes encoded as base64.
+// Semantic equality logic is defined for WireGuardKey, so that keys encoded with the standard or URL-safe base64
+// alphabets, with or without padding and with stray whitespace are considered equal.
+//
+// As private and preshared keys are secrets, validation diagnostics for this type do not include the given value.
+//
+// All of the following are semantically equal:
+//   - yAnz5TF+lXXJte14tji3zlMNq+hd2rYUIgJBgB3fBmk=
+//   - yAnz5TF-lXXJte14tji3zlMNq-hd2rYUIgJBgB3fBmk=
+//   - yAnz5TF+lXXJte14tji3zlMNq+hd2rYUIgJBgB3fBmk
+//   - " yAnz5TF+lXXJte14tji3zlMNq+hd2rYUIgJBgB3fBmk=\n"
+type WireGuardKey struct {
+	basetypes.StringValue
+}
+
+// Type returns a WireGuardKeyType.
+func (v WireGuardKey) Type(_ context.Context) attr.Type {
+	return WireGuardKeyType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v WireGuardKey) Equal(o attr.Value) bool {
+	other, ok := o.(WireGuardKey)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given WireGuard key string value is semantically equal to the current
+// WireGuard key string value. This comparison decodes both values into their 32 key bytes, so that keys encoded with
+// the standard or URL-safe base64 alphabets, with or without padding and with stray whitespace are considered equal.
+//
+// All of the following are semantically equal:
+//   - yAnz5TF+lXXJte14tji3zlMNq+hd2rYUIgJBgB3fBmk=
+//   - yAnz5TF-lXXJte14tji3zlMNq-hd2rYUIgJBgB3fBmk=
+//   - yAnz5TF+lXXJte14tji3zlMNq+hd2rYUIgJBgB3fBmk
+//   - " yAnz5TF+lXXJte14tji3zlMNq+hd2rYUIgJBgB3fBmk=\n"
+func (v WireGuardKey) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(WireGuardKey)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// WireGuard keys are already validated at this point, ignoring errors
+	newKey, _ := parseWireGuardKey(newValue.ValueString())
+	currentKey, _ := parseWireGuardKey(v.ValueString())
+
+	return bytes.Equal(currentKey, newKey), diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String
+// value that decodes to exactly 32 bytes using the standard or URL-safe base64 alphabet, ignoring whitespace.
+func (v WireGuardKey) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseWireGuardKey(v.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid WireGuard Key String Value",
+			"A string value was provided that is not valid WireGuard key string format.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that decodes to exactly 32 bytes using the standard or URL-safe base64 alphabet,
+// ignoring whitespace.
+func (v WireGuardKey) ValidateParameter(_ context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseWireGuardKey(v.ValueString())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid WireGuard Key String Value: "+
+				"A string value was provided that is not valid WireGuard key string format.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueWireGuardKey decodes the WireGuardKey StringValue into its 32 key bytes. A null or unknown value will produce
+// an error diagnostic.
+func (v WireGuardKey) ValueWireGuardKey() ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("WireGuardKey ValueWireGuardKey Error", "WireGuard key string value is null"))
+		return nil, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("WireGuardKey ValueWireGuardKey Error", "WireGuard key string value is unknown"))
+		return nil, diags
+	}
+
+	key, err := parseWireGuardKey(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("WireGuardKey ValueWireGuardKey Error", err.Error()))
+		return nil, diags
+	}
+
+	return key, nil
+}
+
+// ValuePublicKey treats the WireGuardKey as a private key and derives its Curve25519 public key, returned as a
+// WireGuardKey in standard padded base64 encoding, as printed by `wg pubkey`. A null or unknown value will produce an
+// error diagnostic.
+func (v WireGuardKey) ValuePublicKey() (WireGuardKey, diag.Diagnostics) {
+	key, diags := v.ValueWireGuardKey()
+	if diags.HasError() {
+		return NewWireGuardKeyNull(), diags
+	}
+
+	privateKey, err := ecdh.X25519().NewPrivateKey(key)
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("WireGuardKey ValuePublicKey Error", err.Error()))
+		return NewWireGuardKeyNull(), diags
+	}
+
+	return NewWireGuardKeyValue(base64.StdEncoding.EncodeToString(privateKey.PublicKey().Bytes())), nil
+}
+
+// parseWireGuardKey removes all whitespace from a WireGuard key and decodes it using the first matching base64 encoding.
+func parseWireGuardKey(s string) ([]byte, error) {
+	encoded := strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+
+		return r
+	}, s)
+
+	for _, encoding := range wireGuardKeyEncodings {
+		key, err := encoding.DecodeString(encoded)
+		if err != nil {
+			continue
+		}
+
+		if len(key) != wireGuardKeyLength {
+			return nil, fmt.Errorf("WireGuard key must be %d bytes, got %d", wireGuardKeyLength, len(key))
+		}
+
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("WireGuard key must be %d bytes encoded as standard or URL-safe base64", wireGuardKeyLength)
+}
+
+// NewWireGuardKeyNull creates a WireGuardKey with a null value. Determine whether the value is null via IsNull method.
+func NewWireGuardKeyNull() WireGuardKey {
+	return WireGuardKey{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewWireGuardKeyUnknown creates a WireGuardKey with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewWireGuardKeyUnknown() WireGuardKey {
+	return WireGuardKey{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewWireGuardKeyValue creates a WireGuardKey with a known value. Access the value via ValueString method.
+func NewWireGuardKeyValue(value string) WireGuardKey {
+	return WireGuardKey{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewWireGuardKeyPointerValue creates a WireGuardKey with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewWireGuardKeyPointerValue(value *string) WireGuardKey {
+	return WireGuardKey{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cryptotypes_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/cryptotypes"
+)
+
+type WireGuardPeerResourceModel struct {
+	PrivateKey cryptotypes.WireGuardKey `tfsdk:"private_key"`
+	PublicKey  cryptotypes.WireGuardKey `tfsdk:"public_key"`
+}
+
+func ExampleWireGuardKey_ValuePublicKey() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := WireGuardPeerResourceModel{
+		PrivateKey: cryptotypes.NewWireGuardKeyValue("dwdtCnMYpX08FsFyUbJmRd9ML4frwJkqsXf7pR25LCo="),
+	}
+
+	// Check that the private key data is known and derive the computed public key
+	if !data.PrivateKey.IsNull() && !data.PrivateKey.IsUnknown() {
+		publicKey, diags := data.PrivateKey.ValuePublicKey()
+		if diags.HasError() {
+			return
+		}
+
+		data.PublicKey = publicKey
+
+		// Output: hSDwCYkwp1R0i33ctD73Wg2/Og0mOBr066SpjqqbTmo=
+		fmt.Println(data.PublicKey.ValueString())
+	}
+}
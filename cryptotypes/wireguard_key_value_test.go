@@ -0,0 +1,308 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cryptotypes_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/cryptotypes"
+)
+
+func TestWireGuardKeyStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentKey    cryptotypes.WireGuardKey
+		givenKey      basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"semantically equal - byte-for-byte match": {
+			currentKey:    cryptotypes.NewWireGuardKeyValue("yAnz5TF+lXXJte14tji3zlMNq+hd2rYUIgJBgB3fBmk="),
+			givenKey:      cryptotypes.NewWireGuardKeyValue("yAnz5TF+lXXJte14tji3zlMNq+hd2rYUIgJBgB3fBmk="),
+			expectedMatch: true,
+		},
+		"semantically equal - standard vs URL-safe alphabet": {
+			currentKey:    cryptotypes.NewWireGuardKeyValue("yAnz5TF+lXXJte14tji3zlMNq+hd2rYUIgJBgB3fBmk="),
+			givenKey:      cryptotypes.NewWireGuardKeyValue("yAnz5TF-lXXJte14tji3zlMNq-hd2rYUIgJBgB3fBmk="),
+			expectedMatch: true,
+		},
+		"semantically equal - padding omitted": {
+			currentKey:    cryptotypes.NewWireGuardKeyValue("hSDwCYkwp1R0i33ctD73Wg2/Og0mOBr066SpjqqbTmo="),
+			givenKey:      cryptotypes.NewWireGuardKeyValue("hSDwCYkwp1R0i33ctD73Wg2_Og0mOBr066SpjqqbTmo"),
+			expectedMatch: true,
+		},
+		"semantically equal - stray whitespace": {
+			currentKey:    cryptotypes.NewWireGuardKeyValue("yAnz5TF+lXXJte14tji3zlMNq+hd2rYUIgJBgB3fBmk="),
+			givenKey:      cryptotypes.NewWireGuardKeyValue(" yAnz5TF+lXXJte14tji3zlMNq+hd2rYUIgJBgB3fBmk=\n"),
+			expectedMatch: true,
+		},
+		"not equal - different keys": {
+			currentKey:    cryptotypes.NewWireGuardKeyValue("yAnz5TF+lXXJte14tji3zlMNq+hd2rYUIgJBgB3fBmk="),
+			givenKey:      cryptotypes.NewWireGuardKeyValue("hSDwCYkwp1R0i33ctD73Wg2/Og0mOBr066SpjqqbTmo="),
+			expectedMatch: false,
+		},
+		"error - not given WireGuardKey value": {
+			currentKey:    cryptotypes.NewWireGuardKeyValue("yAnz5TF+lXXJte14tji3zlMNq+hd2rYUIgJBgB3fBmk="),
+			givenKey:      basetypes.NewStringValue("yAnz5TF+lXXJte14tji3zlMNq+hd2rYUIgJBgB3fBmk="),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: cryptotypes.WireGuardKey\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentKey.StringSemanticEquals(context.Background(), testCase.givenKey)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestWireGuardKeyValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		keyValue      cryptotypes.WireGuardKey
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			keyValue: cryptotypes.WireGuardKey{},
+		},
+		"null": {
+			keyValue: cryptotypes.NewWireGuardKeyNull(),
+		},
+		"unknown": {
+			keyValue: cryptotypes.NewWireGuardKeyUnknown(),
+		},
+		"valid key": {
+			keyValue: cryptotypes.NewWireGuardKeyValue("yAnz5TF+lXXJte14tji3zlMNq+hd2rYUIgJBgB3fBmk="),
+		},
+		"valid key - URL-safe": {
+			keyValue: cryptotypes.NewWireGuardKeyValue("hSDwCYkwp1R0i33ctD73Wg2_Og0mOBr066SpjqqbTmo="),
+		},
+		"invalid key - not base64": {
+			keyValue: cryptotypes.NewWireGuardKeyValue("not a WireGuard key!"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid WireGuard Key String Value",
+					"A string value was provided that is not valid WireGuard key string format.\n\n"+
+						"Error: WireGuard key must be 32 bytes encoded as standard or URL-safe base64",
+				),
+			},
+		},
+		"invalid key - mixed alphabets": {
+			keyValue: cryptotypes.NewWireGuardKeyValue("yAnz5TF+lXXJte14tji3zlMNq-hd2rYUIgJBgB3fBmk="),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid WireGuard Key String Value",
+					"A string value was provided that is not valid WireGuard key string format.\n\n"+
+						"Error: WireGuard key must be 32 bytes encoded as standard or URL-safe base64",
+				),
+			},
+		},
+		"invalid key - wrong length": {
+			keyValue: cryptotypes.NewWireGuardKeyValue("AAAAAAAAAAAAAAAAAAAAAA=="),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid WireGuard Key String Value",
+					"A string value was provided that is not valid WireGuard key string format.\n\n"+
+						"Error: WireGuard key must be 32 bytes, got 16",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.keyValue.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{Path: path.Root("test")},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestWireGuardKeyValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		keyValue        cryptotypes.WireGuardKey
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			keyValue: cryptotypes.WireGuardKey{},
+		},
+		"null": {
+			keyValue: cryptotypes.NewWireGuardKeyNull(),
+		},
+		"unknown": {
+			keyValue: cryptotypes.NewWireGuardKeyUnknown(),
+		},
+		"valid key": {
+			keyValue: cryptotypes.NewWireGuardKeyValue("yAnz5TF+lXXJte14tji3zlMNq+hd2rYUIgJBgB3fBmk="),
+		},
+		"invalid key - wrong length": {
+			keyValue: cryptotypes.NewWireGuardKeyValue("AAAAAAAAAAAAAAAAAAAAAA=="),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid WireGuard Key String Value: "+
+					"A string value was provided that is not valid WireGuard key string format.\n\n"+
+					"Error: WireGuard key must be 32 bytes, got 16",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.keyValue.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestWireGuardKeyValueWireGuardKey(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		keyValue      cryptotypes.WireGuardKey
+		expectedKey   []byte
+		expectedDiags diag.Diagnostics
+	}{
+		"WireGuard key value is null": {
+			keyValue: cryptotypes.NewWireGuardKeyNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"WireGuardKey ValueWireGuardKey Error",
+					"WireGuard key string value is null",
+				),
+			},
+		},
+		"WireGuard key value is unknown": {
+			keyValue: cryptotypes.NewWireGuardKeyUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"WireGuardKey ValueWireGuardKey Error",
+					"WireGuard key string value is unknown",
+				),
+			},
+		},
+		"valid key": {
+			keyValue: cryptotypes.NewWireGuardKeyValue("hSDwCYkwp1R0i33ctD73Wg2_Og0mOBr066SpjqqbTmo"),
+			expectedKey: []byte{
+				0x85, 0x20, 0xf0, 0x09, 0x89, 0x30, 0xa7, 0x54, 0x74, 0x8b, 0x7d, 0xdc, 0xb4, 0x3e, 0xf7, 0x5a,
+				0x0d, 0xbf, 0x3a, 0x0d, 0x26, 0x38, 0x1a, 0xf4, 0xeb, 0xa4, 0xa9, 0x8e, 0xaa, 0x9b, 0x4e, 0x6a,
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			key, diags := testCase.keyValue.ValueWireGuardKey()
+
+			if !bytes.Equal(key, testCase.expectedKey) {
+				t.Errorf("Unexpected difference in key, got: %x, expected: %x", key, testCase.expectedKey)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestWireGuardKeyValuePublicKey(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		keyValue          cryptotypes.WireGuardKey
+		expectedPublicKey cryptotypes.WireGuardKey
+		expectedDiags     diag.Diagnostics
+	}{
+		"WireGuard key value is null": {
+			keyValue:          cryptotypes.NewWireGuardKeyNull(),
+			expectedPublicKey: cryptotypes.NewWireGuardKeyNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"WireGuardKey ValueWireGuardKey Error",
+					"WireGuard key string value is null",
+				),
+			},
+		},
+		// RFC 7748 Section 6.1 Alice's private and public keys
+		"private key": {
+			keyValue:          cryptotypes.NewWireGuardKeyValue("dwdtCnMYpX08FsFyUbJmRd9ML4frwJkqsXf7pR25LCo="),
+			expectedPublicKey: cryptotypes.NewWireGuardKeyValue("hSDwCYkwp1R0i33ctD73Wg2/Og0mOBr066SpjqqbTmo="),
+		},
+		"private key - URL-safe without padding": {
+			keyValue:          cryptotypes.NewWireGuardKeyValue("dwdtCnMYpX08FsFyUbJmRd9ML4frwJkqsXf7pR25LCo"),
+			expectedPublicKey: cryptotypes.NewWireGuardKeyValue("hSDwCYkwp1R0i33ctD73Wg2/Og0mOBr066SpjqqbTmo="),
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			publicKey, diags := testCase.keyValue.ValuePublicKey()
+
+			if !publicKey.Equal(testCase.expectedPublicKey) {
+				t.Errorf("Unexpected difference in public key, got: %s, expected: %s", publicKey, testCase.expectedPublicKey)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = (*CAARecordType)(nil)
+
+// CAARecordType is an attribute type that represents valid CAA record RDATA in presentation format, which is an 8-bit
+// flags value, a property tag and a property value, such as `0 issue "letsencrypt.org"`. A value containing whitespace
+// must be quoted. Semantic equality logic is defined for CAARecordType, so that whitespace, value quoting and letter
+// case of the tag are ignored. The value is compared exactly.
+//
+// All of the following are semantically equal:
+//   - 0 issue "letsencrypt.org"
+//   - 0 issue letsencrypt.org
+//   - 0  ISSUE "letsencrypt.org"
+type CAARecordType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t CAARecordType) String() string {
+	return "dnstypes.CAARecordType"
+}
+
+// ValueType returns the Value type.
+func (t CAARecordType) ValueType(ctx context.Context) attr.Value {
+	return CAARecord{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t CAARecordType) Equal(o attr.Type) bool {
+	other, ok := o.(CAARecordType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t CAARecordType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return CAARecord{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t CAARecordType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
@@ -0,0 +1,241 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*CAARecord)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*CAARecord)(nil)
+	_ xattr.ValidateableAttribute                = (*CAARecord)(nil)
+	_ function.ValidateableParameter             = (*CAARecord)(nil)
+)
+
+// CAARecord represents valid CAA record RDATA in presentation format, which is an 8-bit flags value, a property tag
+// and a property value, such as `0 issue "letsencrypt.org"`. A value containing whitespace must be quoted. Semantic
+// equality logic is defined for CAARecord, so that whitespace, value quoting and letter case of the tag are ignored.
+// The value is compared exactly.
+//
+// All of the following are semantically equal:
+//   - 0 issue "letsencrypt.org"
+//   - 0 issue letsencrypt.org
+//   - 0  ISSUE "letsencrypt.org"
+type CAARecord struct {
+	basetypes.StringValue
+}
+
+// Type returns a CAARecordType.
+func (v CAARecord) Type(_ context.Context) attr.Type {
+	return CAARecordType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v CAARecord) Equal(o attr.Value) bool {
+	other, ok := o.(CAARecord)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given CAA record string value is semantically equal to the current CAA
+// record string value. This comparison uses the canonical presentation format of both values, so that whitespace,
+// value quoting and letter case of the tag are ignored.
+//
+// All of the following are semantically equal:
+//   - 0 issue "letsencrypt.org"
+//   - 0 issue letsencrypt.org
+//   - 0  ISSUE "letsencrypt.org"
+func (v CAARecord) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(CAARecord)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// CAA records are already validated at this point, ignoring errors
+	newCAA, _ := parseCAA(newValue.ValueString())
+	currentCAA, _ := parseCAA(v.ValueString())
+
+	return currentCAA.String() == newCAA.String(), diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String
+// value that is valid CAA record RDATA, with 8-bit flags, a tag of up to 15 letters and digits and a value.
+func (v CAARecord) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseCAA(v.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid CAA Record String Value",
+			"A string value was provided that is not valid CAA record string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is valid CAA record RDATA, with 8-bit flags, a tag of up to 15 letters and
+// digits and a value.
+func (v CAARecord) ValidateParameter(_ context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseCAA(v.ValueString())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid CAA Record String Value: "+
+				"A string value was provided that is not valid CAA record string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueCAA parses the CAARecord StringValue into its flags, lowercase tag and unquoted value. A null or unknown
+// value will produce an error diagnostic.
+func (v CAARecord) ValueCAA() (CAA, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("CAARecord ValueCAA Error", "CAA record string value is null"))
+		return CAA{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("CAARecord ValueCAA Error", "CAA record string value is unknown"))
+		return CAA{}, diags
+	}
+
+	record, err := parseCAA(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("CAARecord ValueCAA Error", err.Error()))
+		return CAA{}, diags
+	}
+
+	return record, nil
+}
+
+// CAA is the parsed RDATA of a CAA record (RFC 8659).
+type CAA struct {
+	// Flags are the CAA flags, where the most significant bit is the Issuer Critical flag.
+	Flags uint8
+
+	// Tag is the property tag in lowercase, such as issue, issuewild or iodef.
+	Tag string
+
+	// Value is the property value with quoting and escape sequences removed.
+	Value string
+}
+
+// String returns the CAA RDATA in canonical presentation format, such as `0 issue "letsencrypt.org"`.
+func (r CAA) String() string {
+	return fmt.Sprintf("%d %s %s", r.Flags, r.Tag, formatCharacterString(r.Value))
+}
+
+// parseCAA parses CAA RDATA in presentation format.
+func parseCAA(s string) (CAA, error) {
+	record, err := parseCAAFields(s)
+	if err != nil {
+		return CAA{}, fmt.Errorf("CAA record %q: %w", s, err)
+	}
+
+	return record, nil
+}
+
+// parseCAAFields parses the flags, tag and value fields of CAA RDATA.
+func parseCAAFields(s string) (CAA, error) {
+	tokens, err := splitRDATA(s)
+	if err != nil {
+		return CAA{}, err
+	}
+
+	if len(tokens) > 3 {
+		return CAA{}, errors.New("value containing whitespace must be quoted")
+	}
+
+	if len(tokens) != 3 {
+		return CAA{}, fmt.Errorf("must contain flags, tag and value fields, got %d fields", len(tokens))
+	}
+
+	flags, err := parseRDATAUint(tokens[0], "flags", 8)
+	if err != nil {
+		return CAA{}, err
+	}
+
+	tag := tokens[1].text
+
+	if tokens[1].quoted || len(tag) == 0 || len(tag) > maxCAATagLength || !isAlphanumeric(tag) {
+		return CAA{}, fmt.Errorf("tag %q must be 1 to %d letters and digits", tag, maxCAATagLength)
+	}
+
+	// The value is not a character string, so it is not limited to 255 octets
+	value, err := parseCharacterString(tokens[2], 0)
+	if err != nil {
+		return CAA{}, fmt.Errorf("value: %w", err)
+	}
+
+	return CAA{Flags: uint8(flags), Tag: strings.ToLower(tag), Value: value}, nil
+}
+
+// NewCAARecordNull creates a CAARecord with a null value. Determine whether the value is null via IsNull method.
+func NewCAARecordNull() CAARecord {
+	return CAARecord{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewCAARecordUnknown creates a CAARecord with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewCAARecordUnknown() CAARecord {
+	return CAARecord{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewCAARecordValue creates a CAARecord with a known value. Access the value via ValueString method.
+func NewCAARecordValue(value string) CAARecord {
+	return CAARecord{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewCAARecordPointerValue creates a CAARecord with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewCAARecordPointerValue(value *string) CAARecord {
+	return CAARecord{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
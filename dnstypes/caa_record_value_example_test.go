@@ -0,0 +1,33 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/dnstypes"
+)
+
+type CAARecordResourceModel struct {
+	Value dnstypes.CAARecord `tfsdk:"value"`
+}
+
+func ExampleCAARecord_ValueCAA() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := CAARecordResourceModel{
+		Value: dnstypes.NewCAARecordValue("0 issue letsencrypt.org"),
+	}
+
+	// Check that the CAARecord data is known and render it in canonical presentation format
+	if !data.Value.IsNull() && !data.Value.IsUnknown() {
+		caa, diags := data.Value.ValueCAA()
+		if diags.HasError() {
+			return
+		}
+
+		// Output: 0 issue "letsencrypt.org"
+		fmt.Println(caa.String())
+	}
+}
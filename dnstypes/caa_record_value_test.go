@@ -0,0 +1,286 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/dnstypes"
+)
+
+func TestCAARecordStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentCAA    dnstypes.CAARecord
+		givenCAA      basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"semantically equal - byte-for-byte match": {
+			currentCAA:    dnstypes.NewCAARecordValue("0 issue \"letsencrypt.org\""),
+			givenCAA:      dnstypes.NewCAARecordValue("0 issue \"letsencrypt.org\""),
+			expectedMatch: true,
+		},
+		"semantically equal - unquoted value": {
+			currentCAA:    dnstypes.NewCAARecordValue("0 issue \"letsencrypt.org\""),
+			givenCAA:      dnstypes.NewCAARecordValue("0 issue letsencrypt.org"),
+			expectedMatch: true,
+		},
+		"semantically equal - tag letter case and whitespace": {
+			currentCAA:    dnstypes.NewCAARecordValue("0 issue \"letsencrypt.org\""),
+			givenCAA:      dnstypes.NewCAARecordValue("0  ISSUE  \"letsencrypt.org\""),
+			expectedMatch: true,
+		},
+		"semantically equal - escaped quote": {
+			currentCAA:    dnstypes.NewCAARecordValue("0 iodef \"mailto:\\\"ops\\\"@example.com\""),
+			givenCAA:      dnstypes.NewCAARecordValue("0 iodef \"mailto:\\034ops\\034@example.com\""),
+			expectedMatch: true,
+		},
+		"not equal - different flags": {
+			currentCAA:    dnstypes.NewCAARecordValue("0 issue \"letsencrypt.org\""),
+			givenCAA:      dnstypes.NewCAARecordValue("128 issue \"letsencrypt.org\""),
+			expectedMatch: false,
+		},
+		"not equal - different tag": {
+			currentCAA:    dnstypes.NewCAARecordValue("0 issue \"letsencrypt.org\""),
+			givenCAA:      dnstypes.NewCAARecordValue("0 issuewild \"letsencrypt.org\""),
+			expectedMatch: false,
+		},
+		"not equal - value letter case": {
+			currentCAA:    dnstypes.NewCAARecordValue("0 issue \"letsencrypt.org\""),
+			givenCAA:      dnstypes.NewCAARecordValue("0 issue \"LetsEncrypt.org\""),
+			expectedMatch: false,
+		},
+		"error - not given CAARecord value": {
+			currentCAA:    dnstypes.NewCAARecordValue("0 issue \"letsencrypt.org\""),
+			givenCAA:      basetypes.NewStringValue("0 issue \"letsencrypt.org\""),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: dnstypes.CAARecord\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentCAA.StringSemanticEquals(context.Background(), testCase.givenCAA)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestCAARecordValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		caaValue      dnstypes.CAARecord
+		expectedError string
+	}{
+		"empty-struct": {
+			caaValue: dnstypes.CAARecord{},
+		},
+		"null": {
+			caaValue: dnstypes.NewCAARecordNull(),
+		},
+		"unknown": {
+			caaValue: dnstypes.NewCAARecordUnknown(),
+		},
+		"valid": {
+			caaValue: dnstypes.NewCAARecordValue("0 issue \"letsencrypt.org\""),
+		},
+		"valid - empty value": {
+			caaValue: dnstypes.NewCAARecordValue("0 issue \";\""),
+		},
+		"valid - quoted value with whitespace": {
+			caaValue: dnstypes.NewCAARecordValue("0 issue \"letsencrypt.org; validationmethods=dns-01\""),
+		},
+		"valid - critical flag": {
+			caaValue: dnstypes.NewCAARecordValue("128 tbs \"unknown\""),
+		},
+		"valid - empty quoted value": {
+			caaValue: dnstypes.NewCAARecordValue("0 issuewild \"\""),
+		},
+		"invalid - missing value": {
+			caaValue:      dnstypes.NewCAARecordValue("0 issue"),
+			expectedError: "CAA record \"0 issue\": must contain flags, tag and value fields, got 2 fields",
+		},
+		"invalid - unquoted value with whitespace": {
+			caaValue:      dnstypes.NewCAARecordValue("0 issue letsencrypt.org; validationmethods=dns-01"),
+			expectedError: "CAA record \"0 issue letsencrypt.org; validationmethods=dns-01\": value containing whitespace must be quoted",
+		},
+		"invalid - flags out of range": {
+			caaValue:      dnstypes.NewCAARecordValue("256 issue \"letsencrypt.org\""),
+			expectedError: "CAA record \"256 issue \\\"letsencrypt.org\\\"\": flags \"256\" must be an unsigned 8-bit decimal integer",
+		},
+		"invalid - tag with hyphen": {
+			caaValue:      dnstypes.NewCAARecordValue("0 issue-wild \"letsencrypt.org\""),
+			expectedError: "CAA record \"0 issue-wild \\\"letsencrypt.org\\\"\": tag \"issue-wild\" must be 1 to 15 letters and digits",
+		},
+		"invalid - tag too long": {
+			caaValue:      dnstypes.NewCAARecordValue("0 issueissueissue1 \"letsencrypt.org\""),
+			expectedError: "CAA record \"0 issueissueissue1 \\\"letsencrypt.org\\\"\": tag \"issueissueissue1\" must be 1 to 15 letters and digits",
+		},
+		"invalid - missing closing quote": {
+			caaValue:      dnstypes.NewCAARecordValue("0 issue \"letsencrypt.org"),
+			expectedError: "CAA record \"0 issue \\\"letsencrypt.org\": quoted field is missing its closing quote",
+		},
+		"invalid - escape out of range": {
+			caaValue:      dnstypes.NewCAARecordValue("0 issue \"letsencrypt\\256org\""),
+			expectedError: "CAA record \"0 issue \\\"letsencrypt\\\\256org\\\"\": value: escape sequence \\256 must be a decimal octet from 000 to 255",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var expectedDiags diag.Diagnostics
+
+			if testCase.expectedError != "" {
+				expectedDiags.AddAttributeError(
+					path.Root("test"),
+					"Invalid CAA Record String Value",
+					"A string value was provided that is not valid CAA record string format.\n\n"+
+						"Given Value: "+testCase.caaValue.ValueString()+"\n"+
+						"Error: "+testCase.expectedError,
+				)
+			}
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.caaValue.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{Path: path.Root("test")},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestCAARecordValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		caaValue        dnstypes.CAARecord
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			caaValue: dnstypes.CAARecord{},
+		},
+		"null": {
+			caaValue: dnstypes.NewCAARecordNull(),
+		},
+		"unknown": {
+			caaValue: dnstypes.NewCAARecordUnknown(),
+		},
+		"valid": {
+			caaValue: dnstypes.NewCAARecordValue("0 issue \"letsencrypt.org\""),
+		},
+		"invalid": {
+			caaValue: dnstypes.NewCAARecordValue("0 issue"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid CAA Record String Value: "+
+					"A string value was provided that is not valid CAA record string format.\n\n"+
+					"Given Value: "+"0 issue"+"\n"+
+					"Error: "+"CAA record \"0 issue\": must contain flags, tag and value fields, got 2 fields",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.caaValue.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestCAARecordValueCAA(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		caaValue      dnstypes.CAARecord
+		expectedCAA   dnstypes.CAA
+		expectedDiags diag.Diagnostics
+	}{
+		"CAA record value is null": {
+			caaValue: dnstypes.NewCAARecordNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"CAARecord ValueCAA Error",
+					"CAA record string value is null",
+				),
+			},
+		},
+		"CAA record value is unknown": {
+			caaValue: dnstypes.NewCAARecordUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"CAARecord ValueCAA Error",
+					"CAA record string value is unknown",
+				),
+			},
+		},
+		"valid": {
+			caaValue:    dnstypes.NewCAARecordValue("0 ISSUE \"letsencrypt.org; validationmethods=dns-01\""),
+			expectedCAA: dnstypes.CAA{Flags: 0, Tag: "issue", Value: "letsencrypt.org; validationmethods=dns-01"},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			record, diags := testCase.caaValue.ValueCAA()
+
+			if diff := cmp.Diff(record, testCase.expectedCAA); diff != "" {
+				t.Errorf("Unexpected difference in CAA record (-got, +expected): %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = (*DKIMRecordType)(nil)
+
+// DKIMRecordType is an attribute type that represents a valid DKIM key record (RFC 6376 Section 3.6.1), which is a
+// TXT record value containing a tag list with a base64 encoded public key, such as
+// `v=DKIM1; k=ed25519; p=11qYAYKxCrfVS/7TyWQHOg7hcvPapiMlrwIaaPcHURo=`. Semantic equality logic is defined for
+// DKIMRecordType, so that quoting, chunking, whitespace, the order of tags, a trailing semicolon, whitespace within
+// the public key and tags set to their default values are ignored.
+//
+// All of the following are semantically equal:
+//   - v=DKIM1; k=ed25519; p=11qYAYKxCrfVS/7TyWQHOg7hcvPapiMlrwIaaPcHURo=
+//   - k=ed25519; p=11qYAYKxCrfVS/7TyWQH Og7hcvPapiMlrwIaaPcHURo=; s=*;
+//   - "v=DKIM1; k=ed25519; " "p=11qYAYKxCrfVS/7TyWQHOg7hcvPapiMlrwIaaPcHURo="
+type DKIMRecordType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t DKIMRecordType) String() string {
+	return "dnstypes.DKIMRecordType"
+}
+
+// ValueType returns the Value type.
+func (t DKIMRecordType) ValueType(ctx context.Context) attr.Value {
+	return DKIMRecord{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t DKIMRecordType) Equal(o attr.Type) bool {
+	other, ok := o.(DKIMRecordType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t DKIMRecordType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return DKIMRecord{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t DKIMRecordType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
@@ -0,0 +1,430 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*DKIMRecord)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*DKIMRecord)(nil)
+	_ xattr.ValidateableAttribute                = (*DKIMRecord)(nil)
+	_ function.ValidateableParameter             = (*DKIMRecord)(nil)
+)
+
+const (
+	// dkimVersion is the only version of DKIM key records, which is also the default (RFC 6376 Section 3.6.1).
+	dkimVersion = "DKIM1"
+
+	// dkimDefaultKeyType is the key type used when the k tag is not present (RFC 6376 Section 3.6.1).
+	dkimDefaultKeyType = "rsa"
+
+	// dkimDefaultServiceType is the service type used when the s tag is not present (RFC 6376 Section 3.6.1).
+	dkimDefaultServiceType = "*"
+
+	// minDKIMRSAKeySize is the minimum size in bits of RSA keys that verifiers consider valid (RFC 8301 Section 3.2).
+	minDKIMRSAKeySize = 1024
+)
+
+// DKIM key types (RFC 6376 Section 3.6.1 and RFC 8463).
+const (
+	DKIMKeyTypeRSA     = "rsa"
+	DKIMKeyTypeEd25519 = "ed25519"
+)
+
+// DKIMRecord represents a valid DKIM key record (RFC 6376 Section 3.6.1), which is a TXT record value containing a
+// tag list with a base64 encoded public key, such as
+// `v=DKIM1; k=ed25519; p=11qYAYKxCrfVS/7TyWQHOg7hcvPapiMlrwIaaPcHURo=`. An empty public key indicates a revoked key.
+// The value may be unquoted text or quoted character strings, as accepted by TXTRecord. Semantic equality logic is
+// defined for DKIMRecord, so that quoting, chunking, whitespace, the order of tags, a trailing semicolon, whitespace
+// within the public key and tags set to their default values are ignored.
+//
+// All of the following are semantically equal:
+//   - v=DKIM1; k=ed25519; p=11qYAYKxCrfVS/7TyWQHOg7hcvPapiMlrwIaaPcHURo=
+//   - k=ed25519; p=11qYAYKxCrfVS/7TyWQH Og7hcvPapiMlrwIaaPcHURo=; s=*;
+//   - "v=DKIM1; k=ed25519; " "p=11qYAYKxCrfVS/7TyWQHOg7hcvPapiMlrwIaaPcHURo="
+type DKIMRecord struct {
+	basetypes.StringValue
+}
+
+// Type returns a DKIMRecordType.
+func (v DKIMRecord) Type(_ context.Context) attr.Type {
+	return DKIMRecordType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v DKIMRecord) Equal(o attr.Value) bool {
+	other, ok := o.(DKIMRecord)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given DKIM key record string value is semantically equal to the current
+// DKIM key record string value. This comparison uses the canonical form of both records, so that quoting, chunking,
+// whitespace, the order of tags, a trailing semicolon, whitespace within the public key and tags set to their default
+// values are ignored.
+//
+// All of the following are semantically equal:
+//   - v=DKIM1; k=ed25519; p=11qYAYKxCrfVS/7TyWQHOg7hcvPapiMlrwIaaPcHURo=
+//   - k=ed25519; p=11qYAYKxCrfVS/7TyWQH Og7hcvPapiMlrwIaaPcHURo=; s=*;
+//   - "v=DKIM1; k=ed25519; " "p=11qYAYKxCrfVS/7TyWQHOg7hcvPapiMlrwIaaPcHURo="
+func (v DKIMRecord) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(DKIMRecord)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// DKIM key records are already validated at this point, ignoring errors
+	newDKIM, _ := parseDKIM(newValue.ValueString())
+	currentDKIM, _ := parseDKIM(v.ValueString())
+
+	return currentDKIM.String() == newDKIM.String(), diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String
+// value that is a valid DKIM key record, with a p tag containing an empty value or a public key matching the rsa or
+// ed25519 key type, where RSA keys must be at least 1024 bits.
+func (v DKIMRecord) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseDKIM(v.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid DKIM Record String Value",
+			"A string value was provided that is not valid DKIM key record string format (RFC 6376).\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is a valid DKIM key record, with a p tag containing an empty value or a public
+// key matching the rsa or ed25519 key type, where RSA keys must be at least 1024 bits.
+func (v DKIMRecord) ValidateParameter(_ context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseDKIM(v.ValueString())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid DKIM Record String Value: "+
+				"A string value was provided that is not valid DKIM key record string format (RFC 6376).\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueDKIM parses the DKIMRecord StringValue into its tags, with the public key decoded and default values filled
+// in for tags that are not present. A null or unknown value will produce an error diagnostic.
+func (v DKIMRecord) ValueDKIM() (DKIM, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("DKIMRecord ValueDKIM Error", "DKIM record string value is null"))
+		return DKIM{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("DKIMRecord ValueDKIM Error", "DKIM record string value is unknown"))
+		return DKIM{}, diags
+	}
+
+	record, err := parseDKIM(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("DKIMRecord ValueDKIM Error", err.Error()))
+		return DKIM{}, diags
+	}
+
+	return record, nil
+}
+
+// DKIM is a parsed DKIM key record (RFC 6376 Section 3.6.1).
+type DKIM struct {
+	// HashAlgorithms are the acceptable hash algorithms, such as sha256, which is empty if all are acceptable.
+	HashAlgorithms []string
+
+	// KeyType is the key type, which is DKIMKeyTypeRSA or DKIMKeyTypeEd25519 and defaults to DKIMKeyTypeRSA.
+	KeyType string
+
+	// Notes are notes for administrators, which are not interpreted.
+	Notes string
+
+	// PublicKey is the decoded public key data, which is empty if the key has been revoked. RSA keys are DER encoded
+	// and Ed25519 keys are the 32 byte raw public key.
+	PublicKey []byte
+
+	// ServiceTypes are the service types the key applies to, which default to *.
+	ServiceTypes []string
+
+	// Flags are the flags, such as y for testing mode and s for strict domain matching.
+	Flags []string
+
+	// Extensions are tags that are not defined by RFC 6376, by tag name.
+	Extensions map[string]string
+}
+
+// String returns the DKIM key record in canonical form, with tags sorted by name, where the version and tags set to
+// their default values are omitted.
+func (r DKIM) String() string {
+	var tags []tagSpec
+
+	if len(r.HashAlgorithms) > 0 {
+		tags = append(tags, tagSpec{name: "h", value: strings.Join(r.HashAlgorithms, ":")})
+	}
+
+	if r.KeyType != dkimDefaultKeyType {
+		tags = append(tags, tagSpec{name: "k", value: r.KeyType})
+	}
+
+	if r.Notes != "" {
+		tags = append(tags, tagSpec{name: "n", value: r.Notes})
+	}
+
+	tags = append(tags, tagSpec{name: "p", value: base64.StdEncoding.EncodeToString(r.PublicKey)})
+
+	if s := strings.Join(r.ServiceTypes, ":"); s != dkimDefaultServiceType {
+		tags = append(tags, tagSpec{name: "s", value: s})
+	}
+
+	if len(r.Flags) > 0 {
+		tags = append(tags, tagSpec{name: "t", value: strings.Join(r.Flags, ":")})
+	}
+
+	for name, value := range r.Extensions {
+		tags = append(tags, tagSpec{name: name, value: value})
+	}
+
+	return formatTagList(tags, 0)
+}
+
+// parseDKIM parses a DKIM key record from a TXT record value.
+func parseDKIM(s string) (DKIM, error) {
+	text, err := decodeTXT(s)
+	if err != nil {
+		return DKIM{}, fmt.Errorf("DKIM record %q: %w", s, err)
+	}
+
+	record, err := parseDKIMTags(text)
+	if err != nil {
+		return DKIM{}, fmt.Errorf("DKIM record %q: %w", s, err)
+	}
+
+	return record, nil
+}
+
+// parseDKIMTags parses the tag list of DKIM key record text.
+func parseDKIMTags(text string) (DKIM, error) {
+	tags, err := parseTagList(text)
+	if err != nil {
+		return DKIM{}, err
+	}
+
+	record := DKIM{
+		KeyType:      dkimDefaultKeyType,
+		ServiceTypes: []string{dkimDefaultServiceType},
+	}
+
+	publicKey := ""
+	hasPublicKey := false
+
+	for i, tag := range tags {
+		var err error
+
+		switch tag.name {
+		case "v":
+			if i != 0 {
+				err = errors.New("must be the first tag")
+			} else if tag.value != dkimVersion {
+				err = fmt.Errorf("%q must be %s", tag.value, dkimVersion)
+			}
+		case "h":
+			record.HashAlgorithms, err = parseDKIMTokens(tag.value, false)
+		case "k":
+			if tag.value != DKIMKeyTypeRSA && tag.value != DKIMKeyTypeEd25519 {
+				err = fmt.Errorf("%q must be %s or %s", tag.value, DKIMKeyTypeRSA, DKIMKeyTypeEd25519)
+			}
+
+			record.KeyType = tag.value
+		case "n":
+			record.Notes = tag.value
+		case "p":
+			publicKey = tag.value
+			hasPublicKey = true
+		case "s":
+			record.ServiceTypes, err = parseDKIMTokens(tag.value, true)
+		case "t":
+			record.Flags, err = parseDKIMTokens(tag.value, false)
+		default:
+			// Unknown tags must be ignored by verifiers, but are kept so that changes to them are not ignored
+			if record.Extensions == nil {
+				record.Extensions = make(map[string]string)
+			}
+
+			record.Extensions[tag.name] = tag.value
+		}
+
+		if err != nil {
+			return DKIM{}, fmt.Errorf("tag %q: %w", tag.name, err)
+		}
+	}
+
+	if !hasPublicKey {
+		return DKIM{}, errors.New("must contain a p tag, which is empty for a revoked key")
+	}
+
+	// The public key is validated last, as it depends on the key type
+	record.PublicKey, err = parseDKIMPublicKey(publicKey, record.KeyType)
+	if err != nil {
+		return DKIM{}, fmt.Errorf("tag \"p\": %w", err)
+	}
+
+	return record, nil
+}
+
+// parseDKIMTokens parses a colon separated list of tokens, which are letters and digits, such as the hash algorithms
+// of the h tag. The asterisk is also allowed if wildcard is true, such as the service types of the s tag.
+func parseDKIMTokens(value string, wildcard bool) ([]string, error) {
+	tokens, err := splitTagValueList(value, ":")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, token := range tokens {
+		if wildcard && token == "*" {
+			continue
+		}
+
+		if !isAlphanumeric(token) {
+			return nil, fmt.Errorf("%q must only contain letters and digits", token)
+		}
+	}
+
+	return tokens, nil
+}
+
+// parseDKIMPublicKey decodes the base64 public key of the p tag, ignoring whitespace, and validates it against the
+// key type. An empty value is a revoked key.
+func parseDKIMPublicKey(value string, keyType string) ([]byte, error) {
+	encoded := strings.Map(func(r rune) rune {
+		if isTagListSpace(r) {
+			return -1
+		}
+
+		return r
+	}, value)
+
+	publicKey, err := base64.StdEncoding.Strict().DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("public key must be base64 encoded: %w", err)
+	}
+
+	if len(publicKey) == 0 {
+		return publicKey, nil
+	}
+
+	switch keyType {
+	case DKIMKeyTypeEd25519:
+		if len(publicKey) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("ed25519 public key must be %d bytes, got %d", ed25519.PublicKeySize, len(publicKey))
+		}
+	default:
+		rsaPublicKey, err := parseDKIMRSAPublicKey(publicKey)
+		if err != nil {
+			return nil, err
+		}
+
+		if rsaPublicKey.N.BitLen() < minDKIMRSAKeySize {
+			return nil, fmt.Errorf("rsa public key must be at least %d bits, got %d", minDKIMRSAKeySize, rsaPublicKey.N.BitLen())
+		}
+	}
+
+	return publicKey, nil
+}
+
+// parseDKIMRSAPublicKey parses a DER encoded RSA public key, which is a SubjectPublicKeyInfo as published by most
+// signers, or an RSAPublicKey as described by RFC 6376.
+func parseDKIMRSAPublicKey(der []byte) (*rsa.PublicKey, error) {
+	if publicKey, err := x509.ParsePKCS1PublicKey(der); err == nil {
+		return publicKey, nil
+	}
+
+	publicKey, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("rsa public key must be a DER encoded public key: %w", err)
+	}
+
+	rsaPublicKey, ok := publicKey.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("rsa public key must be an RSA public key, got %T", publicKey)
+	}
+
+	return rsaPublicKey, nil
+}
+
+// NewDKIMRecordNull creates a DKIMRecord with a null value. Determine whether the value is null via IsNull method.
+func NewDKIMRecordNull() DKIMRecord {
+	return DKIMRecord{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewDKIMRecordUnknown creates a DKIMRecord with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewDKIMRecordUnknown() DKIMRecord {
+	return DKIMRecord{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewDKIMRecordValue creates a DKIMRecord with a known value. Access the value via ValueString method.
+func NewDKIMRecordValue(value string) DKIMRecord {
+	return DKIMRecord{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewDKIMRecordPointerValue creates a DKIMRecord with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewDKIMRecordPointerValue(value *string) DKIMRecord {
+	return DKIMRecord{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
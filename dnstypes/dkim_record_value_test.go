@@ -0,0 +1,325 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/dnstypes"
+)
+
+func TestDKIMRecordStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentDKIM   dnstypes.DKIMRecord
+		givenDKIM     basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"semantically equal - byte-for-byte match": {
+			currentDKIM:   dnstypes.NewDKIMRecordValue("v=DKIM1; k=ed25519; p=11qYAYKxCrfVS/7TyWQHOg7hcvPapiMlrwIaaPcHURo="),
+			givenDKIM:     dnstypes.NewDKIMRecordValue("v=DKIM1; k=ed25519; p=11qYAYKxCrfVS/7TyWQHOg7hcvPapiMlrwIaaPcHURo="),
+			expectedMatch: true,
+		},
+		"semantically equal - quoted and chunked": {
+			currentDKIM:   dnstypes.NewDKIMRecordValue("v=DKIM1; k=ed25519; p=11qYAYKxCrfVS/7TyWQHOg7hcvPapiMlrwIaaPcHURo="),
+			givenDKIM:     dnstypes.NewDKIMRecordValue(`"v=DKIM1; k=ed25519; " "p=11qYAYKxCrfVS/7TyWQHOg7hcvPapiMlrwIaaPcHURo="`),
+			expectedMatch: true,
+		},
+		"semantically equal - whitespace and trailing semicolon": {
+			currentDKIM:   dnstypes.NewDKIMRecordValue("v=DKIM1; k=ed25519; p=11qYAYKxCrfVS/7TyWQHOg7hcvPapiMlrwIaaPcHURo="),
+			givenDKIM:     dnstypes.NewDKIMRecordValue("v=DKIM1;k=ed25519 ; p = 11qYAYKxCrfVS/7TyWQH Og7hcvPapiMlrwIaaPcHURo=;"),
+			expectedMatch: true,
+		},
+		"semantically equal - tag order": {
+			currentDKIM:   dnstypes.NewDKIMRecordValue("v=DKIM1; k=ed25519; p=11qYAYKxCrfVS/7TyWQHOg7hcvPapiMlrwIaaPcHURo="),
+			givenDKIM:     dnstypes.NewDKIMRecordValue("p=11qYAYKxCrfVS/7TyWQHOg7hcvPapiMlrwIaaPcHURo=; k=ed25519"),
+			expectedMatch: true,
+		},
+		"semantically equal - default values": {
+			currentDKIM:   dnstypes.NewDKIMRecordValue("v=DKIM1; p=MIGfMA0GCSqGSIb3DQEBAQUAA4GNADCBiQKBgQDrfXTdA3f3eSy6rnV8AoLKq/s8aPQHcjGb4eNqjTJ4dOSBIkC/Mq4F7IEpz5y8SpZljb0w3dSEnmmU+H1YP4dHEmneM875LwkHEwv1OsCqFD6PeRMT/SYblaRu3h4SR1vr13mKx9NPmwdSdJjkxe4j0BgnqMIOWqKxY2325XZ41wIDAQAB"),
+			givenDKIM:     dnstypes.NewDKIMRecordValue("k=rsa; s=*; p=MIGfMA0GCSqGSIb3DQEBAQUAA4GNADCBiQKBgQDrfXTdA3f3eSy6rnV8AoLKq/s8aPQHcjGb4eNqjTJ4dOSBIkC/Mq4F7IEpz5y8SpZljb0w3dSEnmmU+H1YP4dHEmneM875LwkHEwv1OsCqFD6PeRMT/SYblaRu3h4SR1vr13mKx9NPmwdSdJjkxe4j0BgnqMIOWqKxY2325XZ41wIDAQAB"),
+			expectedMatch: true,
+		},
+		"not equal - different public key": {
+			currentDKIM:   dnstypes.NewDKIMRecordValue("v=DKIM1; k=ed25519; p=11qYAYKxCrfVS/7TyWQHOg7hcvPapiMlrwIaaPcHURo="),
+			givenDKIM:     dnstypes.NewDKIMRecordValue("v=DKIM1; k=ed25519; p=CUjRlbGmJHtGK9xPvNQ7XW9gAEPDfsqDfRaYxqJE1iw="),
+			expectedMatch: false,
+		},
+		"not equal - revoked": {
+			currentDKIM:   dnstypes.NewDKIMRecordValue("v=DKIM1; k=ed25519; p=11qYAYKxCrfVS/7TyWQHOg7hcvPapiMlrwIaaPcHURo="),
+			givenDKIM:     dnstypes.NewDKIMRecordValue("v=DKIM1; k=ed25519; p="),
+			expectedMatch: false,
+		},
+		"not equal - testing flag": {
+			currentDKIM:   dnstypes.NewDKIMRecordValue("v=DKIM1; k=ed25519; p=11qYAYKxCrfVS/7TyWQHOg7hcvPapiMlrwIaaPcHURo="),
+			givenDKIM:     dnstypes.NewDKIMRecordValue("v=DKIM1; k=ed25519; t=y; p=11qYAYKxCrfVS/7TyWQHOg7hcvPapiMlrwIaaPcHURo="),
+			expectedMatch: false,
+		},
+		"error - not given DKIMRecord value": {
+			currentDKIM:   dnstypes.NewDKIMRecordValue("v=DKIM1; k=ed25519; p=11qYAYKxCrfVS/7TyWQHOg7hcvPapiMlrwIaaPcHURo="),
+			givenDKIM:     basetypes.NewStringValue("v=DKIM1; k=ed25519; p=11qYAYKxCrfVS/7TyWQHOg7hcvPapiMlrwIaaPcHURo="),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: dnstypes.DKIMRecord\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentDKIM.StringSemanticEquals(context.Background(), testCase.givenDKIM)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDKIMRecordValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		dkimValue     dnstypes.DKIMRecord
+		expectedError string
+	}{
+		"empty-struct": {
+			dkimValue: dnstypes.DKIMRecord{},
+		},
+		"null": {
+			dkimValue: dnstypes.NewDKIMRecordNull(),
+		},
+		"unknown": {
+			dkimValue: dnstypes.NewDKIMRecordUnknown(),
+		},
+		"valid - ed25519": {
+			dkimValue: dnstypes.NewDKIMRecordValue("v=DKIM1; k=ed25519; p=11qYAYKxCrfVS/7TyWQHOg7hcvPapiMlrwIaaPcHURo="),
+		},
+		"valid - rsa SubjectPublicKeyInfo": {
+			dkimValue: dnstypes.NewDKIMRecordValue("v=DKIM1; k=rsa; p=MIGfMA0GCSqGSIb3DQEBAQUAA4GNADCBiQKBgQDrfXTdA3f3eSy6rnV8AoLKq/s8aPQHcjGb4eNqjTJ4dOSBIkC/Mq4F7IEpz5y8SpZljb0w3dSEnmmU+H1YP4dHEmneM875LwkHEwv1OsCqFD6PeRMT/SYblaRu3h4SR1vr13mKx9NPmwdSdJjkxe4j0BgnqMIOWqKxY2325XZ41wIDAQAB"),
+		},
+		"valid - rsa RSAPublicKey": {
+			dkimValue: dnstypes.NewDKIMRecordValue("v=DKIM1; p=MIGJAoGBAN5gz9tDfeyDF3rcM1MprLnyZtXWskD+vQzQ4RlcnvxAkxCehwmWDo4XckvchtW37ElhZuPr9MMCUyfXAL+QkCVpzDYwI4kREuuBrJjkgP66P34wt+X3TrkZKU3wPAO86ry5pkVbwLD4CgFPBLbk6Ro95oR6HiIWHwmTYyQP8soXAgMBAAE="),
+		},
+		"valid - revoked": {
+			dkimValue: dnstypes.NewDKIMRecordValue("v=DKIM1; p="),
+		},
+		"valid - all tags": {
+			dkimValue: dnstypes.NewDKIMRecordValue("v=DKIM1; h=sha256; k=rsa; n=notes; s=email; t=y:s; p=MIGfMA0GCSqGSIb3DQEBAQUAA4GNADCBiQKBgQDrfXTdA3f3eSy6rnV8AoLKq/s8aPQHcjGb4eNqjTJ4dOSBIkC/Mq4F7IEpz5y8SpZljb0w3dSEnmmU+H1YP4dHEmneM875LwkHEwv1OsCqFD6PeRMT/SYblaRu3h4SR1vr13mKx9NPmwdSdJjkxe4j0BgnqMIOWqKxY2325XZ41wIDAQAB"),
+		},
+		"valid - extension tag": {
+			dkimValue: dnstypes.NewDKIMRecordValue("v=DKIM1; p=; x=y"),
+		},
+		"valid - quoted": {
+			dkimValue: dnstypes.NewDKIMRecordValue(`"v=DKIM1; k=rsa; p=MIGfMA0GCSqGSIb3DQEBAQUAA4GNADCBiQKBgQDrfXTdA3f3eSy6rnV8AoLKq/s8aPQHcjGb4eNqjTJ4dOSBIkC/Mq4F7IEpz5y8" "SpZljb0w3dSEnmmU+H1YP4dHEmneM875LwkHEwv1OsCqFD6PeRMT/SYblaRu3h4SR1vr13mKx9NPmwdSdJjkxe4j0BgnqMIOWqKxY2325XZ41wIDAQAB"`),
+		},
+		"invalid - empty": {
+			dkimValue:     dnstypes.NewDKIMRecordValue(""),
+			expectedError: "DKIM record \"\": tag 1 must not be empty",
+		},
+		"invalid - missing public key": {
+			dkimValue:     dnstypes.NewDKIMRecordValue("v=DKIM1; k=rsa"),
+			expectedError: "DKIM record \"v=DKIM1; k=rsa\": must contain a p tag, which is empty for a revoked key",
+		},
+		"invalid - version": {
+			dkimValue:     dnstypes.NewDKIMRecordValue("v=DKIM2; p="),
+			expectedError: "DKIM record \"v=DKIM2; p=\": tag \"v\": \"DKIM2\" must be DKIM1",
+		},
+		"invalid - version not first": {
+			dkimValue:     dnstypes.NewDKIMRecordValue("p=; v=DKIM1"),
+			expectedError: "DKIM record \"p=; v=DKIM1\": tag \"v\": must be the first tag",
+		},
+		"invalid - key type": {
+			dkimValue:     dnstypes.NewDKIMRecordValue("k=dsa; p="),
+			expectedError: "DKIM record \"k=dsa; p=\": tag \"k\": \"dsa\" must be rsa or ed25519",
+		},
+		"invalid - hash algorithm": {
+			dkimValue:     dnstypes.NewDKIMRecordValue("h=sha-256; p="),
+			expectedError: "DKIM record \"h=sha-256; p=\": tag \"h\": \"sha-256\" must only contain letters and digits",
+		},
+		"invalid - public key encoding": {
+			dkimValue:     dnstypes.NewDKIMRecordValue("p=abc"),
+			expectedError: "DKIM record \"p=abc\": tag \"p\": public key must be base64 encoded: illegal base64 data at input byte 0",
+		},
+		"invalid - rsa public key size": {
+			dkimValue:     dnstypes.NewDKIMRecordValue("p=MFwwDQYJKoZIhvcNAQEBBQADSwAwSAJBAL71cwW0fbImacx8osBMG1n81d6Gnp84kzOb3eIZHLIo6gLGWTYJ6tEtWf+aEnduc3mvOufHRUWRn/tqKkuV/wsCAwEAAQ=="),
+			expectedError: "DKIM record \"p=MFwwDQYJKoZIhvcNAQEBBQADSwAwSAJBAL71cwW0fbImacx8osBMG1n81d6Gnp84kzOb3eIZHLIo6gLGWTYJ6tEtWf+aEnduc3mvOufHRUWRn/tqKkuV/wsCAwEAAQ==\": tag \"p\": rsa public key must be at least 1024 bits, got 512",
+		},
+		"invalid - rsa public key type": {
+			dkimValue:     dnstypes.NewDKIMRecordValue("k=rsa; p=MFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAEqCpWL4zrifL/vCLaY2FOixDrzM8ntq4SlV71FT5e/CzqM2/ZUzHxxh++4jBx/IT7rz+OfSmYGSygvHDn/VhCRQ=="),
+			expectedError: "DKIM record \"k=rsa; p=MFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAEqCpWL4zrifL/vCLaY2FOixDrzM8ntq4SlV71FT5e/CzqM2/ZUzHxxh++4jBx/IT7rz+OfSmYGSygvHDn/VhCRQ==\": tag \"p\": rsa public key must be an RSA public key, got *ecdsa.PublicKey",
+		},
+		"invalid - ed25519 public key size": {
+			dkimValue:     dnstypes.NewDKIMRecordValue("k=ed25519; p=MIGfMA0GCSqGSIb3DQEBAQUAA4GNADCBiQKBgQDrfXTdA3f3eSy6rnV8AoLKq/s8aPQHcjGb4eNqjTJ4dOSBIkC/Mq4F7IEpz5y8SpZljb0w3dSEnmmU+H1YP4dHEmneM875LwkHEwv1OsCqFD6PeRMT/SYblaRu3h4SR1vr13mKx9NPmwdSdJjkxe4j0BgnqMIOWqKxY2325XZ41wIDAQAB"),
+			expectedError: "DKIM record \"k=ed25519; p=MIGfMA0GCSqGSIb3DQEBAQUAA4GNADCBiQKBgQDrfXTdA3f3eSy6rnV8AoLKq/s8aPQHcjGb4eNqjTJ4dOSBIkC/Mq4F7IEpz5y8SpZljb0w3dSEnmmU+H1YP4dHEmneM875LwkHEwv1OsCqFD6PeRMT/SYblaRu3h4SR1vr13mKx9NPmwdSdJjkxe4j0BgnqMIOWqKxY2325XZ41wIDAQAB\": tag \"p\": ed25519 public key must be 32 bytes, got 162",
+		},
+		"invalid - duplicate tag": {
+			dkimValue:     dnstypes.NewDKIMRecordValue("k=rsa; k=rsa; p="),
+			expectedError: "DKIM record \"k=rsa; k=rsa; p=\": tag \"k\" must not be duplicated",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var expectedDiags diag.Diagnostics
+
+			if testCase.expectedError != "" {
+				expectedDiags.AddAttributeError(
+					path.Root("test"),
+					"Invalid DKIM Record String Value",
+					"A string value was provided that is not valid DKIM key record string format (RFC 6376).\n\n"+
+						"Given Value: "+testCase.dkimValue.ValueString()+"\n"+
+						"Error: "+testCase.expectedError,
+				)
+			}
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.dkimValue.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{Path: path.Root("test")},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDKIMRecordValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		dkimValue       dnstypes.DKIMRecord
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			dkimValue: dnstypes.DKIMRecord{},
+		},
+		"null": {
+			dkimValue: dnstypes.NewDKIMRecordNull(),
+		},
+		"unknown": {
+			dkimValue: dnstypes.NewDKIMRecordUnknown(),
+		},
+		"valid": {
+			dkimValue: dnstypes.NewDKIMRecordValue("v=DKIM1; k=ed25519; p=11qYAYKxCrfVS/7TyWQHOg7hcvPapiMlrwIaaPcHURo="),
+		},
+		"invalid": {
+			dkimValue: dnstypes.NewDKIMRecordValue("k=dsa; p="),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid DKIM Record String Value: "+
+					"A string value was provided that is not valid DKIM key record string format (RFC 6376).\n\n"+
+					"Given Value: "+"k=dsa; p="+"\n"+
+					"Error: "+"DKIM record \"k=dsa; p=\": tag \"k\": \"dsa\" must be rsa or ed25519",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.dkimValue.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDKIMRecordValueDKIM(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		dkimValue     dnstypes.DKIMRecord
+		expectedDKIM  dnstypes.DKIM
+		expectedDiags diag.Diagnostics
+	}{
+		"DKIM record value is null": {
+			dkimValue: dnstypes.NewDKIMRecordNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"DKIMRecord ValueDKIM Error",
+					"DKIM record string value is null",
+				),
+			},
+		},
+		"DKIM record value is unknown": {
+			dkimValue: dnstypes.NewDKIMRecordUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"DKIMRecord ValueDKIM Error",
+					"DKIM record string value is unknown",
+				),
+			},
+		},
+		"valid": {
+			dkimValue: dnstypes.NewDKIMRecordValue("v=DKIM1; k=ed25519; h=sha256; t=y; n=test key; p=11qYAYKxCrfVS/7TyWQHOg7hcvPapiMlrwIaaPcHURo="),
+			expectedDKIM: dnstypes.DKIM{
+				HashAlgorithms: []string{"sha256"},
+				KeyType:        "ed25519",
+				Notes:          "test key",
+				PublicKey: []byte{
+					0xd7, 0x5a, 0x98, 0x01, 0x82, 0xb1, 0x0a, 0xb7,
+					0xd5, 0x4b, 0xfe, 0xd3, 0xc9, 0x64, 0x07, 0x3a,
+					0x0e, 0xe1, 0x72, 0xf3, 0xda, 0xa6, 0x23, 0x25,
+					0xaf, 0x02, 0x1a, 0x68, 0xf7, 0x07, 0x51, 0x1a,
+				},
+				ServiceTypes: []string{"*"},
+				Flags:        []string{"y"},
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			record, diags := testCase.dkimValue.ValueDKIM()
+
+			if diff := cmp.Diff(record, testCase.expectedDKIM); diff != "" {
+				t.Errorf("Unexpected difference in DKIM record (-got, +expected): %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
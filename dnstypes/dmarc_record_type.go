@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = (*DMARCRecordType)(nil)
+
+// DMARCRecordType is an attribute type that represents a valid DMARC record (RFC 7489), which is a TXT record value
+// containing a tag list that starts with v=DMARC1 and contains a policy, such as
+// `v=DMARC1; p=reject; rua=mailto:dmarc@example.com`. Semantic equality logic is defined for DMARCRecordType, so that
+// quoting, chunking, whitespace, the order of tags after the version, letter case of keywords, a trailing semicolon
+// and tags set to their default values are ignored.
+//
+// All of the following are semantically equal:
+//   - v=DMARC1; p=reject; rua=mailto:dmarc@example.com
+//   - v=DMARC1; rua=mailto:dmarc@example.com; p=REJECT; pct=100;
+//   - "v=DMARC1;p=reject;" "adkim=r;rua=mailto:dmarc@example.com"
+type DMARCRecordType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t DMARCRecordType) String() string {
+	return "dnstypes.DMARCRecordType"
+}
+
+// ValueType returns the Value type.
+func (t DMARCRecordType) ValueType(ctx context.Context) attr.Value {
+	return DMARCRecord{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t DMARCRecordType) Equal(o attr.Type) bool {
+	other, ok := o.(DMARCRecordType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t DMARCRecordType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return DMARCRecord{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t DMARCRecordType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
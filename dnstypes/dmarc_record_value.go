@@ -0,0 +1,479 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*DMARCRecord)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*DMARCRecord)(nil)
+	_ xattr.ValidateableAttribute                = (*DMARCRecord)(nil)
+	_ function.ValidateableParameter             = (*DMARCRecord)(nil)
+)
+
+// dmarcVersion is the version that every DMARC record must start with (RFC 7489 Section 6.3).
+const dmarcVersion = "DMARC1"
+
+// DMARC tag defaults, which are used when a tag is not present (RFC 7489 Section 6.3).
+const (
+	dmarcDefaultAlignment      = "r"
+	dmarcDefaultFailureOption  = "0"
+	dmarcDefaultPercentage     = 100
+	dmarcDefaultReportFormat   = "afrf"
+	dmarcDefaultReportInterval = 86400
+)
+
+// DMARCRecord represents a valid DMARC record (RFC 7489), which is a TXT record value containing a tag list that
+// starts with v=DMARC1 and contains a policy, such as `v=DMARC1; p=reject; rua=mailto:dmarc@example.com`. The value
+// may be unquoted text or quoted character strings, as accepted by TXTRecord. Semantic equality logic is defined for
+// DMARCRecord, so that quoting, chunking, whitespace, the order of tags after the version, letter case of
+// keywords, a trailing semicolon and tags set to their default values are ignored.
+//
+// All of the following are semantically equal:
+//   - v=DMARC1; p=reject; rua=mailto:dmarc@example.com
+//   - v=DMARC1; rua=mailto:dmarc@example.com; p=REJECT; pct=100;
+//   - "v=DMARC1;p=reject;" "adkim=r;rua=mailto:dmarc@example.com"
+type DMARCRecord struct {
+	basetypes.StringValue
+}
+
+// Type returns a DMARCRecordType.
+func (v DMARCRecord) Type(_ context.Context) attr.Type {
+	return DMARCRecordType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v DMARCRecord) Equal(o attr.Value) bool {
+	other, ok := o.(DMARCRecord)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given DMARC record string value is semantically equal to the current
+// DMARC record string value. This comparison uses the canonical form of both records, so that quoting, chunking,
+// whitespace, the order of tags after the version, letter case of keywords, a trailing semicolon and tags set to
+// their default values are ignored.
+//
+// All of the following are semantically equal:
+//   - v=DMARC1; p=reject; rua=mailto:dmarc@example.com
+//   - v=DMARC1; rua=mailto:dmarc@example.com; p=REJECT; pct=100;
+//   - "v=DMARC1;p=reject;" "adkim=r;rua=mailto:dmarc@example.com"
+func (v DMARCRecord) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(DMARCRecord)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// DMARC records are already validated at this point, ignoring errors
+	newDMARC, _ := parseDMARC(newValue.ValueString())
+	currentDMARC, _ := parseDMARC(v.ValueString())
+
+	return currentDMARC.String() == newDMARC.String(), diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String
+// value that is a valid DMARC record, with v=DMARC1 as the first tag, a p tag and valid values for the other tags
+// defined by RFC 7489.
+func (v DMARCRecord) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseDMARC(v.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid DMARC Record String Value",
+			"A string value was provided that is not valid DMARC record string format (RFC 7489).\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is a valid DMARC record, with v=DMARC1 as the first tag, a p tag and valid
+// values for the other tags defined by RFC 7489.
+func (v DMARCRecord) ValidateParameter(_ context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseDMARC(v.ValueString())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid DMARC Record String Value: "+
+				"A string value was provided that is not valid DMARC record string format (RFC 7489).\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueDMARC parses the DMARCRecord StringValue into its tags, with keywords in lowercase and default values filled
+// in for tags that are not present. A null or unknown value will produce an error diagnostic.
+func (v DMARCRecord) ValueDMARC() (DMARC, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("DMARCRecord ValueDMARC Error", "DMARC record string value is null"))
+		return DMARC{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("DMARCRecord ValueDMARC Error", "DMARC record string value is unknown"))
+		return DMARC{}, diags
+	}
+
+	record, err := parseDMARC(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("DMARCRecord ValueDMARC Error", err.Error()))
+		return DMARC{}, diags
+	}
+
+	return record, nil
+}
+
+// DMARC is a parsed DMARC record (RFC 7489 Section 6.3).
+type DMARC struct {
+	// Policy is the requested policy for the domain, which is one of none, quarantine or reject.
+	Policy string
+
+	// SubdomainPolicy is the requested policy for subdomains, which defaults to Policy.
+	SubdomainPolicy string
+
+	// DKIMAlignment is the DKIM identifier alignment mode, which is r for relaxed or s for strict and defaults to r.
+	DKIMAlignment string
+
+	// SPFAlignment is the SPF identifier alignment mode, which is r for relaxed or s for strict and defaults to r.
+	SPFAlignment string
+
+	// Percentage is the percentage of messages the policy applies to, which defaults to 100.
+	Percentage int
+
+	// FailureOptions are the failure reporting options, which are 0, 1, d or s and default to 0.
+	FailureOptions []string
+
+	// ReportFormats are the failure report formats, which default to afrf.
+	ReportFormats []string
+
+	// ReportInterval is the requested interval between aggregate reports in seconds, which defaults to 86400.
+	ReportInterval uint32
+
+	// AggregateReportURIs are the URIs that aggregate reports are sent to, such as mailto:dmarc@example.com.
+	AggregateReportURIs []string
+
+	// FailureReportURIs are the URIs that failure reports are sent to.
+	FailureReportURIs []string
+
+	// Extensions are tags that are not defined by RFC 7489, such as the np tag (RFC 9091), by tag name.
+	Extensions map[string]string
+}
+
+// String returns the DMARC record in canonical form, with the version and policy followed by the remaining tags
+// sorted by name, where tags set to their default values are omitted.
+func (r DMARC) String() string {
+	tags := []tagSpec{
+		{name: "v", value: dmarcVersion},
+		{name: "p", value: r.Policy},
+	}
+
+	if r.SubdomainPolicy != r.Policy {
+		tags = append(tags, tagSpec{name: "sp", value: r.SubdomainPolicy})
+	}
+
+	if r.DKIMAlignment != dmarcDefaultAlignment {
+		tags = append(tags, tagSpec{name: "adkim", value: r.DKIMAlignment})
+	}
+
+	if r.SPFAlignment != dmarcDefaultAlignment {
+		tags = append(tags, tagSpec{name: "aspf", value: r.SPFAlignment})
+	}
+
+	if r.Percentage != dmarcDefaultPercentage {
+		tags = append(tags, tagSpec{name: "pct", value: strconv.Itoa(r.Percentage)})
+	}
+
+	if fo := strings.Join(r.FailureOptions, ":"); fo != dmarcDefaultFailureOption {
+		tags = append(tags, tagSpec{name: "fo", value: fo})
+	}
+
+	if rf := strings.Join(r.ReportFormats, ":"); rf != dmarcDefaultReportFormat {
+		tags = append(tags, tagSpec{name: "rf", value: rf})
+	}
+
+	if r.ReportInterval != dmarcDefaultReportInterval {
+		tags = append(tags, tagSpec{name: "ri", value: strconv.FormatUint(uint64(r.ReportInterval), 10)})
+	}
+
+	if len(r.AggregateReportURIs) > 0 {
+		tags = append(tags, tagSpec{name: "rua", value: strings.Join(r.AggregateReportURIs, ",")})
+	}
+
+	if len(r.FailureReportURIs) > 0 {
+		tags = append(tags, tagSpec{name: "ruf", value: strings.Join(r.FailureReportURIs, ",")})
+	}
+
+	for name, value := range r.Extensions {
+		tags = append(tags, tagSpec{name: name, value: value})
+	}
+
+	return formatTagList(tags, 2)
+}
+
+// parseDMARC parses a DMARC record from a TXT record value.
+func parseDMARC(s string) (DMARC, error) {
+	text, err := decodeTXT(s)
+	if err != nil {
+		return DMARC{}, fmt.Errorf("DMARC record %q: %w", s, err)
+	}
+
+	record, err := parseDMARCTags(text)
+	if err != nil {
+		return DMARC{}, fmt.Errorf("DMARC record %q: %w", s, err)
+	}
+
+	return record, nil
+}
+
+// parseDMARCTags parses the tag list of DMARC record text.
+func parseDMARCTags(text string) (DMARC, error) {
+	tags, err := parseTagList(text)
+	if err != nil {
+		return DMARC{}, err
+	}
+
+	if tags[0].name != "v" || tags[0].value != dmarcVersion {
+		return DMARC{}, fmt.Errorf("first tag must be v=%s", dmarcVersion)
+	}
+
+	record := DMARC{
+		DKIMAlignment:  dmarcDefaultAlignment,
+		SPFAlignment:   dmarcDefaultAlignment,
+		Percentage:     dmarcDefaultPercentage,
+		FailureOptions: []string{dmarcDefaultFailureOption},
+		ReportFormats:  []string{dmarcDefaultReportFormat},
+		ReportInterval: dmarcDefaultReportInterval,
+	}
+
+	for _, tag := range tags[1:] {
+		var err error
+
+		switch tag.name {
+		case "p":
+			record.Policy, err = parseDMARCKeyword(tag.value, "none", "quarantine", "reject")
+		case "sp":
+			record.SubdomainPolicy, err = parseDMARCKeyword(tag.value, "none", "quarantine", "reject")
+		case "adkim":
+			record.DKIMAlignment, err = parseDMARCKeyword(tag.value, "r", "s")
+		case "aspf":
+			record.SPFAlignment, err = parseDMARCKeyword(tag.value, "r", "s")
+		case "pct":
+			record.Percentage, err = parseDMARCPercentage(tag.value)
+		case "fo":
+			record.FailureOptions, err = parseDMARCFailureOptions(tag.value)
+		case "rf":
+			record.ReportFormats, err = parseDMARCReportFormats(tag.value)
+		case "ri":
+			var interval uint64
+
+			interval, err = strconv.ParseUint(tag.value, 10, 32)
+			if err != nil {
+				err = fmt.Errorf("%q must be a number of seconds between 0 and 4294967295", tag.value)
+			}
+
+			record.ReportInterval = uint32(interval)
+		case "rua":
+			record.AggregateReportURIs, err = parseDMARCURIs(tag.value)
+		case "ruf":
+			record.FailureReportURIs, err = parseDMARCURIs(tag.value)
+		case "v":
+			err = errors.New("must be the first tag")
+		default:
+			// Unknown tags must be ignored by receivers, but are kept so that changes to them are not ignored
+			if record.Extensions == nil {
+				record.Extensions = make(map[string]string)
+			}
+
+			record.Extensions[tag.name] = tag.value
+		}
+
+		if err != nil {
+			return DMARC{}, fmt.Errorf("tag %q: %w", tag.name, err)
+		}
+	}
+
+	if record.Policy == "" {
+		return DMARC{}, errors.New("must contain a p tag")
+	}
+
+	if record.SubdomainPolicy == "" {
+		record.SubdomainPolicy = record.Policy
+	}
+
+	return record, nil
+}
+
+// parseDMARCKeyword parses a case-insensitive keyword value, which must be one of the given keywords.
+func parseDMARCKeyword(value string, keywords ...string) (string, error) {
+	keyword := strings.ToLower(value)
+
+	for _, k := range keywords {
+		if keyword == k {
+			return keyword, nil
+		}
+	}
+
+	return "", fmt.Errorf("%q must be one of %s", value, strings.Join(keywords, ", "))
+}
+
+// parseDMARCPercentage parses a pct value, which is a whole number from 0 to 100.
+func parseDMARCPercentage(value string) (int, error) {
+	percentage, err := strconv.Atoi(value)
+	if err != nil || !isDigits(value) || len(value) > 3 || percentage > 100 {
+		return 0, fmt.Errorf("%q must be a whole number from 0 to 100", value)
+	}
+
+	return percentage, nil
+}
+
+// parseDMARCFailureOptions parses a colon separated fo value, where each option is 0, 1, d or s.
+func parseDMARCFailureOptions(value string) ([]string, error) {
+	options, err := splitTagValueList(value, ":")
+	if err != nil {
+		return nil, err
+	}
+
+	for i, option := range options {
+		options[i], err = parseDMARCKeyword(option, "0", "1", "d", "s")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return options, nil
+}
+
+// parseDMARCReportFormats parses a colon separated rf value, where each format is a keyword of letters, digits and
+// hyphens, such as afrf.
+func parseDMARCReportFormats(value string) ([]string, error) {
+	formats, err := splitTagValueList(value, ":")
+	if err != nil {
+		return nil, err
+	}
+
+	for i, format := range formats {
+		if !isHostnameLabel(format) {
+			return nil, fmt.Errorf("report format %q must only contain letters, digits and hyphens", format)
+		}
+
+		formats[i] = strings.ToLower(format)
+	}
+
+	return formats, nil
+}
+
+// parseDMARCURIs parses a comma separated rua or ruf value, where each URI may be followed by an exclamation mark
+// and a maximum report size with an optional k, m, g or t unit, such as mailto:dmarc@example.com!10m.
+func parseDMARCURIs(value string) ([]string, error) {
+	uris, err := splitTagValueList(value, ",")
+	if err != nil {
+		return nil, err
+	}
+
+	for i, uri := range uris {
+		rawURI, size, hasSize := strings.Cut(uri, "!")
+
+		if hasSize {
+			digits := strings.TrimRight(strings.ToLower(size), "kmgt")
+
+			if !isDigits(digits) || len(size)-len(digits) > 1 {
+				return nil, fmt.Errorf("URI %q: maximum report size %q must be digits with an optional k, m, g or t unit", uri, size)
+			}
+
+			size = strings.ToLower(size)
+		}
+
+		u, err := url.Parse(rawURI)
+		if err != nil {
+			return nil, fmt.Errorf("URI %q: %w", uri, err)
+		}
+
+		if u.Scheme == "" {
+			return nil, fmt.Errorf("URI %q must have a scheme, such as mailto:", uri)
+		}
+
+		if strings.EqualFold(u.Scheme, "mailto") && !strings.Contains(u.Opaque, "@") {
+			return nil, fmt.Errorf("URI %q must contain an email address", uri)
+		}
+
+		uris[i] = rawURI
+
+		if hasSize {
+			uris[i] += "!" + size
+		}
+	}
+
+	return uris, nil
+}
+
+// NewDMARCRecordNull creates a DMARCRecord with a null value. Determine whether the value is null via IsNull method.
+func NewDMARCRecordNull() DMARCRecord {
+	return DMARCRecord{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewDMARCRecordUnknown creates a DMARCRecord with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewDMARCRecordUnknown() DMARCRecord {
+	return DMARCRecord{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewDMARCRecordValue creates a DMARCRecord with a known value. Access the value via ValueString method.
+func NewDMARCRecordValue(value string) DMARCRecord {
+	return DMARCRecord{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewDMARCRecordPointerValue creates a DMARCRecord with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewDMARCRecordPointerValue(value *string) DMARCRecord {
+	return DMARCRecord{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
@@ -0,0 +1,34 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/dnstypes"
+)
+
+type DMARCRecordResourceModel struct {
+	Value dnstypes.DMARCRecord `tfsdk:"value"`
+}
+
+func ExampleDMARCRecord_ValueDMARC() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := DMARCRecordResourceModel{
+		Value: dnstypes.NewDMARCRecordValue("v=DMARC1; p=quarantine; sp=reject; rua=mailto:dmarc@example.com"),
+	}
+
+	// Check that the DMARCRecord data is known and read the requested policies
+	if !data.Value.IsNull() && !data.Value.IsUnknown() {
+		dmarc, diags := data.Value.ValueDMARC()
+		if diags.HasError() {
+			return
+		}
+
+		fmt.Println(dmarc.Policy, dmarc.SubdomainPolicy, dmarc.Percentage)
+
+		// Output: quarantine reject 100
+	}
+}
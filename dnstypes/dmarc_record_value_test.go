@@ -0,0 +1,341 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/dnstypes"
+)
+
+func TestDMARCRecordStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentDMARC  dnstypes.DMARCRecord
+		givenDMARC    basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"semantically equal - byte-for-byte match": {
+			currentDMARC:  dnstypes.NewDMARCRecordValue("v=DMARC1; p=reject; rua=mailto:dmarc@example.com"),
+			givenDMARC:    dnstypes.NewDMARCRecordValue("v=DMARC1; p=reject; rua=mailto:dmarc@example.com"),
+			expectedMatch: true,
+		},
+		"semantically equal - quoted and chunked": {
+			currentDMARC:  dnstypes.NewDMARCRecordValue("v=DMARC1; p=reject; rua=mailto:dmarc@example.com"),
+			givenDMARC:    dnstypes.NewDMARCRecordValue(`"v=DMARC1; p=reject; " "rua=mailto:dmarc@example.com"`),
+			expectedMatch: true,
+		},
+		"semantically equal - whitespace and trailing semicolon": {
+			currentDMARC:  dnstypes.NewDMARCRecordValue("v=DMARC1; p=reject; rua=mailto:dmarc@example.com"),
+			givenDMARC:    dnstypes.NewDMARCRecordValue("v=DMARC1;p=reject ;  rua = mailto:dmarc@example.com;"),
+			expectedMatch: true,
+		},
+		"semantically equal - tag order": {
+			currentDMARC:  dnstypes.NewDMARCRecordValue("v=DMARC1; p=reject; rua=mailto:dmarc@example.com"),
+			givenDMARC:    dnstypes.NewDMARCRecordValue("v=DMARC1; rua=mailto:dmarc@example.com; p=reject"),
+			expectedMatch: true,
+		},
+		"semantically equal - keyword letter case": {
+			currentDMARC:  dnstypes.NewDMARCRecordValue("v=DMARC1; p=reject; rua=mailto:dmarc@example.com"),
+			givenDMARC:    dnstypes.NewDMARCRecordValue("v=DMARC1; p=REJECT; rua=mailto:dmarc@example.com"),
+			expectedMatch: true,
+		},
+		"semantically equal - default values": {
+			currentDMARC:  dnstypes.NewDMARCRecordValue("v=DMARC1; p=reject; rua=mailto:dmarc@example.com"),
+			givenDMARC:    dnstypes.NewDMARCRecordValue("v=DMARC1; p=reject; sp=reject; adkim=r; aspf=r; pct=100; fo=0; rf=afrf; ri=86400; rua=mailto:dmarc@example.com"),
+			expectedMatch: true,
+		},
+		"not equal - different policy": {
+			currentDMARC:  dnstypes.NewDMARCRecordValue("v=DMARC1; p=reject; rua=mailto:dmarc@example.com"),
+			givenDMARC:    dnstypes.NewDMARCRecordValue("v=DMARC1; p=quarantine; rua=mailto:dmarc@example.com"),
+			expectedMatch: false,
+		},
+		"not equal - different subdomain policy": {
+			currentDMARC:  dnstypes.NewDMARCRecordValue("v=DMARC1; p=reject; rua=mailto:dmarc@example.com"),
+			givenDMARC:    dnstypes.NewDMARCRecordValue("v=DMARC1; p=reject; sp=none; rua=mailto:dmarc@example.com"),
+			expectedMatch: false,
+		},
+		"not equal - different report URI": {
+			currentDMARC:  dnstypes.NewDMARCRecordValue("v=DMARC1; p=reject; rua=mailto:dmarc@example.com"),
+			givenDMARC:    dnstypes.NewDMARCRecordValue("v=DMARC1; p=reject; rua=mailto:reports@example.com"),
+			expectedMatch: false,
+		},
+		"not equal - extension tag": {
+			currentDMARC:  dnstypes.NewDMARCRecordValue("v=DMARC1; p=reject; rua=mailto:dmarc@example.com"),
+			givenDMARC:    dnstypes.NewDMARCRecordValue("v=DMARC1; p=reject; np=none; rua=mailto:dmarc@example.com"),
+			expectedMatch: false,
+		},
+		"error - not given DMARCRecord value": {
+			currentDMARC:  dnstypes.NewDMARCRecordValue("v=DMARC1; p=reject; rua=mailto:dmarc@example.com"),
+			givenDMARC:    basetypes.NewStringValue("v=DMARC1; p=reject; rua=mailto:dmarc@example.com"),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: dnstypes.DMARCRecord\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentDMARC.StringSemanticEquals(context.Background(), testCase.givenDMARC)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDMARCRecordValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		dmarcValue    dnstypes.DMARCRecord
+		expectedError string
+	}{
+		"empty-struct": {
+			dmarcValue: dnstypes.DMARCRecord{},
+		},
+		"null": {
+			dmarcValue: dnstypes.NewDMARCRecordNull(),
+		},
+		"unknown": {
+			dmarcValue: dnstypes.NewDMARCRecordUnknown(),
+		},
+		"valid - policy only": {
+			dmarcValue: dnstypes.NewDMARCRecordValue("v=DMARC1; p=none"),
+		},
+		"valid - all tags": {
+			dmarcValue: dnstypes.NewDMARCRecordValue("v=DMARC1; p=quarantine; sp=none; adkim=s; aspf=s; pct=50; fo=1:d:s; rf=afrf; ri=3600; rua=mailto:a@example.com!10m, mailto:b@example.net; ruf=mailto:forensic@example.com"),
+		},
+		"valid - extension tag": {
+			dmarcValue: dnstypes.NewDMARCRecordValue("v=DMARC1; p=reject; np=reject"),
+		},
+		"valid - quoted": {
+			dmarcValue: dnstypes.NewDMARCRecordValue(`"v=DMARC1; p=reject;" " rua=mailto:dmarc@example.com"`),
+		},
+		"invalid - empty": {
+			dmarcValue:    dnstypes.NewDMARCRecordValue(""),
+			expectedError: "DMARC record \"\": tag 1 must not be empty",
+		},
+		"invalid - version not first": {
+			dmarcValue:    dnstypes.NewDMARCRecordValue("p=reject; v=DMARC1"),
+			expectedError: "DMARC record \"p=reject; v=DMARC1\": first tag must be v=DMARC1",
+		},
+		"invalid - version": {
+			dmarcValue:    dnstypes.NewDMARCRecordValue("v=DMARC2; p=reject"),
+			expectedError: "DMARC record \"v=DMARC2; p=reject\": first tag must be v=DMARC1",
+		},
+		"invalid - missing policy": {
+			dmarcValue:    dnstypes.NewDMARCRecordValue("v=DMARC1; rua=mailto:dmarc@example.com"),
+			expectedError: "DMARC record \"v=DMARC1; rua=mailto:dmarc@example.com\": must contain a p tag",
+		},
+		"invalid - policy": {
+			dmarcValue:    dnstypes.NewDMARCRecordValue("v=DMARC1; p=block"),
+			expectedError: "DMARC record \"v=DMARC1; p=block\": tag \"p\": \"block\" must be one of none, quarantine, reject",
+		},
+		"invalid - alignment": {
+			dmarcValue:    dnstypes.NewDMARCRecordValue("v=DMARC1; p=reject; adkim=x"),
+			expectedError: "DMARC record \"v=DMARC1; p=reject; adkim=x\": tag \"adkim\": \"x\" must be one of r, s",
+		},
+		"invalid - percentage": {
+			dmarcValue:    dnstypes.NewDMARCRecordValue("v=DMARC1; p=reject; pct=101"),
+			expectedError: "DMARC record \"v=DMARC1; p=reject; pct=101\": tag \"pct\": \"101\" must be a whole number from 0 to 100",
+		},
+		"invalid - failure option": {
+			dmarcValue:    dnstypes.NewDMARCRecordValue("v=DMARC1; p=reject; fo=2"),
+			expectedError: "DMARC record \"v=DMARC1; p=reject; fo=2\": tag \"fo\": \"2\" must be one of 0, 1, d, s",
+		},
+		"invalid - report interval": {
+			dmarcValue:    dnstypes.NewDMARCRecordValue("v=DMARC1; p=reject; ri=-1"),
+			expectedError: "DMARC record \"v=DMARC1; p=reject; ri=-1\": tag \"ri\": \"-1\" must be a number of seconds between 0 and 4294967295",
+		},
+		"invalid - report URI without scheme": {
+			dmarcValue:    dnstypes.NewDMARCRecordValue("v=DMARC1; p=reject; rua=dmarc@example.com"),
+			expectedError: "DMARC record \"v=DMARC1; p=reject; rua=dmarc@example.com\": tag \"rua\": URI \"dmarc@example.com\" must have a scheme, such as mailto:",
+		},
+		"invalid - report URI without email address": {
+			dmarcValue:    dnstypes.NewDMARCRecordValue("v=DMARC1; p=reject; rua=mailto:example.com"),
+			expectedError: "DMARC record \"v=DMARC1; p=reject; rua=mailto:example.com\": tag \"rua\": URI \"mailto:example.com\" must contain an email address",
+		},
+		"invalid - report size": {
+			dmarcValue:    dnstypes.NewDMARCRecordValue("v=DMARC1; p=reject; ruf=mailto:dmarc@example.com!10x"),
+			expectedError: "DMARC record \"v=DMARC1; p=reject; ruf=mailto:dmarc@example.com!10x\": tag \"ruf\": URI \"mailto:dmarc@example.com!10x\": maximum report size \"10x\" must be digits with an optional k, m, g or t unit",
+		},
+		"invalid - duplicate tag": {
+			dmarcValue:    dnstypes.NewDMARCRecordValue("v=DMARC1; p=reject; p=none"),
+			expectedError: "DMARC record \"v=DMARC1; p=reject; p=none\": tag \"p\" must not be duplicated",
+		},
+		"invalid - empty tag": {
+			dmarcValue:    dnstypes.NewDMARCRecordValue("v=DMARC1;; p=reject"),
+			expectedError: "DMARC record \"v=DMARC1;; p=reject\": tag 2 must not be empty",
+		},
+		"invalid - tag without value": {
+			dmarcValue:    dnstypes.NewDMARCRecordValue("v=DMARC1; p=reject; rua"),
+			expectedError: "DMARC record \"v=DMARC1; p=reject; rua\": tag \"rua\" must be a tag=value pair",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var expectedDiags diag.Diagnostics
+
+			if testCase.expectedError != "" {
+				expectedDiags.AddAttributeError(
+					path.Root("test"),
+					"Invalid DMARC Record String Value",
+					"A string value was provided that is not valid DMARC record string format (RFC 7489).\n\n"+
+						"Given Value: "+testCase.dmarcValue.ValueString()+"\n"+
+						"Error: "+testCase.expectedError,
+				)
+			}
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.dmarcValue.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{Path: path.Root("test")},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDMARCRecordValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		dmarcValue      dnstypes.DMARCRecord
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			dmarcValue: dnstypes.DMARCRecord{},
+		},
+		"null": {
+			dmarcValue: dnstypes.NewDMARCRecordNull(),
+		},
+		"unknown": {
+			dmarcValue: dnstypes.NewDMARCRecordUnknown(),
+		},
+		"valid": {
+			dmarcValue: dnstypes.NewDMARCRecordValue("v=DMARC1; p=reject; rua=mailto:dmarc@example.com"),
+		},
+		"invalid": {
+			dmarcValue: dnstypes.NewDMARCRecordValue("v=DMARC1; p=block"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid DMARC Record String Value: "+
+					"A string value was provided that is not valid DMARC record string format (RFC 7489).\n\n"+
+					"Given Value: "+"v=DMARC1; p=block"+"\n"+
+					"Error: "+"DMARC record \"v=DMARC1; p=block\": tag \"p\": \"block\" must be one of none, quarantine, reject",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.dmarcValue.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDMARCRecordValueDMARC(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		dmarcValue    dnstypes.DMARCRecord
+		expectedDMARC dnstypes.DMARC
+		expectedDiags diag.Diagnostics
+	}{
+		"DMARC record value is null": {
+			dmarcValue: dnstypes.NewDMARCRecordNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"DMARCRecord ValueDMARC Error",
+					"DMARC record string value is null",
+				),
+			},
+		},
+		"DMARC record value is unknown": {
+			dmarcValue: dnstypes.NewDMARCRecordUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"DMARCRecord ValueDMARC Error",
+					"DMARC record string value is unknown",
+				),
+			},
+		},
+		"valid": {
+			dmarcValue: dnstypes.NewDMARCRecordValue("v=DMARC1; p=Quarantine; pct=25; fo=1:D; rua=mailto:a@example.com!10M,mailto:b@example.net; np=reject"),
+			expectedDMARC: dnstypes.DMARC{
+				Policy:              "quarantine",
+				SubdomainPolicy:     "quarantine",
+				DKIMAlignment:       "r",
+				SPFAlignment:        "r",
+				Percentage:          25,
+				FailureOptions:      []string{"1", "d"},
+				ReportFormats:       []string{"afrf"},
+				ReportInterval:      86400,
+				AggregateReportURIs: []string{"mailto:a@example.com!10m", "mailto:b@example.net"},
+				Extensions:          map[string]string{"np": "reject"},
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			record, diags := testCase.dmarcValue.ValueDMARC()
+
+			if diff := cmp.Diff(record, testCase.expectedDMARC); diff != "" {
+				t.Errorf("Unexpected difference in DMARC record (-got, +expected): %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
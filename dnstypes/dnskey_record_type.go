@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = (*DNSKEYRecordType)(nil)
+
+// DNSKEYRecordType is an attribute type that represents valid DNSKEY record RDATA in presentation format, which is
+// 16-bit flags, the protocol 3 and a DNSSEC algorithm followed by a base64 public key, such as
+// `257 3 15 l02Woi0iS8Aa25FQkUd9RMzZHJpBoRQwAQEX1SxZJA4=`. The algorithm must be assigned in the IANA registry and the
+// public key must match the key format of the algorithm. Semantic equality logic is defined for DNSKEYRecordType, so
+// that algorithm mnemonics and whitespace in the public key are ignored.
+//
+// All of the following are semantically equal:
+//   - 257 3 15 l02Woi0iS8Aa25FQkUd9RMzZHJpBoRQwAQEX1SxZJA4=
+//   - 257 3 ED25519 l02Woi0iS8Aa25FQkUd9RMzZHJpBoRQwAQEX1SxZJA4=
+//   - 257 3 15 l02Woi0iS8Aa25FQkUd9 RMzZHJpBoRQwAQEX1SxZJA4=
+type DNSKEYRecordType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t DNSKEYRecordType) String() string {
+	return "dnstypes.DNSKEYRecordType"
+}
+
+// ValueType returns the Value type.
+func (t DNSKEYRecordType) ValueType(ctx context.Context) attr.Value {
+	return DNSKEYRecord{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t DNSKEYRecordType) Equal(o attr.Type) bool {
+	other, ok := o.(DNSKEYRecordType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t DNSKEYRecordType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return DNSKEYRecord{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t DNSKEYRecordType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
@@ -0,0 +1,343 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*DNSKEYRecord)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*DNSKEYRecord)(nil)
+	_ xattr.ValidateableAttribute                = (*DNSKEYRecord)(nil)
+	_ function.ValidateableParameter             = (*DNSKEYRecord)(nil)
+)
+
+// DNSKEYRecord represents valid DNSKEY record RDATA in presentation format, which is 16-bit flags, the protocol 3
+// and a DNSSEC algorithm followed by a base64 public key, such as
+// `257 3 15 l02Woi0iS8Aa25FQkUd9RMzZHJpBoRQwAQEX1SxZJA4=`. The algorithm must be assigned in the IANA registry and
+// the public key must match the key format of the algorithm. Semantic equality logic is defined for DNSKEYRecord, so
+// that algorithm mnemonics and whitespace in the public key are ignored.
+//
+// All of the following are semantically equal:
+//   - 257 3 15 l02Woi0iS8Aa25FQkUd9RMzZHJpBoRQwAQEX1SxZJA4=
+//   - 257 3 ED25519 l02Woi0iS8Aa25FQkUd9RMzZHJpBoRQwAQEX1SxZJA4=
+//   - 257 3 15 l02Woi0iS8Aa25FQkUd9 RMzZHJpBoRQwAQEX1SxZJA4=
+type DNSKEYRecord struct {
+	basetypes.StringValue
+}
+
+// Type returns a DNSKEYRecordType.
+func (v DNSKEYRecord) Type(_ context.Context) attr.Type {
+	return DNSKEYRecordType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v DNSKEYRecord) Equal(o attr.Value) bool {
+	other, ok := o.(DNSKEYRecord)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given DNSKEY record string value is semantically equal to the current
+// DNSKEY record string value. This comparison uses the canonical presentation format of both values, so that
+// algorithm mnemonics and whitespace in the public key are ignored.
+//
+// All of the following are semantically equal:
+//   - 257 3 15 l02Woi0iS8Aa25FQkUd9RMzZHJpBoRQwAQEX1SxZJA4=
+//   - 257 3 ED25519 l02Woi0iS8Aa25FQkUd9RMzZHJpBoRQwAQEX1SxZJA4=
+//   - 257 3 15 l02Woi0iS8Aa25FQkUd9 RMzZHJpBoRQwAQEX1SxZJA4=
+func (v DNSKEYRecord) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(DNSKEYRecord)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// DNSKEY records are already validated at this point, ignoring errors
+	newDNSKEY, _ := parseDNSKEY(newValue.ValueString())
+	currentDNSKEY, _ := parseDNSKEY(v.ValueString())
+
+	return currentDNSKEY.String() == newDNSKEY.String(), diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String
+// value that is valid DNSKEY record RDATA, with protocol 3, an assigned algorithm and a public key in the format of
+// the algorithm.
+func (v DNSKEYRecord) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseDNSKEY(v.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid DNSKEY Record String Value",
+			"A string value was provided that is not valid DNSKEY record string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is valid DNSKEY record RDATA, with protocol 3, an assigned algorithm and a
+// public key in the format of the algorithm.
+func (v DNSKEYRecord) ValidateParameter(_ context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseDNSKEY(v.ValueString())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid DNSKEY Record String Value: "+
+				"A string value was provided that is not valid DNSKEY record string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueDNSKEY parses the DNSKEYRecord StringValue into its flags, protocol, algorithm and decoded public key. A null
+// or unknown value will produce an error diagnostic.
+func (v DNSKEYRecord) ValueDNSKEY() (DNSKEY, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("DNSKEYRecord ValueDNSKEY Error", "DNSKEY record string value is null"))
+		return DNSKEY{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("DNSKEYRecord ValueDNSKEY Error", "DNSKEY record string value is unknown"))
+		return DNSKEY{}, diags
+	}
+
+	record, err := parseDNSKEY(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("DNSKEYRecord ValueDNSKEY Error", err.Error()))
+		return DNSKEY{}, diags
+	}
+
+	return record, nil
+}
+
+// ValueDS computes the DS record of the DNSKEYRecord StringValue with the given owner name and digest type, which
+// allows computed DS attributes to be populated without querying DNS. A null or unknown value will produce a null or
+// unknown DSRecord respectively. An invalid value, owner name or digest type will produce an error diagnostic.
+func (v DNSKEYRecord) ValueDS(ownerName string, digestType uint8) (DSRecord, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		return NewDSRecordNull(), nil
+	}
+
+	if v.IsUnknown() {
+		return NewDSRecordUnknown(), nil
+	}
+
+	record, err := parseDNSKEY(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("DNSKEYRecord ValueDS Error", err.Error()))
+		return NewDSRecordUnknown(), diags
+	}
+
+	ds, err := record.DS(ownerName, digestType)
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("DNSKEYRecord ValueDS Error", err.Error()))
+		return NewDSRecordUnknown(), diags
+	}
+
+	return NewDSRecordValue(ds.String()), nil
+}
+
+// DNSKEY is the parsed RDATA of a DNSKEY record (RFC 4034 Section 2.1).
+type DNSKEY struct {
+	// Flags is the 16-bit flags field, where DNSKEYFlagZoneKey and DNSKEYFlagSecureEntryPoint are commonly set.
+	Flags uint16
+
+	// Protocol is the protocol field, which is always 3.
+	Protocol uint8
+
+	// Algorithm is the DNSSEC algorithm number of the public key, such as 13 for ECDSAP256SHA256.
+	Algorithm uint8
+
+	// PublicKey is the decoded public key in the format of the algorithm.
+	PublicKey []byte
+}
+
+const (
+	// DNSKEYFlagZoneKey is the Zone Key flag of a DNSKEY record, which is set on keys that sign zone data
+	// (RFC 4034 Section 2.1.1).
+	DNSKEYFlagZoneKey uint16 = 0x0100
+
+	// DNSKEYFlagRevoke is the REVOKE flag of a DNSKEY record (RFC 5011 Section 3).
+	DNSKEYFlagRevoke uint16 = 0x0080
+
+	// DNSKEYFlagSecureEntryPoint is the Secure Entry Point flag of a DNSKEY record, which is commonly set on key
+	// signing keys (RFC 4034 Section 2.1.1).
+	DNSKEYFlagSecureEntryPoint uint16 = 0x0001
+
+	// dnskeyProtocol is the only valid value of the protocol field (RFC 4034 Section 2.1.2).
+	dnskeyProtocol = 3
+)
+
+// String returns the DNSKEY RDATA in canonical presentation format with a numeric algorithm and an unbroken base64
+// public key.
+func (r DNSKEY) String() string {
+	return fmt.Sprintf("%d %d %d %s", r.Flags, r.Protocol, r.Algorithm, base64.StdEncoding.EncodeToString(r.PublicKey))
+}
+
+// KeyTag returns the key tag of the DNSKEY record, which identifies the key in DS and RRSIG records
+// (RFC 4034 Appendix B).
+func (r DNSKEY) KeyTag() uint16 {
+	return dnssecKeyTag(r.Algorithm, r.wire(), r.PublicKey)
+}
+
+// DS computes the DS record referring to the DNSKEY record at the given owner name with the given digest type
+// (RFC 4034 Section 5.1.4). The DNSKEY record must have the Zone Key flag set, and the SHA-1, SHA-256 and SHA-384
+// digest types are supported.
+func (r DNSKEY) DS(ownerName string, digestType uint8) (DS, error) {
+	if r.Flags&DNSKEYFlagZoneKey == 0 {
+		return DS{}, errors.New("DNSKEY record must have the Zone Key flag set to be referred to by a DS record")
+	}
+
+	entry, ok := lookupDSDigestType(digestType)
+	if !ok {
+		return DS{}, fmt.Errorf("digest type %d must be an assigned DS digest type number", digestType)
+	}
+
+	if entry.hash == nil {
+		return DS{}, fmt.Errorf("digest type %d (%s) is not supported for computing DS records", entry.number, entry.name)
+	}
+
+	owner, err := dnssecOwnerName(ownerName)
+	if err != nil {
+		return DS{}, fmt.Errorf("owner name: %w", err)
+	}
+
+	h := entry.hash()
+	h.Write(owner)
+	h.Write(r.wire())
+
+	return DS{KeyTag: r.KeyTag(), Algorithm: r.Algorithm, DigestType: entry.number, Digest: h.Sum(nil)}, nil
+}
+
+// wire returns the DNSKEY RDATA in wire format.
+func (r DNSKEY) wire() []byte {
+	rdata := make([]byte, 0, 4+len(r.PublicKey))
+	rdata = append(rdata, byte(r.Flags>>8), byte(r.Flags), r.Protocol, r.Algorithm)
+
+	return append(rdata, r.PublicKey...)
+}
+
+// parseDNSKEY parses DNSKEY RDATA in presentation format.
+func parseDNSKEY(s string) (DNSKEY, error) {
+	record, err := parseDNSKEYFields(s)
+	if err != nil {
+		return DNSKEY{}, fmt.Errorf("DNSKEY record %q: %w", s, err)
+	}
+
+	return record, nil
+}
+
+// parseDNSKEYFields parses the flags, protocol, algorithm and public key fields of DNSKEY RDATA.
+func parseDNSKEYFields(s string) (DNSKEY, error) {
+	tokens, err := splitRDATA(s)
+	if err != nil {
+		return DNSKEY{}, err
+	}
+
+	if len(tokens) < 4 {
+		return DNSKEY{}, fmt.Errorf("must contain flags, protocol, algorithm and public key fields, got %d fields", len(tokens))
+	}
+
+	flags, err := parseRDATAUint(tokens[0], "flags", 16)
+	if err != nil {
+		return DNSKEY{}, err
+	}
+
+	protocol, err := parseRDATAUint(tokens[1], "protocol", 8)
+	if err != nil {
+		return DNSKEY{}, err
+	}
+
+	if protocol != dnskeyProtocol {
+		return DNSKEY{}, fmt.Errorf("protocol must be %d, got %d", dnskeyProtocol, protocol)
+	}
+
+	algorithm, err := parseRDATADNSSECAlgorithm(tokens[2])
+	if err != nil {
+		return DNSKEY{}, err
+	}
+
+	publicKey, err := parseRDATABase64(tokens[3:], "public key")
+	if err != nil {
+		return DNSKEY{}, err
+	}
+
+	err = validateDNSSECPublicKey(algorithm, publicKey)
+	if err != nil {
+		return DNSKEY{}, err
+	}
+
+	return DNSKEY{Flags: uint16(flags), Protocol: uint8(protocol), Algorithm: algorithm, PublicKey: publicKey}, nil
+}
+
+// NewDNSKEYRecordNull creates a DNSKEYRecord with a null value. Determine whether the value is null via IsNull method.
+func NewDNSKEYRecordNull() DNSKEYRecord {
+	return DNSKEYRecord{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewDNSKEYRecordUnknown creates a DNSKEYRecord with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewDNSKEYRecordUnknown() DNSKEYRecord {
+	return DNSKEYRecord{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewDNSKEYRecordValue creates a DNSKEYRecord with a known value. Access the value via ValueString method.
+func NewDNSKEYRecordValue(value string) DNSKEYRecord {
+	return DNSKEYRecord{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewDNSKEYRecordPointerValue creates a DNSKEYRecord with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewDNSKEYRecordPointerValue(value *string) DNSKEYRecord {
+	return DNSKEYRecord{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/dnstypes"
+)
+
+type DNSKEYRecordResourceModel struct {
+	Zone  string                `tfsdk:"zone"`
+	Value dnstypes.DNSKEYRecord `tfsdk:"value"`
+	DS    dnstypes.DSRecord     `tfsdk:"ds"`
+}
+
+func ExampleDNSKEYRecord_ValueDS() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := DNSKEYRecordResourceModel{
+		Zone:  "example.com.",
+		Value: dnstypes.NewDNSKEYRecordValue("257 3 15 l02Woi0iS8Aa25FQkUd9RMzZHJpBoRQwAQEX1SxZJA4="),
+	}
+
+	// Populate the computed SHA-256 DS record, which is unknown while the DNSKEY record is unknown
+	ds, diags := data.Value.ValueDS(data.Zone, 2)
+	if diags.HasError() {
+		return
+	}
+
+	data.DS = ds
+
+	// Output: 3613 15 2 3AA5AB37EFCE57F737FC1627013FEE07BDF241BD10F3B1964AB55C78E79A304B
+	fmt.Println(data.DS.ValueString())
+}
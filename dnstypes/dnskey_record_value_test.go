@@ -0,0 +1,451 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/dnstypes"
+)
+
+func TestDNSKEYRecordStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentDNSKEY dnstypes.DNSKEYRecord
+		givenDNSKEY   basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"semantically equal - byte-for-byte match": {
+			currentDNSKEY: dnstypes.NewDNSKEYRecordValue("257 3 15 l02Woi0iS8Aa25FQkUd9RMzZHJpBoRQwAQEX1SxZJA4="),
+			givenDNSKEY:   dnstypes.NewDNSKEYRecordValue("257 3 15 l02Woi0iS8Aa25FQkUd9RMzZHJpBoRQwAQEX1SxZJA4="),
+			expectedMatch: true,
+		},
+		"semantically equal - split public key": {
+			currentDNSKEY: dnstypes.NewDNSKEYRecordValue("257 3 15 l02Woi0iS8Aa25FQkUd9RMzZHJpBoRQwAQEX1SxZJA4="),
+			givenDNSKEY:   dnstypes.NewDNSKEYRecordValue("257 3 15 l02Woi0iS8Aa25FQkUd9 RMzZHJpBoRQwAQEX1SxZJA4="),
+			expectedMatch: true,
+		},
+		"semantically equal - algorithm mnemonic": {
+			currentDNSKEY: dnstypes.NewDNSKEYRecordValue("257 3 15 l02Woi0iS8Aa25FQkUd9RMzZHJpBoRQwAQEX1SxZJA4="),
+			givenDNSKEY:   dnstypes.NewDNSKEYRecordValue("257 3 ed25519 l02Woi0iS8Aa25FQkUd9RMzZHJpBoRQwAQEX1SxZJA4="),
+			expectedMatch: true,
+		},
+		"semantically equal - multi-line whitespace": {
+			currentDNSKEY: dnstypes.NewDNSKEYRecordValue("257 3 13 GojIhhXUN/u4v54ZQqGSnyhWJwaubCvTmeexv7bR6edbkrSqQpF64cYbcB7wNcP+e+MAnLr+Wi9xMWyQLc8NAA=="),
+			givenDNSKEY:   dnstypes.NewDNSKEYRecordValue("257 3 13 GojIhhXUN/u4v54ZQqGSnyhWJwaubCvTmeexv7bR6edb\n\tkrSqQpF64cYbcB7wNcP+e+MAnLr+Wi9xMWyQLc8NAA=="),
+			expectedMatch: true,
+		},
+		"not equal - different flags": {
+			currentDNSKEY: dnstypes.NewDNSKEYRecordValue("257 3 15 l02Woi0iS8Aa25FQkUd9RMzZHJpBoRQwAQEX1SxZJA4="),
+			givenDNSKEY:   dnstypes.NewDNSKEYRecordValue("256 3 15 l02Woi0iS8Aa25FQkUd9RMzZHJpBoRQwAQEX1SxZJA4="),
+			expectedMatch: false,
+		},
+		"not equal - different public key": {
+			currentDNSKEY: dnstypes.NewDNSKEYRecordValue("257 3 15 l02Woi0iS8Aa25FQkUd9RMzZHJpBoRQwAQEX1SxZJA4="),
+			givenDNSKEY:   dnstypes.NewDNSKEYRecordValue("257 3 15 l02Woi0iS8Aa25FQkUd9RMzZHJpBoRQwAQEX1SxZJB4="),
+			expectedMatch: false,
+		},
+		"error - not given DNSKEYRecord value": {
+			currentDNSKEY: dnstypes.NewDNSKEYRecordValue("257 3 15 l02Woi0iS8Aa25FQkUd9RMzZHJpBoRQwAQEX1SxZJA4="),
+			givenDNSKEY:   basetypes.NewStringValue("257 3 15 l02Woi0iS8Aa25FQkUd9RMzZHJpBoRQwAQEX1SxZJA4="),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: dnstypes.DNSKEYRecord\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentDNSKEY.StringSemanticEquals(context.Background(), testCase.givenDNSKEY)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDNSKEYRecordValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		dnskeyValue   dnstypes.DNSKEYRecord
+		expectedError string
+	}{
+		"empty-struct": {
+			dnskeyValue: dnstypes.DNSKEYRecord{},
+		},
+		"null": {
+			dnskeyValue: dnstypes.NewDNSKEYRecordNull(),
+		},
+		"unknown": {
+			dnskeyValue: dnstypes.NewDNSKEYRecordUnknown(),
+		},
+		"valid - RSASHA1": {
+			dnskeyValue: dnstypes.NewDNSKEYRecordValue("256 3 5 AQOeiiR0GOMYkDshWoSKz9XzfwJr1AYtsmx3TGkJaNXVbfi/2pHm822aJ5iI9BMzNXxeYCmZDRD99WYwYqUSdjMmmAphXdvxegXd/M5+X7OrzKBaMbCVdFLUUh6DhweJBjEVv5f2wwjM9XzcnOf+EPbtG9DMBmADjFDc2w/rljwvFw=="),
+		},
+		"valid - ECDSAP256SHA256": {
+			dnskeyValue: dnstypes.NewDNSKEYRecordValue("257 3 13 GojIhhXUN/u4v54ZQqGSnyhWJwaubCvTmeexv7bR6edbkrSqQpF64cYbcB7wNcP+e+MAnLr+Wi9xMWyQLc8NAA=="),
+		},
+		"valid - ED25519": {
+			dnskeyValue: dnstypes.NewDNSKEYRecordValue("257 3 ED25519 l02Woi0iS8Aa25FQkUd9RMzZHJpBoRQwAQEX1SxZJA4="),
+		},
+		"valid - private algorithm": {
+			dnskeyValue: dnstypes.NewDNSKEYRecordValue("256 3 253 AQID"),
+		},
+		"invalid - missing public key": {
+			dnskeyValue:   dnstypes.NewDNSKEYRecordValue("257 3 15"),
+			expectedError: "DNSKEY record \"257 3 15\": must contain flags, protocol, algorithm and public key fields, got 3 fields",
+		},
+		"invalid - protocol": {
+			dnskeyValue:   dnstypes.NewDNSKEYRecordValue("257 2 15 l02Woi0iS8Aa25FQkUd9RMzZHJpBoRQwAQEX1SxZJA4="),
+			expectedError: "DNSKEY record \"257 2 15 l02Woi0iS8Aa25FQkUd9RMzZHJpBoRQwAQEX1SxZJA4=\": protocol must be 3, got 2",
+		},
+		"invalid - flags out of range": {
+			dnskeyValue:   dnstypes.NewDNSKEYRecordValue("65536 3 15 l02Woi0iS8Aa25FQkUd9RMzZHJpBoRQwAQEX1SxZJA4="),
+			expectedError: "DNSKEY record \"65536 3 15 l02Woi0iS8Aa25FQkUd9RMzZHJpBoRQwAQEX1SxZJA4=\": flags \"65536\" must be an unsigned 16-bit decimal integer",
+		},
+		"invalid - unassigned algorithm": {
+			dnskeyValue:   dnstypes.NewDNSKEYRecordValue("257 3 9 l02Woi0iS8Aa25FQkUd9RMzZHJpBoRQwAQEX1SxZJA4="),
+			expectedError: "DNSKEY record \"257 3 9 l02Woi0iS8Aa25FQkUd9RMzZHJpBoRQwAQEX1SxZJA4=\": algorithm 9 must be an assigned DNSSEC algorithm number",
+		},
+		"invalid - public key not base64": {
+			dnskeyValue:   dnstypes.NewDNSKEYRecordValue("257 3 15 l02Woi0iS8Aa25FQkUd9RMzZHJpBoRQwAQEX1SxZJA4"),
+			expectedError: "DNSKEY record \"257 3 15 l02Woi0iS8Aa25FQkUd9RMzZHJpBoRQwAQEX1SxZJA4\": public key must be non-empty padded base64",
+		},
+		"invalid - ED25519 public key length": {
+			dnskeyValue:   dnstypes.NewDNSKEYRecordValue("257 3 15 GojIhhXUN/u4v54ZQqGSnyhWJwaubCvTmeexv7bR6edbkrSqQpF64cYbcB7wNcP+e+MAnLr+Wi9xMWyQLc8NAA=="),
+			expectedError: "DNSKEY record \"257 3 15 GojIhhXUN/u4v54ZQqGSnyhWJwaubCvTmeexv7bR6edbkrSqQpF64cYbcB7wNcP+e+MAnLr+Wi9xMWyQLc8NAA==\": ED25519 public key must be 32 bytes, got 64",
+		},
+		"invalid - RSA public key truncated exponent": {
+			dnskeyValue:   dnstypes.NewDNSKEYRecordValue("256 3 8 AwEA"),
+			expectedError: "DNSKEY record \"256 3 8 AwEA\": RSA public key exponent of 3 bytes is truncated",
+		},
+		"invalid - RSA public key without modulus": {
+			dnskeyValue:   dnstypes.NewDNSKEYRecordValue("256 3 8 AwEAAQ=="),
+			expectedError: "DNSKEY record \"256 3 8 AwEAAQ==\": RSA public key modulus must not be empty or have leading zeros",
+		},
+		"invalid - DSA public key length": {
+			dnskeyValue:   dnstypes.NewDNSKEYRecordValue("256 3 3 AAEC"),
+			expectedError: "DNSKEY record \"256 3 3 AAEC\": DSA public key with T parameter 0 must be 213 bytes, got 3",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var expectedDiags diag.Diagnostics
+
+			if testCase.expectedError != "" {
+				expectedDiags.AddAttributeError(
+					path.Root("test"),
+					"Invalid DNSKEY Record String Value",
+					"A string value was provided that is not valid DNSKEY record string format.\n\n"+
+						"Given Value: "+testCase.dnskeyValue.ValueString()+"\n"+
+						"Error: "+testCase.expectedError,
+				)
+			}
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.dnskeyValue.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{Path: path.Root("test")},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDNSKEYRecordValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		dnskeyValue     dnstypes.DNSKEYRecord
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			dnskeyValue: dnstypes.DNSKEYRecord{},
+		},
+		"null": {
+			dnskeyValue: dnstypes.NewDNSKEYRecordNull(),
+		},
+		"unknown": {
+			dnskeyValue: dnstypes.NewDNSKEYRecordUnknown(),
+		},
+		"valid": {
+			dnskeyValue: dnstypes.NewDNSKEYRecordValue("257 3 15 l02Woi0iS8Aa25FQkUd9RMzZHJpBoRQwAQEX1SxZJA4="),
+		},
+		"invalid": {
+			dnskeyValue: dnstypes.NewDNSKEYRecordValue("257 3 15"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid DNSKEY Record String Value: "+
+					"A string value was provided that is not valid DNSKEY record string format.\n\n"+
+					"Given Value: "+"257 3 15"+"\n"+
+					"Error: "+"DNSKEY record \"257 3 15\": must contain flags, protocol, algorithm and public key fields, got 3 fields",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.dnskeyValue.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDNSKEYRecordValueDNSKEY(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		dnskeyValue    dnstypes.DNSKEYRecord
+		expectedDNSKEY dnstypes.DNSKEY
+		expectedDiags  diag.Diagnostics
+	}{
+		"DNSKEY record value is null": {
+			dnskeyValue: dnstypes.NewDNSKEYRecordNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"DNSKEYRecord ValueDNSKEY Error",
+					"DNSKEY record string value is null",
+				),
+			},
+		},
+		"DNSKEY record value is unknown": {
+			dnskeyValue: dnstypes.NewDNSKEYRecordUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"DNSKEYRecord ValueDNSKEY Error",
+					"DNSKEY record string value is unknown",
+				),
+			},
+		},
+		"valid": {
+			dnskeyValue: dnstypes.NewDNSKEYRecordValue("257 3 ED25519 l02Woi0iS8Aa25FQkUd9 RMzZHJpBoRQwAQEX1SxZJA4="),
+			expectedDNSKEY: dnstypes.DNSKEY{Flags: 257, Protocol: 3, Algorithm: 15, PublicKey: []byte{
+				0x97, 0x4d, 0x96, 0xa2, 0x2d, 0x22, 0x4b, 0xc0,
+				0x1a, 0xdb, 0x91, 0x50, 0x91, 0x47, 0x7d, 0x44,
+				0xcc, 0xd9, 0x1c, 0x9a, 0x41, 0xa1, 0x14, 0x30,
+				0x01, 0x01, 0x17, 0xd5, 0x2c, 0x59, 0x24, 0x0e,
+			}},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			record, diags := testCase.dnskeyValue.ValueDNSKEY()
+
+			if diff := cmp.Diff(record, testCase.expectedDNSKEY); diff != "" {
+				t.Errorf("Unexpected difference in DNSKEY record (-got, +expected): %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDNSKEYRecordValueDS(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		dnskeyValue   dnstypes.DNSKEYRecord
+		ownerName     string
+		digestType    uint8
+		expectedDS    dnstypes.DSRecord
+		expectedDiags diag.Diagnostics
+	}{
+		"DNSKEY record value is null": {
+			dnskeyValue: dnstypes.NewDNSKEYRecordNull(),
+			ownerName:   "example.com.",
+			digestType:  2,
+			expectedDS:  dnstypes.NewDSRecordNull(),
+		},
+		"DNSKEY record value is unknown": {
+			dnskeyValue: dnstypes.NewDNSKEYRecordUnknown(),
+			ownerName:   "example.com.",
+			digestType:  2,
+			expectedDS:  dnstypes.NewDSRecordUnknown(),
+		},
+		"RSASHA1 SHA-1": {
+			dnskeyValue: dnstypes.NewDNSKEYRecordValue("256 3 5 AQOeiiR0GOMYkDshWoSKz9XzfwJr1AYtsmx3TGkJaNXVbfi/2pHm822aJ5iI9BMzNXxeYCmZDRD99WYwYqUSdjMmmAphXdvxegXd/M5+X7OrzKBaMbCVdFLUUh6DhweJBjEVv5f2wwjM9XzcnOf+EPbtG9DMBmADjFDc2w/rljwvFw=="),
+			ownerName:   "dskey.example.com.",
+			digestType:  1,
+			expectedDS:  dnstypes.NewDSRecordValue("60485 5 1 2BB183AF5F22588179A53B0A98631FAD1A292118"),
+		},
+		"RSASHA1 SHA-256": {
+			dnskeyValue: dnstypes.NewDNSKEYRecordValue("256 3 5 AQOeiiR0GOMYkDshWoSKz9XzfwJr1AYtsmx3TGkJaNXVbfi/2pHm822aJ5iI9BMzNXxeYCmZDRD99WYwYqUSdjMmmAphXdvxegXd/M5+X7OrzKBaMbCVdFLUUh6DhweJBjEVv5f2wwjM9XzcnOf+EPbtG9DMBmADjFDc2w/rljwvFw=="),
+			ownerName:   "dskey.example.com.",
+			digestType:  2,
+			expectedDS:  dnstypes.NewDSRecordValue("60485 5 2 D4B7D520E7BB5F0F67674A0CCEB1E3E0614B93C4F9E99B8383F6A1E4469DA50A"),
+		},
+		"ECDSAP256SHA256 SHA-256": {
+			dnskeyValue: dnstypes.NewDNSKEYRecordValue("257 3 13 GojIhhXUN/u4v54ZQqGSnyhWJwaubCvTmeexv7bR6edbkrSqQpF64cYbcB7wNcP+e+MAnLr+Wi9xMWyQLc8NAA=="),
+			ownerName:   "example.net.",
+			digestType:  2,
+			expectedDS:  dnstypes.NewDSRecordValue("55648 13 2 B4C8C1FE2E7477127B27115656AD6256F424625BF5C1E2770CE6D6E37DF61D17"),
+		},
+		"ED25519 SHA-256 - owner name letter case and missing trailing dot": {
+			dnskeyValue: dnstypes.NewDNSKEYRecordValue("257 3 15 l02Woi0iS8Aa25FQkUd9RMzZHJpBoRQwAQEX1SxZJA4="),
+			ownerName:   "Example.COM",
+			digestType:  2,
+			expectedDS:  dnstypes.NewDSRecordValue("3613 15 2 3AA5AB37EFCE57F737FC1627013FEE07BDF241BD10F3B1964AB55C78E79A304B"),
+		},
+		"error - unsupported digest type": {
+			dnskeyValue: dnstypes.NewDNSKEYRecordValue("257 3 15 l02Woi0iS8Aa25FQkUd9RMzZHJpBoRQwAQEX1SxZJA4="),
+			ownerName:   "example.com.",
+			digestType:  3,
+			expectedDS:  dnstypes.NewDSRecordUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"DNSKEYRecord ValueDS Error",
+					"digest type 3 (GOST R 34.11-94) is not supported for computing DS records",
+				),
+			},
+		},
+		"error - unassigned digest type": {
+			dnskeyValue: dnstypes.NewDNSKEYRecordValue("257 3 15 l02Woi0iS8Aa25FQkUd9RMzZHJpBoRQwAQEX1SxZJA4="),
+			ownerName:   "example.com.",
+			digestType:  0,
+			expectedDS:  dnstypes.NewDSRecordUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"DNSKEYRecord ValueDS Error",
+					"digest type 0 must be an assigned DS digest type number",
+				),
+			},
+		},
+		"error - not a zone key": {
+			dnskeyValue: dnstypes.NewDNSKEYRecordValue("1 3 15 l02Woi0iS8Aa25FQkUd9RMzZHJpBoRQwAQEX1SxZJA4="),
+			ownerName:   "example.com.",
+			digestType:  2,
+			expectedDS:  dnstypes.NewDSRecordUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"DNSKEYRecord ValueDS Error",
+					"DNSKEY record must have the Zone Key flag set to be referred to by a DS record",
+				),
+			},
+		},
+		"error - invalid owner name": {
+			dnskeyValue: dnstypes.NewDNSKEYRecordValue("257 3 15 l02Woi0iS8Aa25FQkUd9RMzZHJpBoRQwAQEX1SxZJA4="),
+			ownerName:   "example..com.",
+			digestType:  2,
+			expectedDS:  dnstypes.NewDSRecordUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"DNSKEYRecord ValueDS Error",
+					"owner name: domain name \"example..com.\": must not contain empty labels",
+				),
+			},
+		},
+		"error - invalid DNSKEY record": {
+			dnskeyValue: dnstypes.NewDNSKEYRecordValue("257 3 15"),
+			ownerName:   "example.com.",
+			digestType:  2,
+			expectedDS:  dnstypes.NewDSRecordUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"DNSKEYRecord ValueDS Error",
+					"DNSKEY record \"257 3 15\": must contain flags, protocol, algorithm and public key fields, got 3 fields",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ds, diags := testCase.dnskeyValue.ValueDS(testCase.ownerName, testCase.digestType)
+
+			if diff := cmp.Diff(ds, testCase.expectedDS); diff != "" {
+				t.Errorf("Unexpected difference in DS record (-got, +expected): %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDNSKEYKeyTag(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		dnskey         string
+		expectedKeyTag uint16
+	}{
+		"RSAMD5": {
+			dnskey:         "256 3 1 AQOeiiR0GOMYkDshWoSKz9XzfwJr1AYtsmx3TGkJaNXVbfi/2pHm822aJ5iI9BMzNXxeYCmZDRD99WYwYqUSdjMmmAphXdvxegXd/M5+X7OrzKBaMbCVdFLUUh6DhweJBjEVv5f2wwjM9XzcnOf+EPbtG9DMBmADjFDc2w/rljwvFw==",
+			expectedKeyTag: 0x3C2F,
+		},
+		"RSASHA1": {
+			dnskey:         "256 3 5 AQOeiiR0GOMYkDshWoSKz9XzfwJr1AYtsmx3TGkJaNXVbfi/2pHm822aJ5iI9BMzNXxeYCmZDRD99WYwYqUSdjMmmAphXdvxegXd/M5+X7OrzKBaMbCVdFLUUh6DhweJBjEVv5f2wwjM9XzcnOf+EPbtG9DMBmADjFDc2w/rljwvFw==",
+			expectedKeyTag: 60485,
+		},
+		"ED25519": {
+			dnskey:         "257 3 15 l02Woi0iS8Aa25FQkUd9RMzZHJpBoRQwAQEX1SxZJA4=",
+			expectedKeyTag: 3613,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			record, diags := dnstypes.NewDNSKEYRecordValue(testCase.dnskey).ValueDNSKEY()
+			if diags.HasError() {
+				t.Fatalf("Unexpected diagnostics: %v", diags)
+			}
+
+			if got := record.KeyTag(); got != testCase.expectedKeyTag {
+				t.Errorf("Expected KeyTag to return: %d, but got: %d", testCase.expectedKeyTag, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,238 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes
+
+import (
+	"crypto/sha1" //nolint:gosec // SHA-1 DS digests are still defined by RFC 4034 and in use
+	"crypto/sha256"
+	"crypto/sha512"
+	"errors"
+	"fmt"
+	"hash"
+	"strings"
+)
+
+// dnssecAlgorithm is an entry of the IANA DNS Security Algorithm Numbers registry.
+type dnssecAlgorithm struct {
+	number   uint8
+	mnemonic string
+
+	// publicKeyLength is the length of the public key in octets, or zero if the length varies.
+	publicKeyLength int
+}
+
+// dnssecAlgorithms are the DNSSEC algorithms that may be used in DNSKEY and DS records, from the IANA DNS Security
+// Algorithm Numbers registry (https://www.iana.org/assignments/dns-sec-alg-numbers). Reserved and unassigned numbers
+// and the Delete DS value, which is only valid in CDS and CDNSKEY records (RFC 8078), are not included.
+var dnssecAlgorithms = []dnssecAlgorithm{
+	{number: 1, mnemonic: "RSAMD5"},
+	{number: 2, mnemonic: "DH"},
+	{number: 3, mnemonic: "DSA"},
+	{number: 5, mnemonic: "RSASHA1"},
+	{number: 6, mnemonic: "DSA-NSEC3-SHA1"},
+	{number: 7, mnemonic: "RSASHA1-NSEC3-SHA1"},
+	{number: 8, mnemonic: "RSASHA256"},
+	{number: 10, mnemonic: "RSASHA512"},
+	{number: 12, mnemonic: "ECC-GOST", publicKeyLength: 64},
+	{number: 13, mnemonic: "ECDSAP256SHA256", publicKeyLength: 64},
+	{number: 14, mnemonic: "ECDSAP384SHA384", publicKeyLength: 96},
+	{number: 15, mnemonic: "ED25519", publicKeyLength: 32},
+	{number: 16, mnemonic: "ED448", publicKeyLength: 57},
+	{number: 17, mnemonic: "SM2SM3", publicKeyLength: 64},
+	{number: 23, mnemonic: "ECC-GOST12", publicKeyLength: 64},
+	{number: 252, mnemonic: "INDIRECT"},
+	{number: 253, mnemonic: "PRIVATEDNS"},
+	{number: 254, mnemonic: "PRIVATEOID"},
+}
+
+// dsDigestType is an entry of the IANA Delegation Signer (DS) Resource Record Digest Algorithms registry.
+type dsDigestType struct {
+	number uint8
+	name   string
+	length int
+
+	// hash creates the hash function of the digest type, or is nil if it is not available in the standard library.
+	hash func() hash.Hash
+}
+
+// dsDigestTypes are the digest types that may be used in DS records, from the IANA Delegation Signer (DS) Resource
+// Record Digest Algorithms registry (https://www.iana.org/assignments/ds-rr-types).
+var dsDigestTypes = []dsDigestType{
+	{number: 1, name: "SHA-1", length: sha1.Size, hash: sha1.New},
+	{number: 2, name: "SHA-256", length: sha256.Size, hash: sha256.New},
+	{number: 3, name: "GOST R 34.11-94", length: 32},
+	{number: 4, name: "SHA-384", length: sha512.Size384, hash: sha512.New384},
+	{number: 5, name: "GOST R 34.11-2012", length: 32},
+	{number: 6, name: "SM3", length: 32},
+}
+
+// DNSSEC algorithm numbers that have a specific public key format.
+const (
+	dnssecAlgorithmRSAMD5           = 1
+	dnssecAlgorithmDSA              = 3
+	dnssecAlgorithmRSASHA1          = 5
+	dnssecAlgorithmDSANSEC3SHA1     = 6
+	dnssecAlgorithmRSASHA1NSEC3SHA1 = 7
+	dnssecAlgorithmRSASHA256        = 8
+	dnssecAlgorithmRSASHA512        = 10
+)
+
+// lookupDNSSECAlgorithm returns the registry entry of a DNSSEC algorithm number.
+func lookupDNSSECAlgorithm(number uint8) (dnssecAlgorithm, bool) {
+	for _, algorithm := range dnssecAlgorithms {
+		if algorithm.number == number {
+			return algorithm, true
+		}
+	}
+
+	return dnssecAlgorithm{}, false
+}
+
+// lookupDSDigestType returns the registry entry of a DS digest type number.
+func lookupDSDigestType(number uint8) (dsDigestType, bool) {
+	for _, digestType := range dsDigestTypes {
+		if digestType.number == number {
+			return digestType, true
+		}
+	}
+
+	return dsDigestType{}, false
+}
+
+// parseRDATADNSSECAlgorithm parses an unquoted algorithm field, which is either a decimal algorithm number or a
+// case-insensitive algorithm mnemonic (RFC 4034 Appendix A.1), into an algorithm number from the IANA registry.
+func parseRDATADNSSECAlgorithm(token rdataToken) (uint8, error) {
+	if !token.quoted && !isDigits(token.text) {
+		for _, algorithm := range dnssecAlgorithms {
+			if strings.EqualFold(token.text, algorithm.mnemonic) {
+				return algorithm.number, nil
+			}
+		}
+
+		return 0, fmt.Errorf("algorithm %q must be a DNSSEC algorithm number or mnemonic", token.text)
+	}
+
+	number, err := parseRDATAUint(token, "algorithm", 8)
+	if err != nil {
+		return 0, err
+	}
+
+	if _, ok := lookupDNSSECAlgorithm(uint8(number)); !ok {
+		return 0, fmt.Errorf("algorithm %d must be an assigned DNSSEC algorithm number", number)
+	}
+
+	return uint8(number), nil
+}
+
+// validateDNSSECPublicKey validates the length and structure of a public key for the given algorithm, where the
+// formats of RSA (RFC 3110 Section 2) and DSA (RFC 2536 Section 2) keys are checked, and the keys of elliptic curve
+// algorithms must have the fixed length of their curve.
+func validateDNSSECPublicKey(algorithm uint8, publicKey []byte) error {
+	switch algorithm {
+	case dnssecAlgorithmRSAMD5, dnssecAlgorithmRSASHA1, dnssecAlgorithmRSASHA1NSEC3SHA1, dnssecAlgorithmRSASHA256, dnssecAlgorithmRSASHA512:
+		_, modulus, err := splitDNSSECRSAPublicKey(publicKey)
+		if err != nil {
+			return err
+		}
+
+		if len(modulus) == 0 || modulus[0] == 0 {
+			return errors.New("RSA public key modulus must not be empty or have leading zeros")
+		}
+	case dnssecAlgorithmDSA, dnssecAlgorithmDSANSEC3SHA1:
+		if len(publicKey) == 0 || publicKey[0] > 8 {
+			return errors.New("DSA public key T parameter must be from 0 to 8")
+		}
+
+		// The key is T, followed by Q of 20 octets and P, G and Y of 64 + T * 8 octets each
+		if length := 1 + 20 + 3*(64+int(publicKey[0])*8); len(publicKey) != length {
+			return fmt.Errorf("DSA public key with T parameter %d must be %d bytes, got %d", publicKey[0], length, len(publicKey))
+		}
+	default:
+		entry, _ := lookupDNSSECAlgorithm(algorithm)
+
+		if entry.publicKeyLength != 0 && len(publicKey) != entry.publicKeyLength {
+			return fmt.Errorf("%s public key must be %d bytes, got %d", entry.mnemonic, entry.publicKeyLength, len(publicKey))
+		}
+	}
+
+	return nil
+}
+
+// splitDNSSECRSAPublicKey splits an RSA public key into its exponent and modulus, where the exponent length is a
+// single octet, or three octets starting with zero for exponents longer than 255 octets (RFC 3110 Section 2).
+func splitDNSSECRSAPublicKey(publicKey []byte) ([]byte, []byte, error) {
+	if len(publicKey) < 1 {
+		return nil, nil, errors.New("RSA public key must not be empty")
+	}
+
+	exponentLength := int(publicKey[0])
+	rest := publicKey[1:]
+
+	if exponentLength == 0 {
+		if len(rest) < 2 {
+			return nil, nil, errors.New("RSA public key exponent length is truncated")
+		}
+
+		exponentLength = int(rest[0])<<8 | int(rest[1])
+		rest = rest[2:]
+	}
+
+	if exponentLength == 0 || len(rest) < exponentLength {
+		return nil, nil, fmt.Errorf("RSA public key exponent of %d bytes is truncated", exponentLength)
+	}
+
+	return rest[:exponentLength], rest[exponentLength:], nil
+}
+
+// dnssecKeyTag calculates the key tag of DNSKEY RDATA in wire format (RFC 4034 Appendix B).
+func dnssecKeyTag(algorithm uint8, rdata []byte, publicKey []byte) uint16 {
+	// Keys of algorithm 1 use the most significant 16 bits of the least significant 24 bits of the modulus
+	if algorithm == dnssecAlgorithmRSAMD5 {
+		if len(publicKey) < 3 {
+			return 0
+		}
+
+		return uint16(publicKey[len(publicKey)-3])<<8 | uint16(publicKey[len(publicKey)-2])
+	}
+
+	var ac uint32
+
+	for i, b := range rdata {
+		if i&1 == 1 {
+			ac += uint32(b)
+		} else {
+			ac += uint32(b) << 8
+		}
+	}
+
+	ac += ac >> 16 & 0xFFFF
+
+	return uint16(ac & 0xFFFF)
+}
+
+// dnssecOwnerName converts a domain name into the canonical wire format used in DS digests (RFC 4034 Section 6.2),
+// which is each lowercase label prefixed with its length, terminated by the zero length root label.
+func dnssecOwnerName(name string) ([]byte, error) {
+	if name == "." {
+		return []byte{0}, nil
+	}
+
+	labels, err := splitDomainName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	var wire []byte
+
+	for _, label := range labels {
+		if !isDNSLabel(label) {
+			return nil, fmt.Errorf("domain name %q: label %q must only contain letters, digits, hyphens and underscores", name, label)
+		}
+
+		wire = append(wire, byte(len(label)))
+		wire = append(wire, strings.ToLower(label)...)
+	}
+
+	return append(wire, 0), nil
+}
@@ -0,0 +1,136 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// maxDomainNameLength is the maximum length of a domain name in presentation format without the trailing dot,
+	// which is 255 octets in wire format (RFC 1035 Section 2.3.4).
+	maxDomainNameLength = 253
+
+	// maxLabelLength is the maximum length of a single label (RFC 1035 Section 2.3.4).
+	maxLabelLength = 63
+
+	// wildcardLabel is the leftmost label of a wildcard domain name (RFC 4592 and RFC 6125 Section 6.4.3).
+	wildcardLabel = "*"
+)
+
+// splitDomainName splits a domain name in presentation format into its labels, ignoring a single trailing dot, and
+// validates the name and label lengths.
+func splitDomainName(s string) ([]string, error) {
+	name := strings.TrimSuffix(s, ".")
+
+	if name == "" {
+		return nil, fmt.Errorf("domain name %q: must contain at least one label", s)
+	}
+
+	if len(name) > maxDomainNameLength {
+		return nil, fmt.Errorf("domain name %q: must not be longer than %d characters, got %d", s, maxDomainNameLength, len(name))
+	}
+
+	labels := strings.Split(name, ".")
+
+	for _, label := range labels {
+		if label == "" {
+			return nil, fmt.Errorf("domain name %q: must not contain empty labels", s)
+		}
+
+		if len(label) > maxLabelLength {
+			return nil, fmt.Errorf("domain name %q: label %q must not be longer than %d characters", s, label, maxLabelLength)
+		}
+	}
+
+	return labels, nil
+}
+
+// validateHostnameLabels validates that each label follows the letters, digits and hyphen rule for hostnames
+// (RFC 1123 Section 2.1) and that the top-level label is not all-numeric, so IPv4 addresses are not mistaken for
+// hostnames (RFC 3696 Section 2).
+func validateHostnameLabels(s string, labels []string) error {
+	for _, label := range labels {
+		if !isHostnameLabel(label) {
+			return fmt.Errorf("domain name %q: label %q must only contain letters, digits and hyphens, and must not start or end with a hyphen", s, label)
+		}
+	}
+
+	if isDigits(labels[len(labels)-1]) {
+		return fmt.Errorf("domain name %q: top-level label must not be all-numeric", s)
+	}
+
+	return nil
+}
+
+// isHostnameLabel returns true if label only contains ASCII letters, digits and hyphens, and does not start or end
+// with a hyphen.
+func isHostnameLabel(label string) bool {
+	if label == "" || label[0] == '-' || label[len(label)-1] == '-' {
+		return false
+	}
+
+	for i := 0; i < len(label); i++ {
+		c := label[i]
+
+		if (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') && (c < '0' || c > '9') && c != '-' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isDNSLabel returns true if label only contains ASCII letters, digits, hyphens and underscores, which covers
+// hostname labels and underscore prefixed labels such as _sip or _domainkey (RFC 8552).
+func isDNSLabel(label string) bool {
+	if label == "" {
+		return false
+	}
+
+	for i := 0; i < len(label); i++ {
+		c := label[i]
+
+		if (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') && (c < '0' || c > '9') && c != '-' && c != '_' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isDigits returns true if s is not empty and only contains ASCII digits.
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// normalizeDomainName returns a domain name in lowercase without a trailing dot, for case-insensitive comparison
+// (RFC 4343).
+func normalizeDomainName(s string) string {
+	return strings.ToLower(strings.TrimSuffix(s, "."))
+}
+
+// isAlphanumeric returns true if s only contains ASCII letters and digits.
+func isAlphanumeric(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+
+		if (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') && (c < '0' || c > '9') {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = (*DSRecordType)(nil)
+
+// DSRecordType is an attribute type that represents valid DS record RDATA in presentation format, which is a 16-bit key
+// tag, a DNSSEC algorithm and a digest type followed by a hexadecimal digest, such as
+// `60485 5 1 2BB183AF5F22588179A53B0A98631FAD1A292118`. Algorithm and digest type numbers must be assigned in the IANA
+// registries and the digest length must match the digest type. Semantic equality logic is defined for DSRecordType, so
+// that algorithm mnemonics, whitespace and letter case of the digest are ignored.
+//
+// All of the following are semantically equal:
+//   - 60485 5 1 2BB183AF5F22588179A53B0A98631FAD1A292118
+//   - 60485 RSASHA1 1 2bb183af5f22588179a53b0a98631fad1a292118
+//   - 60485 5 1 2BB183AF5F225881 79A53B0A98631FAD1A292118
+type DSRecordType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t DSRecordType) String() string {
+	return "dnstypes.DSRecordType"
+}
+
+// ValueType returns the Value type.
+func (t DSRecordType) ValueType(ctx context.Context) attr.Value {
+	return DSRecord{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t DSRecordType) Equal(o attr.Type) bool {
+	other, ok := o.(DSRecordType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t DSRecordType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return DSRecord{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t DSRecordType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
@@ -0,0 +1,254 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*DSRecord)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*DSRecord)(nil)
+	_ xattr.ValidateableAttribute                = (*DSRecord)(nil)
+	_ function.ValidateableParameter             = (*DSRecord)(nil)
+)
+
+// DSRecord represents valid DS record RDATA in presentation format, which is a 16-bit key tag, a DNSSEC algorithm
+// and a digest type followed by a hexadecimal digest, such as
+// `60485 5 1 2BB183AF5F22588179A53B0A98631FAD1A292118`. Algorithm and digest type numbers must be assigned in the
+// IANA registries and the digest length must match the digest type. Semantic equality logic is defined for DSRecord,
+// so that algorithm mnemonics, whitespace and letter case of the digest are ignored.
+//
+// All of the following are semantically equal:
+//   - 60485 5 1 2BB183AF5F22588179A53B0A98631FAD1A292118
+//   - 60485 RSASHA1 1 2bb183af5f22588179a53b0a98631fad1a292118
+//   - 60485 5 1 2BB183AF5F225881 79A53B0A98631FAD1A292118
+type DSRecord struct {
+	basetypes.StringValue
+}
+
+// Type returns a DSRecordType.
+func (v DSRecord) Type(_ context.Context) attr.Type {
+	return DSRecordType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v DSRecord) Equal(o attr.Value) bool {
+	other, ok := o.(DSRecord)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given DS record string value is semantically equal to the current DS
+// record string value. This comparison uses the canonical presentation format of both values, so that algorithm
+// mnemonics, whitespace and letter case of the digest are ignored.
+//
+// All of the following are semantically equal:
+//   - 60485 5 1 2BB183AF5F22588179A53B0A98631FAD1A292118
+//   - 60485 RSASHA1 1 2bb183af5f22588179a53b0a98631fad1a292118
+//   - 60485 5 1 2BB183AF5F225881 79A53B0A98631FAD1A292118
+func (v DSRecord) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(DSRecord)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// DS records are already validated at this point, ignoring errors
+	newDS, _ := parseDS(newValue.ValueString())
+	currentDS, _ := parseDS(v.ValueString())
+
+	return currentDS.String() == newDS.String(), diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String
+// value that is valid DS record RDATA, with an assigned algorithm and digest type and a digest of the correct
+// length.
+func (v DSRecord) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseDS(v.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid DS Record String Value",
+			"A string value was provided that is not valid DS record string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is valid DS record RDATA, with an assigned algorithm and digest type and a
+// digest of the correct length.
+func (v DSRecord) ValidateParameter(_ context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseDS(v.ValueString())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid DS Record String Value: "+
+				"A string value was provided that is not valid DS record string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueDS parses the DSRecord StringValue into its key tag, algorithm, digest type and decoded digest. A null or
+// unknown value will produce an error diagnostic.
+func (v DSRecord) ValueDS() (DS, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("DSRecord ValueDS Error", "DS record string value is null"))
+		return DS{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("DSRecord ValueDS Error", "DS record string value is unknown"))
+		return DS{}, diags
+	}
+
+	record, err := parseDS(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("DSRecord ValueDS Error", err.Error()))
+		return DS{}, diags
+	}
+
+	return record, nil
+}
+
+// DS is the parsed RDATA of a DS record (RFC 4034 Section 5.1).
+type DS struct {
+	// KeyTag is the key tag of the DNSKEY record that the DS record refers to.
+	KeyTag uint16
+
+	// Algorithm is the DNSSEC algorithm number of the DNSKEY record that the DS record refers to, such as 13 for
+	// ECDSAP256SHA256.
+	Algorithm uint8
+
+	// DigestType is the digest type number, such as 2 for SHA-256.
+	DigestType uint8
+
+	// Digest is the decoded digest of the DNSKEY record.
+	Digest []byte
+}
+
+// String returns the DS RDATA in canonical presentation format with a numeric algorithm and an uppercase hexadecimal
+// digest.
+func (r DS) String() string {
+	return fmt.Sprintf("%d %d %d %X", r.KeyTag, r.Algorithm, r.DigestType, r.Digest)
+}
+
+// parseDS parses DS RDATA in presentation format.
+func parseDS(s string) (DS, error) {
+	record, err := parseDSFields(s)
+	if err != nil {
+		return DS{}, fmt.Errorf("DS record %q: %w", s, err)
+	}
+
+	return record, nil
+}
+
+// parseDSFields parses the key tag, algorithm, digest type and digest fields of DS RDATA.
+func parseDSFields(s string) (DS, error) {
+	tokens, err := splitRDATA(s)
+	if err != nil {
+		return DS{}, err
+	}
+
+	if len(tokens) < 4 {
+		return DS{}, fmt.Errorf("must contain key tag, algorithm, digest type and digest fields, got %d fields", len(tokens))
+	}
+
+	keyTag, err := parseRDATAUint(tokens[0], "key tag", 16)
+	if err != nil {
+		return DS{}, err
+	}
+
+	algorithm, err := parseRDATADNSSECAlgorithm(tokens[1])
+	if err != nil {
+		return DS{}, err
+	}
+
+	number, err := parseRDATAUint(tokens[2], "digest type", 8)
+	if err != nil {
+		return DS{}, err
+	}
+
+	digestType, ok := lookupDSDigestType(uint8(number))
+	if !ok {
+		return DS{}, fmt.Errorf("digest type %d must be an assigned DS digest type number", number)
+	}
+
+	digest, err := parseRDATAHex(tokens[3:], "digest")
+	if err != nil {
+		return DS{}, err
+	}
+
+	if len(digest) != digestType.length {
+		return DS{}, fmt.Errorf("digest type %d (%s) must be %d bytes, got %d", digestType.number, digestType.name, digestType.length, len(digest))
+	}
+
+	return DS{KeyTag: uint16(keyTag), Algorithm: algorithm, DigestType: digestType.number, Digest: digest}, nil
+}
+
+// NewDSRecordNull creates a DSRecord with a null value. Determine whether the value is null via IsNull method.
+func NewDSRecordNull() DSRecord {
+	return DSRecord{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewDSRecordUnknown creates a DSRecord with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewDSRecordUnknown() DSRecord {
+	return DSRecord{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewDSRecordValue creates a DSRecord with a known value. Access the value via ValueString method.
+func NewDSRecordValue(value string) DSRecord {
+	return DSRecord{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewDSRecordPointerValue creates a DSRecord with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewDSRecordPointerValue(value *string) DSRecord {
+	return DSRecord{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
@@ -0,0 +1,287 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/dnstypes"
+)
+
+func TestDSRecordStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentDS     dnstypes.DSRecord
+		givenDS       basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"semantically equal - byte-for-byte match": {
+			currentDS:     dnstypes.NewDSRecordValue("60485 5 1 2BB183AF5F22588179A53B0A98631FAD1A292118"),
+			givenDS:       dnstypes.NewDSRecordValue("60485 5 1 2BB183AF5F22588179A53B0A98631FAD1A292118"),
+			expectedMatch: true,
+		},
+		"semantically equal - letter case": {
+			currentDS:     dnstypes.NewDSRecordValue("60485 5 1 2BB183AF5F22588179A53B0A98631FAD1A292118"),
+			givenDS:       dnstypes.NewDSRecordValue("60485 5 1 2bb183af5f22588179a53b0a98631fad1a292118"),
+			expectedMatch: true,
+		},
+		"semantically equal - split digest": {
+			currentDS:     dnstypes.NewDSRecordValue("60485 5 1 2BB183AF5F22588179A53B0A98631FAD1A292118"),
+			givenDS:       dnstypes.NewDSRecordValue("60485 5 1 2BB183AF5F225881 79A53B0A98631FAD1A292118"),
+			expectedMatch: true,
+		},
+		"semantically equal - algorithm mnemonic": {
+			currentDS:     dnstypes.NewDSRecordValue("60485 5 1 2BB183AF5F22588179A53B0A98631FAD1A292118"),
+			givenDS:       dnstypes.NewDSRecordValue("60485 rsasha1 1 2BB183AF5F22588179A53B0A98631FAD1A292118"),
+			expectedMatch: true,
+		},
+		"semantically equal - leading zeroes": {
+			currentDS:     dnstypes.NewDSRecordValue("60485 5 1 2BB183AF5F22588179A53B0A98631FAD1A292118"),
+			givenDS:       dnstypes.NewDSRecordValue("60485 05 001 2BB183AF5F22588179A53B0A98631FAD1A292118"),
+			expectedMatch: true,
+		},
+		"not equal - different key tag": {
+			currentDS:     dnstypes.NewDSRecordValue("60485 5 1 2BB183AF5F22588179A53B0A98631FAD1A292118"),
+			givenDS:       dnstypes.NewDSRecordValue("60486 5 1 2BB183AF5F22588179A53B0A98631FAD1A292118"),
+			expectedMatch: false,
+		},
+		"not equal - different digest type": {
+			currentDS:     dnstypes.NewDSRecordValue("60485 5 1 2BB183AF5F22588179A53B0A98631FAD1A292118"),
+			givenDS:       dnstypes.NewDSRecordValue("60485 5 2 D4B7D520E7BB5F0F67674A0CCEB1E3E0614B93C4F9E99B8383F6A1E4469DA50A"),
+			expectedMatch: false,
+		},
+		"error - not given DSRecord value": {
+			currentDS:     dnstypes.NewDSRecordValue("60485 5 1 2BB183AF5F22588179A53B0A98631FAD1A292118"),
+			givenDS:       basetypes.NewStringValue("60485 5 1 2BB183AF5F22588179A53B0A98631FAD1A292118"),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: dnstypes.DSRecord\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentDS.StringSemanticEquals(context.Background(), testCase.givenDS)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDSRecordValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		dsValue       dnstypes.DSRecord
+		expectedError string
+	}{
+		"empty-struct": {
+			dsValue: dnstypes.DSRecord{},
+		},
+		"null": {
+			dsValue: dnstypes.NewDSRecordNull(),
+		},
+		"unknown": {
+			dsValue: dnstypes.NewDSRecordUnknown(),
+		},
+		"valid - SHA-1": {
+			dsValue: dnstypes.NewDSRecordValue("60485 5 1 2BB183AF5F22588179A53B0A98631FAD1A292118"),
+		},
+		"valid - SHA-256": {
+			dsValue: dnstypes.NewDSRecordValue("60485 5 2 D4B7D520E7BB5F0F67674A0CCEB1E3E0614B93C4F9E99B8383F6A1E4469DA50A"),
+		},
+		"valid - SHA-384": {
+			dsValue: dnstypes.NewDSRecordValue("55648 13 4 3BE4B980B34443E569255F4A347D4C8E8E18DE755FB8072D7B355C44C56B50A61E8050AE636041B9664A04F05AEF2680"),
+		},
+		"valid - algorithm mnemonic": {
+			dsValue: dnstypes.NewDSRecordValue("2371 ECDSAP256SHA256 2 D4B7D520E7BB5F0F67674A0CCEB1E3E0614B93C4F9E99B8383F6A1E4469DA50A"),
+		},
+		"invalid - missing digest": {
+			dsValue:       dnstypes.NewDSRecordValue("60485 5 1"),
+			expectedError: "DS record \"60485 5 1\": must contain key tag, algorithm, digest type and digest fields, got 3 fields",
+		},
+		"invalid - key tag out of range": {
+			dsValue:       dnstypes.NewDSRecordValue("65536 5 1 2BB183AF5F22588179A53B0A98631FAD1A292118"),
+			expectedError: "DS record \"65536 5 1 2BB183AF5F22588179A53B0A98631FAD1A292118\": key tag \"65536\" must be an unsigned 16-bit decimal integer",
+		},
+		"invalid - unassigned algorithm": {
+			dsValue:       dnstypes.NewDSRecordValue("60485 4 1 2BB183AF5F22588179A53B0A98631FAD1A292118"),
+			expectedError: "DS record \"60485 4 1 2BB183AF5F22588179A53B0A98631FAD1A292118\": algorithm 4 must be an assigned DNSSEC algorithm number",
+		},
+		"invalid - unknown algorithm mnemonic": {
+			dsValue:       dnstypes.NewDSRecordValue("60485 RSASHA3 1 2BB183AF5F22588179A53B0A98631FAD1A292118"),
+			expectedError: "DS record \"60485 RSASHA3 1 2BB183AF5F22588179A53B0A98631FAD1A292118\": algorithm \"RSASHA3\" must be a DNSSEC algorithm number or mnemonic",
+		},
+		"invalid - unassigned digest type": {
+			dsValue:       dnstypes.NewDSRecordValue("60485 5 7 D4B7D520E7BB5F0F67674A0CCEB1E3E0614B93C4F9E99B8383F6A1E4469DA50A"),
+			expectedError: "DS record \"60485 5 7 D4B7D520E7BB5F0F67674A0CCEB1E3E0614B93C4F9E99B8383F6A1E4469DA50A\": digest type 7 must be an assigned DS digest type number",
+		},
+		"invalid - digest length": {
+			dsValue:       dnstypes.NewDSRecordValue("60485 5 2 2BB183AF5F22588179A53B0A98631FAD1A292118"),
+			expectedError: "DS record \"60485 5 2 2BB183AF5F22588179A53B0A98631FAD1A292118\": digest type 2 (SHA-256) must be 32 bytes, got 20",
+		},
+		"invalid - digest not hexadecimal": {
+			dsValue:       dnstypes.NewDSRecordValue("60485 5 1 2BB183AF5F22588179A53B0A98631FAD1A2921ZZ"),
+			expectedError: "DS record \"60485 5 1 2BB183AF5F22588179A53B0A98631FAD1A2921ZZ\": digest must be a non-empty even number of hexadecimal digits",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var expectedDiags diag.Diagnostics
+
+			if testCase.expectedError != "" {
+				expectedDiags.AddAttributeError(
+					path.Root("test"),
+					"Invalid DS Record String Value",
+					"A string value was provided that is not valid DS record string format.\n\n"+
+						"Given Value: "+testCase.dsValue.ValueString()+"\n"+
+						"Error: "+testCase.expectedError,
+				)
+			}
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.dsValue.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{Path: path.Root("test")},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDSRecordValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		dsValue         dnstypes.DSRecord
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			dsValue: dnstypes.DSRecord{},
+		},
+		"null": {
+			dsValue: dnstypes.NewDSRecordNull(),
+		},
+		"unknown": {
+			dsValue: dnstypes.NewDSRecordUnknown(),
+		},
+		"valid": {
+			dsValue: dnstypes.NewDSRecordValue("60485 5 1 2BB183AF5F22588179A53B0A98631FAD1A292118"),
+		},
+		"invalid": {
+			dsValue: dnstypes.NewDSRecordValue("60485 5 1"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid DS Record String Value: "+
+					"A string value was provided that is not valid DS record string format.\n\n"+
+					"Given Value: "+"60485 5 1"+"\n"+
+					"Error: "+"DS record \"60485 5 1\": must contain key tag, algorithm, digest type and digest fields, got 3 fields",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.dsValue.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDSRecordValueDS(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		dsValue       dnstypes.DSRecord
+		expectedDS    dnstypes.DS
+		expectedDiags diag.Diagnostics
+	}{
+		"DS record value is null": {
+			dsValue: dnstypes.NewDSRecordNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"DSRecord ValueDS Error",
+					"DS record string value is null",
+				),
+			},
+		},
+		"DS record value is unknown": {
+			dsValue: dnstypes.NewDSRecordUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"DSRecord ValueDS Error",
+					"DS record string value is unknown",
+				),
+			},
+		},
+		"valid": {
+			dsValue: dnstypes.NewDSRecordValue("60485 RSASHA1 1 2bb183af5f22588179a53b0a98631fad1a292118"),
+			expectedDS: dnstypes.DS{KeyTag: 60485, Algorithm: 5, DigestType: 1, Digest: []byte{
+				0x2b, 0xb1, 0x83, 0xaf, 0x5f, 0x22, 0x58, 0x81,
+				0x79, 0xa5, 0x3b, 0x0a, 0x98, 0x63, 0x1f, 0xad,
+				0x1a, 0x29, 0x21, 0x18,
+			}},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			record, diags := testCase.dsValue.ValueDS()
+
+			if diff := cmp.Diff(record, testCase.expectedDS); diff != "" {
+				t.Errorf("Unexpected difference in DS record (-got, +expected): %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = (*MXRecordType)(nil)
+
+// MXRecordType is an attribute type that represents valid MX record RDATA in presentation format, which is a 16-bit
+// preference followed by a mail exchange hostname, such as `10 mail.example.com.`. Semantic equality logic is defined
+// for MXRecordType, so that whitespace, letter case and a trailing dot on the exchange are ignored.
+//
+// All of the following are semantically equal:
+//   - 10 mail.example.com.
+//   - 10 mail.example.com
+//   - 10  Mail.Example.COM.
+type MXRecordType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t MXRecordType) String() string {
+	return "dnstypes.MXRecordType"
+}
+
+// ValueType returns the Value type.
+func (t MXRecordType) ValueType(ctx context.Context) attr.Value {
+	return MXRecord{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t MXRecordType) Equal(o attr.Type) bool {
+	other, ok := o.(MXRecordType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t MXRecordType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return MXRecord{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t MXRecordType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
@@ -0,0 +1,229 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*MXRecord)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*MXRecord)(nil)
+	_ xattr.ValidateableAttribute                = (*MXRecord)(nil)
+	_ function.ValidateableParameter             = (*MXRecord)(nil)
+)
+
+// MXRecord represents valid MX record RDATA in presentation format, which is a 16-bit preference followed by a
+// mail exchange hostname, such as `10 mail.example.com.`. A null MX of `0 .` is also valid (RFC 7505). Semantic
+// equality logic is defined for MXRecord, so that whitespace, letter case and a trailing dot on the exchange are
+// ignored.
+//
+// All of the following are semantically equal:
+//   - 10 mail.example.com.
+//   - 10 mail.example.com
+//   - 10  Mail.Example.COM.
+type MXRecord struct {
+	basetypes.StringValue
+}
+
+// Type returns an MXRecordType.
+func (v MXRecord) Type(_ context.Context) attr.Type {
+	return MXRecordType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v MXRecord) Equal(o attr.Value) bool {
+	other, ok := o.(MXRecord)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given MX record string value is semantically equal to the current MX
+// record string value. This comparison uses the canonical presentation format of both values, so that whitespace,
+// letter case and a trailing dot on the exchange are ignored.
+//
+// All of the following are semantically equal:
+//   - 10 mail.example.com.
+//   - 10 mail.example.com
+//   - 10  Mail.Example.COM.
+func (v MXRecord) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(MXRecord)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// MX records are already validated at this point, ignoring errors
+	newMX, _ := parseMX(newValue.ValueString())
+	currentMX, _ := parseMX(v.ValueString())
+
+	return currentMX.String() == newMX.String(), diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String
+// value that is valid MX record RDATA, with a 16-bit preference and a valid exchange hostname.
+func (v MXRecord) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseMX(v.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid MX Record String Value",
+			"A string value was provided that is not valid MX record string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is valid MX record RDATA, with a 16-bit preference and a valid exchange
+// hostname.
+func (v MXRecord) ValidateParameter(_ context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseMX(v.ValueString())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid MX Record String Value: "+
+				"A string value was provided that is not valid MX record string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueMX parses the MXRecord StringValue into its preference and exchange, with the exchange in lowercase with a
+// trailing dot. A null or unknown value will produce an error diagnostic.
+func (v MXRecord) ValueMX() (MX, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("MXRecord ValueMX Error", "MX record string value is null"))
+		return MX{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("MXRecord ValueMX Error", "MX record string value is unknown"))
+		return MX{}, diags
+	}
+
+	record, err := parseMX(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("MXRecord ValueMX Error", err.Error()))
+		return MX{}, diags
+	}
+
+	return record, nil
+}
+
+// MX is the parsed RDATA of an MX record (RFC 1035 Section 3.3.9).
+type MX struct {
+	// Preference is the preference of the mail exchange, where lower values are preferred.
+	Preference uint16
+
+	// Exchange is the mail exchange hostname in lowercase with a trailing dot, or "." for a null MX (RFC 7505).
+	Exchange string
+}
+
+// String returns the MX RDATA in canonical presentation format, such as `10 mail.example.com.`.
+func (r MX) String() string {
+	return fmt.Sprintf("%d %s", r.Preference, r.Exchange)
+}
+
+// parseMX parses MX RDATA in presentation format.
+func parseMX(s string) (MX, error) {
+	record, err := parseMXFields(s)
+	if err != nil {
+		return MX{}, fmt.Errorf("MX record %q: %w", s, err)
+	}
+
+	return record, nil
+}
+
+// parseMXFields parses the preference and exchange fields of MX RDATA.
+func parseMXFields(s string) (MX, error) {
+	tokens, err := splitRDATA(s)
+	if err != nil {
+		return MX{}, err
+	}
+
+	if len(tokens) != 2 {
+		return MX{}, fmt.Errorf("must contain preference and exchange fields, got %d fields", len(tokens))
+	}
+
+	preference, err := parseRDATAUint(tokens[0], "preference", 16)
+	if err != nil {
+		return MX{}, err
+	}
+
+	exchange, err := parseRDATADomainName(tokens[1], "exchange", true)
+	if err != nil {
+		return MX{}, err
+	}
+
+	if exchange == "." && preference != 0 {
+		return MX{}, fmt.Errorf("null MX exchange \".\" must have preference 0, got %d", preference)
+	}
+
+	return MX{Preference: uint16(preference), Exchange: exchange}, nil
+}
+
+// NewMXRecordNull creates an MXRecord with a null value. Determine whether the value is null via IsNull method.
+func NewMXRecordNull() MXRecord {
+	return MXRecord{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewMXRecordUnknown creates an MXRecord with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewMXRecordUnknown() MXRecord {
+	return MXRecord{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewMXRecordValue creates an MXRecord with a known value. Access the value via ValueString method.
+func NewMXRecordValue(value string) MXRecord {
+	return MXRecord{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewMXRecordPointerValue creates an MXRecord with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewMXRecordPointerValue(value *string) MXRecord {
+	return MXRecord{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/dnstypes"
+)
+
+type MXRecordSetResourceModel struct {
+	Records []dnstypes.MXRecord `tfsdk:"records"`
+}
+
+func ExampleMXRecord_ValueMX() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := MXRecordSetResourceModel{
+		Records: []dnstypes.MXRecord{
+			dnstypes.NewMXRecordValue("10 mail.example.com"),
+			dnstypes.NewMXRecordValue("20 Backup.Example.com."),
+		},
+	}
+
+	// Parse each MXRecord into its preference and exchange
+	for _, record := range data.Records {
+		mx, diags := record.ValueMX()
+		if diags.HasError() {
+			return
+		}
+
+		fmt.Println(mx.Preference, mx.Exchange)
+	}
+
+	// Output:
+	// 10 mail.example.com.
+	// 20 backup.example.com.
+}
@@ -0,0 +1,283 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/dnstypes"
+)
+
+func TestMXRecordStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentMX     dnstypes.MXRecord
+		givenMX       basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"semantically equal - byte-for-byte match": {
+			currentMX:     dnstypes.NewMXRecordValue("10 mail.example.com."),
+			givenMX:       dnstypes.NewMXRecordValue("10 mail.example.com."),
+			expectedMatch: true,
+		},
+		"semantically equal - missing trailing dot": {
+			currentMX:     dnstypes.NewMXRecordValue("10 mail.example.com."),
+			givenMX:       dnstypes.NewMXRecordValue("10 mail.example.com"),
+			expectedMatch: true,
+		},
+		"semantically equal - letter case and whitespace": {
+			currentMX:     dnstypes.NewMXRecordValue("10 mail.example.com."),
+			givenMX:       dnstypes.NewMXRecordValue("  10\tMail.Example.COM. "),
+			expectedMatch: true,
+		},
+		"semantically equal - leading zeroes": {
+			currentMX:     dnstypes.NewMXRecordValue("10 mail.example.com."),
+			givenMX:       dnstypes.NewMXRecordValue("010 mail.example.com."),
+			expectedMatch: true,
+		},
+		"not equal - different preference": {
+			currentMX:     dnstypes.NewMXRecordValue("10 mail.example.com."),
+			givenMX:       dnstypes.NewMXRecordValue("20 mail.example.com."),
+			expectedMatch: false,
+		},
+		"not equal - different exchange": {
+			currentMX:     dnstypes.NewMXRecordValue("10 mail.example.com."),
+			givenMX:       dnstypes.NewMXRecordValue("10 mx.example.com."),
+			expectedMatch: false,
+		},
+		"error - not given MXRecord value": {
+			currentMX:     dnstypes.NewMXRecordValue("10 mail.example.com."),
+			givenMX:       basetypes.NewStringValue("10 mail.example.com."),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: dnstypes.MXRecord\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentMX.StringSemanticEquals(context.Background(), testCase.givenMX)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestMXRecordValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		mxValue       dnstypes.MXRecord
+		expectedError string
+	}{
+		"empty-struct": {
+			mxValue: dnstypes.MXRecord{},
+		},
+		"null": {
+			mxValue: dnstypes.NewMXRecordNull(),
+		},
+		"unknown": {
+			mxValue: dnstypes.NewMXRecordUnknown(),
+		},
+		"valid": {
+			mxValue: dnstypes.NewMXRecordValue("10 mail.example.com."),
+		},
+		"valid - null MX": {
+			mxValue: dnstypes.NewMXRecordValue("0 ."),
+		},
+		"valid - maximum preference": {
+			mxValue: dnstypes.NewMXRecordValue("65535 mail.example.com"),
+		},
+		"invalid - empty": {
+			mxValue:       dnstypes.NewMXRecordValue(""),
+			expectedError: "MX record \"\": must contain preference and exchange fields, got 0 fields",
+		},
+		"invalid - missing exchange": {
+			mxValue:       dnstypes.NewMXRecordValue("10"),
+			expectedError: "MX record \"10\": must contain preference and exchange fields, got 1 fields",
+		},
+		"invalid - preference out of range": {
+			mxValue:       dnstypes.NewMXRecordValue("65536 mail.example.com."),
+			expectedError: "MX record \"65536 mail.example.com.\": preference \"65536\" must be an unsigned 16-bit decimal integer",
+		},
+		"invalid - negative preference": {
+			mxValue:       dnstypes.NewMXRecordValue("-1 mail.example.com."),
+			expectedError: "MX record \"-1 mail.example.com.\": preference \"-1\" must be an unsigned 16-bit decimal integer",
+		},
+		"invalid - exchange with underscore": {
+			mxValue:       dnstypes.NewMXRecordValue("10 mail_1.example.com."),
+			expectedError: "MX record \"10 mail_1.example.com.\": exchange: domain name \"mail_1.example.com.\": label \"mail_1\" must only contain letters, digits and hyphens, and must not start or end with a hyphen",
+		},
+		"invalid - exchange is an IP address": {
+			mxValue:       dnstypes.NewMXRecordValue("10 192.0.2.1"),
+			expectedError: "MX record \"10 192.0.2.1\": exchange: domain name \"192.0.2.1\": top-level label must not be all-numeric",
+		},
+		"invalid - quoted exchange": {
+			mxValue:       dnstypes.NewMXRecordValue("10 \"mail.example.com.\""),
+			expectedError: "MX record \"10 \\\"mail.example.com.\\\"\": exchange \"mail.example.com.\" must not be quoted",
+		},
+		"invalid - null MX with non-zero preference": {
+			mxValue:       dnstypes.NewMXRecordValue("10 ."),
+			expectedError: "MX record \"10 .\": null MX exchange \".\" must have preference 0, got 10",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var expectedDiags diag.Diagnostics
+
+			if testCase.expectedError != "" {
+				expectedDiags.AddAttributeError(
+					path.Root("test"),
+					"Invalid MX Record String Value",
+					"A string value was provided that is not valid MX record string format.\n\n"+
+						"Given Value: "+testCase.mxValue.ValueString()+"\n"+
+						"Error: "+testCase.expectedError,
+				)
+			}
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.mxValue.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{Path: path.Root("test")},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestMXRecordValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		mxValue         dnstypes.MXRecord
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			mxValue: dnstypes.MXRecord{},
+		},
+		"null": {
+			mxValue: dnstypes.NewMXRecordNull(),
+		},
+		"unknown": {
+			mxValue: dnstypes.NewMXRecordUnknown(),
+		},
+		"valid": {
+			mxValue: dnstypes.NewMXRecordValue("10 mail.example.com."),
+		},
+		"invalid": {
+			mxValue: dnstypes.NewMXRecordValue("10"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid MX Record String Value: "+
+					"A string value was provided that is not valid MX record string format.\n\n"+
+					"Given Value: "+"10"+"\n"+
+					"Error: "+"MX record \"10\": must contain preference and exchange fields, got 1 fields",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.mxValue.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestMXRecordValueMX(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		mxValue       dnstypes.MXRecord
+		expectedMX    dnstypes.MX
+		expectedDiags diag.Diagnostics
+	}{
+		"MX record value is null": {
+			mxValue: dnstypes.NewMXRecordNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"MXRecord ValueMX Error",
+					"MX record string value is null",
+				),
+			},
+		},
+		"MX record value is unknown": {
+			mxValue: dnstypes.NewMXRecordUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"MXRecord ValueMX Error",
+					"MX record string value is unknown",
+				),
+			},
+		},
+		"valid": {
+			mxValue:    dnstypes.NewMXRecordValue("20 Mail.Example.com"),
+			expectedMX: dnstypes.MX{Preference: 20, Exchange: "mail.example.com."},
+		},
+		"null MX": {
+			mxValue:    dnstypes.NewMXRecordValue("0 ."),
+			expectedMX: dnstypes.MX{Preference: 0, Exchange: "."},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			record, diags := testCase.mxValue.ValueMX()
+
+			if diff := cmp.Diff(record, testCase.expectedMX); diff != "" {
+				t.Errorf("Unexpected difference in MX record (-got, +expected): %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = (*NAPTRRecordType)(nil)
+
+// NAPTRRecordType is an attribute type that represents valid NAPTR record RDATA in presentation format, which is a
+// 16-bit order and preference, the flags, services and regexp character strings and a replacement domain name, such as
+// `100 10 "U" "E2U+sip" "!^.*$!sip:info@example.com!" .`. Semantic equality logic is defined for NAPTRRecordType, so
+// that whitespace, quoting, letter case of the flags and the replacement, and a trailing dot on the replacement are
+// ignored.
+//
+// All of the following are semantically equal:
+//   - 100 10 "U" "E2U+sip" "!^.*$!sip:info@example.com!" .
+//   - 100 10 u E2U+sip !^.*$!sip:info@example.com! .
+type NAPTRRecordType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t NAPTRRecordType) String() string {
+	return "dnstypes.NAPTRRecordType"
+}
+
+// ValueType returns the Value type.
+func (t NAPTRRecordType) ValueType(ctx context.Context) attr.Value {
+	return NAPTRRecord{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t NAPTRRecordType) Equal(o attr.Type) bool {
+	other, ok := o.(NAPTRRecordType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t NAPTRRecordType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return NAPTRRecord{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t NAPTRRecordType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
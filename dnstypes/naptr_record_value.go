@@ -0,0 +1,282 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*NAPTRRecord)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*NAPTRRecord)(nil)
+	_ xattr.ValidateableAttribute                = (*NAPTRRecord)(nil)
+	_ function.ValidateableParameter             = (*NAPTRRecord)(nil)
+)
+
+// NAPTRRecord represents valid NAPTR record RDATA in presentation format, which is a 16-bit order and preference,
+// the flags, services and regexp character strings and a replacement domain name, such as
+// `100 10 "U" "E2U+sip" "!^.*$!sip:info@example.com!" .`. Semantic equality logic is defined for NAPTRRecord, so
+// that whitespace, quoting, letter case of the flags and the replacement, and a trailing dot on the replacement are
+// ignored.
+//
+// All of the following are semantically equal:
+//   - 100 10 "U" "E2U+sip" "!^.*$!sip:info@example.com!" .
+//   - 100 10 u E2U+sip !^.*$!sip:info@example.com! .
+type NAPTRRecord struct {
+	basetypes.StringValue
+}
+
+// Type returns a NAPTRRecordType.
+func (v NAPTRRecord) Type(_ context.Context) attr.Type {
+	return NAPTRRecordType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v NAPTRRecord) Equal(o attr.Value) bool {
+	other, ok := o.(NAPTRRecord)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given NAPTR record string value is semantically equal to the current
+// NAPTR record string value. This comparison uses the canonical presentation format of both values, so that
+// whitespace, quoting, letter case of the flags and the replacement, and a trailing dot on the replacement are
+// ignored.
+//
+// All of the following are semantically equal:
+//   - 100 10 "U" "E2U+sip" "!^.*$!sip:info@example.com!" .
+//   - 100 10 u E2U+sip !^.*$!sip:info@example.com! .
+func (v NAPTRRecord) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(NAPTRRecord)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// NAPTR records are already validated at this point, ignoring errors
+	newNAPTR, _ := parseNAPTR(newValue.ValueString())
+	currentNAPTR, _ := parseNAPTR(v.ValueString())
+
+	return currentNAPTR.String() == newNAPTR.String(), diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String
+// value that is valid NAPTR record RDATA, with a 16-bit order and preference, alphanumeric flags and either a regexp
+// or a replacement domain name.
+func (v NAPTRRecord) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseNAPTR(v.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid NAPTR Record String Value",
+			"A string value was provided that is not valid NAPTR record string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is valid NAPTR record RDATA, with a 16-bit order and preference, alphanumeric
+// flags and either a regexp or a replacement domain name.
+func (v NAPTRRecord) ValidateParameter(_ context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseNAPTR(v.ValueString())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid NAPTR Record String Value: "+
+				"A string value was provided that is not valid NAPTR record string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueNAPTR parses the NAPTRRecord StringValue into its fields, with uppercase flags and the replacement in
+// lowercase with a trailing dot. A null or unknown value will produce an error diagnostic.
+func (v NAPTRRecord) ValueNAPTR() (NAPTR, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("NAPTRRecord ValueNAPTR Error", "NAPTR record string value is null"))
+		return NAPTR{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("NAPTRRecord ValueNAPTR Error", "NAPTR record string value is unknown"))
+		return NAPTR{}, diags
+	}
+
+	record, err := parseNAPTR(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("NAPTRRecord ValueNAPTR Error", err.Error()))
+		return NAPTR{}, diags
+	}
+
+	return record, nil
+}
+
+// NAPTR is the parsed RDATA of a NAPTR record (RFC 3403 Section 4.1).
+type NAPTR struct {
+	// Order is the order in which records must be processed, where lower values are processed first.
+	Order uint16
+
+	// Preference is the preference of records with the same order, where lower values are preferred.
+	Preference uint16
+
+	// Flags are the uppercase flags that control rewriting and interpretation, such as S, A, U or P.
+	Flags string
+
+	// Services are the service parameters, such as E2U+sip.
+	Services string
+
+	// Regexp is the substitution expression applied to the original string, which is empty if Replacement is used.
+	Regexp string
+
+	// Replacement is the replacement domain name in lowercase with a trailing dot, or "." if Regexp is used.
+	Replacement string
+}
+
+// String returns the NAPTR RDATA in canonical presentation format, such as
+// `100 10 "U" "E2U+sip" "!^.*$!sip:info@example.com!" .`.
+func (r NAPTR) String() string {
+	return fmt.Sprintf("%d %d %s %s %s %s",
+		r.Order,
+		r.Preference,
+		formatCharacterString(r.Flags),
+		formatCharacterString(r.Services),
+		formatCharacterString(r.Regexp),
+		r.Replacement,
+	)
+}
+
+// parseNAPTR parses NAPTR RDATA in presentation format.
+func parseNAPTR(s string) (NAPTR, error) {
+	record, err := parseNAPTRFields(s)
+	if err != nil {
+		return NAPTR{}, fmt.Errorf("NAPTR record %q: %w", s, err)
+	}
+
+	return record, nil
+}
+
+// parseNAPTRFields parses the order, preference, flags, services, regexp and replacement fields of NAPTR RDATA.
+func parseNAPTRFields(s string) (NAPTR, error) {
+	tokens, err := splitRDATA(s)
+	if err != nil {
+		return NAPTR{}, err
+	}
+
+	if len(tokens) != 6 {
+		return NAPTR{}, fmt.Errorf("must contain order, preference, flags, services, regexp and replacement fields, got %d fields", len(tokens))
+	}
+
+	order, err := parseRDATAUint(tokens[0], "order", 16)
+	if err != nil {
+		return NAPTR{}, err
+	}
+
+	preference, err := parseRDATAUint(tokens[1], "preference", 16)
+	if err != nil {
+		return NAPTR{}, err
+	}
+
+	var characterStrings [3]string
+
+	for i, field := range []string{"flags", "services", "regexp"} {
+		characterString, err := parseCharacterString(tokens[2+i], maxCharacterStringLength)
+		if err != nil {
+			return NAPTR{}, fmt.Errorf("%s: %w", field, err)
+		}
+
+		characterStrings[i] = characterString
+	}
+
+	// Flags are single characters from A-Z and 0-9, where case is not significant (RFC 3403 Section 4.1)
+	flags := characterStrings[0]
+
+	if flags != "" && !isAlphanumeric(flags) {
+		return NAPTR{}, fmt.Errorf("flags %q must only contain letters and digits", flags)
+	}
+
+	replacement, err := parseRDATADomainName(tokens[5], "replacement", false)
+	if err != nil {
+		return NAPTR{}, err
+	}
+
+	if characterStrings[2] != "" && replacement != "." {
+		return NAPTR{}, errors.New("regexp and replacement are mutually exclusive, regexp must be empty or replacement must be \".\"")
+	}
+
+	return NAPTR{
+		Order:       uint16(order),
+		Preference:  uint16(preference),
+		Flags:       strings.ToUpper(flags),
+		Services:    characterStrings[1],
+		Regexp:      characterStrings[2],
+		Replacement: replacement,
+	}, nil
+}
+
+// NewNAPTRRecordNull creates a NAPTRRecord with a null value. Determine whether the value is null via IsNull method.
+func NewNAPTRRecordNull() NAPTRRecord {
+	return NAPTRRecord{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewNAPTRRecordUnknown creates a NAPTRRecord with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewNAPTRRecordUnknown() NAPTRRecord {
+	return NAPTRRecord{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewNAPTRRecordValue creates a NAPTRRecord with a known value. Access the value via ValueString method.
+func NewNAPTRRecordValue(value string) NAPTRRecord {
+	return NAPTRRecord{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewNAPTRRecordPointerValue creates a NAPTRRecord with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewNAPTRRecordPointerValue(value *string) NAPTRRecord {
+	return NAPTRRecord{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
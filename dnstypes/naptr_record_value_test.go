@@ -0,0 +1,266 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/dnstypes"
+)
+
+func TestNAPTRRecordStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentNAPTR  dnstypes.NAPTRRecord
+		givenNAPTR    basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"semantically equal - byte-for-byte match": {
+			currentNAPTR:  dnstypes.NewNAPTRRecordValue("100 10 \"U\" \"E2U+sip\" \"!^.*$!sip:info@example.com!\" ."),
+			givenNAPTR:    dnstypes.NewNAPTRRecordValue("100 10 \"U\" \"E2U+sip\" \"!^.*$!sip:info@example.com!\" ."),
+			expectedMatch: true,
+		},
+		"semantically equal - unquoted fields and flags letter case": {
+			currentNAPTR:  dnstypes.NewNAPTRRecordValue("100 10 \"U\" \"E2U+sip\" \"!^.*$!sip:info@example.com!\" ."),
+			givenNAPTR:    dnstypes.NewNAPTRRecordValue("100 10 u E2U+sip !^.*$!sip:info@example.com! ."),
+			expectedMatch: true,
+		},
+		"semantically equal - replacement letter case and trailing dot": {
+			currentNAPTR:  dnstypes.NewNAPTRRecordValue("100 10 \"S\" \"SIP+D2U\" \"\" _sip._udp.example.com."),
+			givenNAPTR:    dnstypes.NewNAPTRRecordValue("100 10 \"s\" \"SIP+D2U\" \"\" _SIP._udp.Example.com"),
+			expectedMatch: true,
+		},
+		"not equal - different services letter case": {
+			currentNAPTR:  dnstypes.NewNAPTRRecordValue("100 10 \"S\" \"SIP+D2U\" \"\" _sip._udp.example.com."),
+			givenNAPTR:    dnstypes.NewNAPTRRecordValue("100 10 \"S\" \"sip+d2u\" \"\" _sip._udp.example.com."),
+			expectedMatch: false,
+		},
+		"not equal - different order": {
+			currentNAPTR:  dnstypes.NewNAPTRRecordValue("100 10 \"S\" \"SIP+D2U\" \"\" _sip._udp.example.com."),
+			givenNAPTR:    dnstypes.NewNAPTRRecordValue("200 10 \"S\" \"SIP+D2U\" \"\" _sip._udp.example.com."),
+			expectedMatch: false,
+		},
+		"error - not given NAPTRRecord value": {
+			currentNAPTR:  dnstypes.NewNAPTRRecordValue("100 10 \"U\" \"E2U+sip\" \"!^.*$!sip:info@example.com!\" ."),
+			givenNAPTR:    basetypes.NewStringValue("100 10 \"U\" \"E2U+sip\" \"!^.*$!sip:info@example.com!\" ."),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: dnstypes.NAPTRRecord\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentNAPTR.StringSemanticEquals(context.Background(), testCase.givenNAPTR)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNAPTRRecordValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		naptrValue    dnstypes.NAPTRRecord
+		expectedError string
+	}{
+		"empty-struct": {
+			naptrValue: dnstypes.NAPTRRecord{},
+		},
+		"null": {
+			naptrValue: dnstypes.NewNAPTRRecordNull(),
+		},
+		"unknown": {
+			naptrValue: dnstypes.NewNAPTRRecordUnknown(),
+		},
+		"valid - regexp": {
+			naptrValue: dnstypes.NewNAPTRRecordValue("100 10 \"U\" \"E2U+sip\" \"!^.*$!sip:info@example.com!\" ."),
+		},
+		"valid - replacement": {
+			naptrValue: dnstypes.NewNAPTRRecordValue("100 10 \"S\" \"SIP+D2U\" \"\" _sip._udp.example.com."),
+		},
+		"valid - empty flags": {
+			naptrValue: dnstypes.NewNAPTRRecordValue("100 10 \"\" \"\" \"\" example.com."),
+		},
+		"invalid - missing replacement": {
+			naptrValue:    dnstypes.NewNAPTRRecordValue("100 10 \"U\" \"E2U+sip\" \"!^.*$!sip:info@example.com!\""),
+			expectedError: "NAPTR record \"100 10 \\\"U\\\" \\\"E2U+sip\\\" \\\"!^.*$!sip:info@example.com!\\\"\": must contain order, preference, flags, services, regexp and replacement fields, got 5 fields",
+		},
+		"invalid - regexp and replacement": {
+			naptrValue:    dnstypes.NewNAPTRRecordValue("100 10 \"U\" \"E2U+sip\" \"!^.*$!sip:info@example.com!\" example.com."),
+			expectedError: "NAPTR record \"100 10 \\\"U\\\" \\\"E2U+sip\\\" \\\"!^.*$!sip:info@example.com!\\\" example.com.\": regexp and replacement are mutually exclusive, regexp must be empty or replacement must be \".\"",
+		},
+		"invalid - flags with symbols": {
+			naptrValue:    dnstypes.NewNAPTRRecordValue("100 10 \"U+\" \"E2U+sip\" \"!^.*$!sip:info@example.com!\" ."),
+			expectedError: "NAPTR record \"100 10 \\\"U+\\\" \\\"E2U+sip\\\" \\\"!^.*$!sip:info@example.com!\\\" .\": flags \"U+\" must only contain letters and digits",
+		},
+		"invalid - order out of range": {
+			naptrValue:    dnstypes.NewNAPTRRecordValue("65536 10 \"U\" \"E2U+sip\" \"!^.*$!sip:info@example.com!\" ."),
+			expectedError: "NAPTR record \"65536 10 \\\"U\\\" \\\"E2U+sip\\\" \\\"!^.*$!sip:info@example.com!\\\" .\": order \"65536\" must be an unsigned 16-bit decimal integer",
+		},
+		"invalid - services too long": {
+			naptrValue:    dnstypes.NewNAPTRRecordValue("100 10 \"U\" \"aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa\" \"\" ."),
+			expectedError: "NAPTR record \"100 10 \\\"U\\\" \\\"aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa\\\" \\\"\\\" .\": services: character string must not be longer than 255 octets, got 256",
+		},
+		"invalid - replacement with wildcard": {
+			naptrValue:    dnstypes.NewNAPTRRecordValue("100 10 \"S\" \"SIP+D2U\" \"\" *.example.com."),
+			expectedError: "NAPTR record \"100 10 \\\"S\\\" \\\"SIP+D2U\\\" \\\"\\\" *.example.com.\": replacement: domain name \"*.example.com.\": label \"*\" must only contain letters, digits, hyphens and underscores",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var expectedDiags diag.Diagnostics
+
+			if testCase.expectedError != "" {
+				expectedDiags.AddAttributeError(
+					path.Root("test"),
+					"Invalid NAPTR Record String Value",
+					"A string value was provided that is not valid NAPTR record string format.\n\n"+
+						"Given Value: "+testCase.naptrValue.ValueString()+"\n"+
+						"Error: "+testCase.expectedError,
+				)
+			}
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.naptrValue.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{Path: path.Root("test")},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNAPTRRecordValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		naptrValue      dnstypes.NAPTRRecord
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			naptrValue: dnstypes.NAPTRRecord{},
+		},
+		"null": {
+			naptrValue: dnstypes.NewNAPTRRecordNull(),
+		},
+		"unknown": {
+			naptrValue: dnstypes.NewNAPTRRecordUnknown(),
+		},
+		"valid": {
+			naptrValue: dnstypes.NewNAPTRRecordValue("100 10 \"U\" \"E2U+sip\" \"!^.*$!sip:info@example.com!\" ."),
+		},
+		"invalid": {
+			naptrValue: dnstypes.NewNAPTRRecordValue("100 10 \"U\" \"E2U+sip\" \"!^.*$!sip:info@example.com!\" example.com."),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid NAPTR Record String Value: "+
+					"A string value was provided that is not valid NAPTR record string format.\n\n"+
+					"Given Value: "+"100 10 \"U\" \"E2U+sip\" \"!^.*$!sip:info@example.com!\" example.com."+"\n"+
+					"Error: "+"NAPTR record \"100 10 \\\"U\\\" \\\"E2U+sip\\\" \\\"!^.*$!sip:info@example.com!\\\" example.com.\": regexp and replacement are mutually exclusive, regexp must be empty or replacement must be \".\"",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.naptrValue.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNAPTRRecordValueNAPTR(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		naptrValue    dnstypes.NAPTRRecord
+		expectedNAPTR dnstypes.NAPTR
+		expectedDiags diag.Diagnostics
+	}{
+		"NAPTR record value is null": {
+			naptrValue: dnstypes.NewNAPTRRecordNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"NAPTRRecord ValueNAPTR Error",
+					"NAPTR record string value is null",
+				),
+			},
+		},
+		"NAPTR record value is unknown": {
+			naptrValue: dnstypes.NewNAPTRRecordUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"NAPTRRecord ValueNAPTR Error",
+					"NAPTR record string value is unknown",
+				),
+			},
+		},
+		"valid": {
+			naptrValue:    dnstypes.NewNAPTRRecordValue("100 10 u \"E2U+sip\" \"!^.*$!sip:info@example.com!\" ."),
+			expectedNAPTR: dnstypes.NAPTR{Order: 100, Preference: 10, Flags: "U", Services: "E2U+sip", Regexp: "!^.*$!sip:info@example.com!", Replacement: "."},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			record, diags := testCase.naptrValue.ValueNAPTR()
+
+			if diff := cmp.Diff(record, testCase.expectedNAPTR); diff != "" {
+				t.Errorf("Unexpected difference in NAPTR record (-got, +expected): %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,254 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// maxCharacterStringLength is the maximum length of a <character-string> in octets (RFC 1035 Section 3.3).
+	maxCharacterStringLength = 255
+
+	// maxRDATALength is the maximum length of RDATA in octets, which is limited by its 16-bit length field
+	// (RFC 1035 Section 3.2.1).
+	maxRDATALength = 65535
+
+	// maxCAATagLength is the maximum length of a CAA property tag (RFC 8659 Section 4.1).
+	maxCAATagLength = 15
+)
+
+// rdataToken is a single whitespace separated field of RDATA in presentation format, with the surrounding quotes of
+// a quoted field removed. Escape sequences are left in place, so they can be decoded as a character string or
+// rejected as part of a domain name.
+type rdataToken struct {
+	text   string
+	quoted bool
+}
+
+// splitRDATA splits RDATA in presentation format (RFC 1035 Section 5.1) into fields separated by whitespace, where a
+// quoted field may contain whitespace and a backslash escapes the following character.
+func splitRDATA(s string) ([]rdataToken, error) {
+	var tokens []rdataToken
+
+	for i := 0; i < len(s); {
+		if isRDATASpace(s[i]) {
+			i++
+			continue
+		}
+
+		quoted := s[i] == '"'
+		if quoted {
+			i++
+		}
+
+		start := i
+
+		for ; i < len(s); i++ {
+			c := s[i]
+
+			if c == '\\' {
+				if i+1 >= len(s) {
+					return nil, errors.New("backslash must be followed by an escaped character")
+				}
+
+				i++
+
+				continue
+			}
+
+			if quoted && c == '"' {
+				break
+			}
+
+			if !quoted && isRDATASpace(c) {
+				break
+			}
+
+			if !quoted && c == '"' {
+				return nil, errors.New("quote must only appear at the start of a field")
+			}
+		}
+
+		end := i
+
+		if quoted {
+			if i >= len(s) {
+				return nil, errors.New("quoted field is missing its closing quote")
+			}
+
+			i++
+
+			if i < len(s) && !isRDATASpace(s[i]) {
+				return nil, errors.New("closing quote must be followed by whitespace")
+			}
+		}
+
+		tokens = append(tokens, rdataToken{text: s[start:end], quoted: quoted})
+	}
+
+	return tokens, nil
+}
+
+// isRDATASpace returns true if c separates RDATA fields.
+func isRDATASpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r' || c == '\n'
+}
+
+// parseCharacterString decodes the escape sequences of a field, where \DDD is a decimal octet and any other escaped
+// character is taken literally. A maxLength of zero disables the length check.
+func parseCharacterString(token rdataToken, maxLength int) (string, error) {
+	var b strings.Builder
+
+	for i := 0; i < len(token.text); i++ {
+		c := token.text[i]
+
+		if c != '\\' {
+			b.WriteByte(c)
+			continue
+		}
+
+		i++
+
+		if i+2 < len(token.text) && isDigits(token.text[i:i+3]) {
+			octet, err := strconv.ParseUint(token.text[i:i+3], 10, 8)
+			if err != nil {
+				return "", fmt.Errorf("escape sequence \\%s must be a decimal octet from 000 to 255", token.text[i:i+3])
+			}
+
+			b.WriteByte(byte(octet))
+			i += 2
+
+			continue
+		}
+
+		b.WriteByte(token.text[i])
+	}
+
+	if maxLength > 0 && b.Len() > maxLength {
+		return "", fmt.Errorf("character string must not be longer than %d octets, got %d", maxLength, b.Len())
+	}
+
+	return b.String(), nil
+}
+
+// formatCharacterString quotes a character string in presentation format, escaping quotes, backslashes and
+// non-printable octets.
+func formatCharacterString(s string) string {
+	var b strings.Builder
+
+	b.WriteByte('"')
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+
+		switch {
+		case c == '"' || c == '\\':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		case c < 0x20 || c > 0x7e:
+			fmt.Fprintf(&b, "\\%03d", c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+
+	b.WriteByte('"')
+
+	return b.String()
+}
+
+// parseRDATAUint parses an unquoted decimal field into an unsigned integer of the given bit size.
+func parseRDATAUint(token rdataToken, field string, bitSize int) (uint64, error) {
+	if token.quoted || !isDigits(token.text) {
+		return 0, fmt.Errorf("%s %q must be an unsigned %d-bit decimal integer", field, token.text, bitSize)
+	}
+
+	value, err := strconv.ParseUint(token.text, 10, bitSize)
+	if err != nil {
+		return 0, fmt.Errorf("%s %q must be an unsigned %d-bit decimal integer", field, token.text, bitSize)
+	}
+
+	return value, nil
+}
+
+// parseRDATADomainName parses an unquoted domain name field into its canonical lowercase form with a trailing dot. The
+// root name "." is returned as-is. Hostname fields follow the hostname label rules, otherwise labels may additionally
+// contain underscores, such as service labels (RFC 2782).
+func parseRDATADomainName(token rdataToken, field string, hostname bool) (string, error) {
+	if token.quoted {
+		return "", fmt.Errorf("%s %q must not be quoted", field, token.text)
+	}
+
+	if token.text == "." {
+		return ".", nil
+	}
+
+	labels, err := splitDomainName(token.text)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", field, err)
+	}
+
+	if hostname {
+		err = validateHostnameLabels(token.text, labels)
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", field, err)
+		}
+	} else {
+		for _, label := range labels {
+			if !isDNSLabel(label) {
+				return "", fmt.Errorf("%s: domain name %q: label %q must only contain letters, digits, hyphens and underscores", field, token.text, label)
+			}
+		}
+	}
+
+	return normalizeDomainName(token.text) + ".", nil
+}
+
+// parseRDATAHex decodes one or more unquoted hexadecimal fields, which presentation format allows to be split by
+// whitespace, into bytes.
+func parseRDATAHex(tokens []rdataToken, field string) ([]byte, error) {
+	var b strings.Builder
+
+	for _, token := range tokens {
+		if token.quoted {
+			return nil, fmt.Errorf("%s must not be quoted", field)
+		}
+
+		b.WriteString(token.text)
+	}
+
+	data, err := hex.DecodeString(b.String())
+	if err != nil || len(data) == 0 {
+		return nil, fmt.Errorf("%s must be a non-empty even number of hexadecimal digits", field)
+	}
+
+	return data, nil
+}
+
+// parseRDATABase64 decodes one or more unquoted base64 fields, which presentation format allows to be split by
+// whitespace, into bytes.
+func parseRDATABase64(tokens []rdataToken, field string) ([]byte, error) {
+	var b strings.Builder
+
+	for _, token := range tokens {
+		if token.quoted {
+			return nil, fmt.Errorf("%s must not be quoted", field)
+		}
+
+		b.WriteString(token.text)
+	}
+
+	data, err := base64.StdEncoding.Strict().DecodeString(b.String())
+	if err != nil || len(data) == 0 {
+		return nil, fmt.Errorf("%s must be non-empty padded base64", field)
+	}
+
+	return data, nil
+}
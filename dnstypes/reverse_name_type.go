@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = (*ReverseNameType)(nil)
+
+// ReverseNameType is an attribute type that represents a valid reverse DNS name, which is an IPv4 address or prefix
+// in decimal octet labels below `in-addr.arpa`, such as `1.2.0.192.in-addr.arpa`, or an IPv6 address or prefix in
+// hexadecimal nibble labels below `ip6.arpa`. IPv4 names may contain an RFC 2317 classless delegation label in the
+// fourth octet position, such as `0/26.2.0.192.in-addr.arpa`. A single trailing dot is permitted. Semantic equality
+// logic is defined for ReverseNameType, so that letter case and a trailing dot are ignored.
+//
+// All of the following are semantically equal:
+//   - 1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa
+//   - 1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.B.D.0.1.0.0.2.IP6.ARPA
+//   - 1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa.
+type ReverseNameType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t ReverseNameType) String() string {
+	return "dnstypes.ReverseNameType"
+}
+
+// ValueType returns the Value type.
+func (t ReverseNameType) ValueType(ctx context.Context) attr.Value {
+	return ReverseName{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t ReverseNameType) Equal(o attr.Type) bool {
+	other, ok := o.(ReverseNameType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t ReverseNameType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return ReverseName{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t ReverseNameType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
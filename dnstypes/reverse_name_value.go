@@ -0,0 +1,466 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/cidrtypes"
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/iptypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*ReverseName)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*ReverseName)(nil)
+	_ xattr.ValidateableAttribute                = (*ReverseName)(nil)
+	_ function.ValidateableParameter             = (*ReverseName)(nil)
+)
+
+const (
+	// reverseNameIPv4Suffix is the domain of IPv4 reverse names (RFC 1035 Section 3.5).
+	reverseNameIPv4Suffix = "in-addr.arpa"
+
+	// reverseNameIPv6Suffix is the domain of IPv6 reverse names (RFC 3596 Section 2.5).
+	reverseNameIPv6Suffix = "ip6.arpa"
+
+	// minClasslessPrefixLength and maxClasslessPrefixLength are the IPv4 prefix lengths that are delegated with a
+	// classless label such as `0/26` (RFC 2317), since shorter prefixes end on or span octet boundaries.
+	minClasslessPrefixLength = 25
+	maxClasslessPrefixLength = 31
+)
+
+// ReverseName represents a valid reverse DNS name, which is an IPv4 address or prefix in decimal octet labels below
+// `in-addr.arpa`, such as `1.2.0.192.in-addr.arpa`, or an IPv6 address or prefix in hexadecimal nibble labels below
+// `ip6.arpa`. IPv4 names may contain an RFC 2317 classless delegation label in the fourth octet position, which is
+// the first address and prefix length of the delegated block, such as `0/26.2.0.192.in-addr.arpa`, optionally
+// preceded by an octet label of an address within the block. A single trailing dot is permitted. Semantic equality
+// logic is defined for ReverseName, so that letter case and a trailing dot are ignored.
+//
+// All of the following are semantically equal:
+//   - 1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa
+//   - 1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.B.D.0.1.0.0.2.IP6.ARPA
+//   - 1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa.
+type ReverseName struct {
+	basetypes.StringValue
+}
+
+// Type returns a ReverseNameType.
+func (v ReverseName) Type(_ context.Context) attr.Type {
+	return ReverseNameType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v ReverseName) Equal(o attr.Value) bool {
+	other, ok := o.(ReverseName)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given reverse name string value is semantically equal to the current
+// reverse name string value. This comparison ignores letter case (RFC 4343) and a trailing dot.
+//
+// All of the following are semantically equal:
+//   - 1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa
+//   - 1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.B.D.0.1.0.0.2.IP6.ARPA
+//   - 1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa.
+func (v ReverseName) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(ReverseName)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	return normalizeDomainName(v.ValueString()) == normalizeDomainName(newValue.ValueString()), diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String
+// value that is a valid reverse DNS name below in-addr.arpa or ip6.arpa.
+func (v ReverseName) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseReverseName(v.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Reverse Name String Value",
+			"A string value was provided that is not valid reverse DNS name string format (RFC 1035, RFC 3596, RFC 2317).\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is a valid reverse DNS name below in-addr.arpa or ip6.arpa.
+func (v ReverseName) ValidateParameter(_ context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseReverseName(v.ValueString())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid Reverse Name String Value: "+
+				"A string value was provided that is not valid reverse DNS name string format (RFC 1035, RFC 3596, RFC 2317).\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueIPAddress returns the IP address of a reverse name for a single address, such as `192.0.2.1` for
+// `1.2.0.192.in-addr.arpa` or `1.0/26.2.0.192.in-addr.arpa`, as an iptypes.IPAddress. A null or unknown value, or a
+// reverse name for a prefix that is not a single address, will produce an error diagnostic.
+func (v ReverseName) ValueIPAddress() (iptypes.IPAddress, diag.Diagnostics) {
+	prefix, diags := v.valueReverseName("ValueIPAddress")
+	if diags.HasError() {
+		return iptypes.IPAddress{}, diags
+	}
+
+	if !prefix.IsSingleIP() {
+		diags.Append(diag.NewErrorDiagnostic(
+			"ReverseName ValueIPAddress Error",
+			fmt.Sprintf("reverse name %q is for prefix %s, not a single address", v.ValueString(), prefix),
+		))
+		return iptypes.IPAddress{}, diags
+	}
+
+	return iptypes.NewIPAddressValue(prefix.Addr().String()), nil
+}
+
+// ValueIPPrefix returns the IP prefix covered by the reverse name as a cidrtypes.IPPrefix, such as `192.0.2.0/24` for
+// `2.0.192.in-addr.arpa`, `192.0.2.0/26` for `0/26.2.0.192.in-addr.arpa` or `2001:db8::/32` for
+// `8.b.d.0.1.0.0.2.ip6.arpa`. Reverse names for a single address produce a /32 or /128 prefix. A null or unknown
+// value will produce an error diagnostic.
+func (v ReverseName) ValueIPPrefix() (cidrtypes.IPPrefix, diag.Diagnostics) {
+	prefix, diags := v.valueReverseName("ValueIPPrefix")
+	if diags.HasError() {
+		return cidrtypes.IPPrefix{}, diags
+	}
+
+	return cidrtypes.NewIPPrefixValue(prefix.String()), nil
+}
+
+// valueReverseName parses the ReverseName StringValue, with diagnostics for the given accessor method name.
+func (v ReverseName) valueReverseName(method string) (netip.Prefix, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("ReverseName "+method+" Error", "reverse name string value is null"))
+		return netip.Prefix{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("ReverseName "+method+" Error", "reverse name string value is unknown"))
+		return netip.Prefix{}, diags
+	}
+
+	prefix, err := parseReverseName(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("ReverseName "+method+" Error", err.Error()))
+		return netip.Prefix{}, diags
+	}
+
+	return prefix, nil
+}
+
+// ReverseNameFromIPAddress returns the reverse name of an IP address, such as `1.2.0.192.in-addr.arpa.` for
+// `192.0.2.1` or the 32 nibble ip6.arpa name for an IPv6 address. IPv4-mapped IPv6 addresses are kept as IPv6
+// addresses and a zone is ignored. A null or unknown value will produce a null or unknown ReverseName respectively.
+func ReverseNameFromIPAddress(address iptypes.IPAddress) (ReverseName, diag.Diagnostics) {
+	if address.IsNull() {
+		return NewReverseNameNull(), nil
+	}
+
+	if address.IsUnknown() {
+		return NewReverseNameUnknown(), nil
+	}
+
+	ipAddr, diags := address.ValueIPAddress()
+	if diags.HasError() {
+		return NewReverseNameUnknown(), diags
+	}
+
+	ipAddr = ipAddr.WithZone("")
+
+	return NewReverseNameValue(formatReverseName(netip.PrefixFrom(ipAddr, ipAddr.BitLen()))), nil
+}
+
+// ReverseNameFromIPPrefix returns the name of the reverse zone of an IP prefix, such as `2.0.192.in-addr.arpa.` for
+// `192.0.2.0/24` or `8.b.d.0.1.0.0.2.ip6.arpa.` for `2001:db8::/32`. IPv4 prefix lengths must be on an octet boundary,
+// except for /25 to /31 which produce an RFC 2317 classless delegation name such as `0/26.2.0.192.in-addr.arpa.`, and
+// IPv6 prefix lengths must be on a nibble boundary. The prefix must not have host bits set. A null or unknown value
+// will produce a null or unknown ReverseName respectively.
+func ReverseNameFromIPPrefix(prefix cidrtypes.IPPrefix) (ReverseName, diag.Diagnostics) {
+	if prefix.IsNull() {
+		return NewReverseNameNull(), nil
+	}
+
+	if prefix.IsUnknown() {
+		return NewReverseNameUnknown(), nil
+	}
+
+	ipPrefix, diags := prefix.ValueIPPrefix()
+	if diags.HasError() {
+		return NewReverseNameUnknown(), diags
+	}
+
+	err := validateReversePrefix(ipPrefix)
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("ReverseNameFromIPPrefix Error", err.Error()))
+		return NewReverseNameUnknown(), diags
+	}
+
+	return NewReverseNameValue(formatReverseName(ipPrefix)), nil
+}
+
+// validateReversePrefix validates that a prefix has a reverse name, which requires the prefix length to be on an
+// octet boundary or a classless delegation length for IPv4, and on a nibble boundary for IPv6.
+func validateReversePrefix(prefix netip.Prefix) error {
+	if prefix.Masked() != prefix {
+		return fmt.Errorf("prefix %s must not have host bits set, expected %s", prefix, prefix.Masked())
+	}
+
+	bits := prefix.Bits()
+
+	if prefix.Addr().Is4() {
+		if bits%8 != 0 && (bits < minClasslessPrefixLength || bits > maxClasslessPrefixLength) {
+			return fmt.Errorf("prefix %s must have a length on an octet boundary, or from /%d to /%d for classless delegation", prefix, minClasslessPrefixLength, maxClasslessPrefixLength)
+		}
+
+		return nil
+	}
+
+	if bits%4 != 0 {
+		return fmt.Errorf("prefix %s must have a length on a nibble boundary", prefix)
+	}
+
+	return nil
+}
+
+// formatReverseName returns the reverse name of a validated prefix with a trailing dot, where IPv4 prefix lengths of
+// /25 to /31 produce a classless delegation label.
+func formatReverseName(prefix netip.Prefix) string {
+	var labels []string
+
+	if prefix.Addr().Is4() {
+		octets := prefix.Addr().As4()
+		bits := prefix.Bits()
+
+		if bits%8 != 0 {
+			labels = append(labels, fmt.Sprintf("%d/%d", octets[3], bits))
+			bits = 24
+		}
+
+		for i := bits/8 - 1; i >= 0; i-- {
+			labels = append(labels, strconv.Itoa(int(octets[i])))
+		}
+
+		return strings.Join(append(labels, reverseNameIPv4Suffix), ".") + "."
+	}
+
+	octets := prefix.Addr().As16()
+
+	for i := prefix.Bits()/4 - 1; i >= 0; i-- {
+		nibble := octets[i/2] >> 4
+
+		if i%2 == 1 {
+			nibble = octets[i/2] & 0x0F
+		}
+
+		labels = append(labels, strconv.FormatUint(uint64(nibble), 16))
+	}
+
+	return strings.Join(append(labels, reverseNameIPv6Suffix), ".") + "."
+}
+
+// parseReverseName parses a reverse name into the prefix it covers, where reverse names of a single address produce
+// a /32 or /128 prefix.
+func parseReverseName(s string) (netip.Prefix, error) {
+	labels, err := splitDomainName(s)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+
+	name := normalizeDomainName(s)
+
+	var prefix netip.Prefix
+
+	switch {
+	case name == reverseNameIPv4Suffix || strings.HasSuffix(name, "."+reverseNameIPv4Suffix):
+		prefix, err = parseReverseNameIPv4(labels[:len(labels)-2])
+	case name == reverseNameIPv6Suffix || strings.HasSuffix(name, "."+reverseNameIPv6Suffix):
+		prefix, err = parseReverseNameIPv6(labels[:len(labels)-2])
+	default:
+		err = fmt.Errorf("must end with %s or %s", reverseNameIPv4Suffix, reverseNameIPv6Suffix)
+	}
+
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("reverse name %q: %w", s, err)
+	}
+
+	return prefix, nil
+}
+
+// parseReverseNameIPv4 parses the labels in front of in-addr.arpa, which are up to four decimal octets in reverse
+// order, where the fourth octet may be a classless delegation label optionally preceded by an octet within the block.
+func parseReverseNameIPv4(labels []string) (netip.Prefix, error) {
+	var host string
+
+	if len(labels) == 5 {
+		host, labels = labels[0], labels[1:]
+	}
+
+	if len(labels) > 4 || (host != "" && !strings.Contains(labels[0], "/")) {
+		return netip.Prefix{}, errors.New("must not contain more than 4 octet labels, except for an address label in front of a classless delegation label")
+	}
+
+	var octets [4]byte
+
+	bits := len(labels) * 8
+
+	for i, label := range labels {
+		position := len(labels) - 1 - i
+
+		start, length, classless := strings.Cut(label, "/")
+		if classless && position != 3 {
+			return netip.Prefix{}, fmt.Errorf("classless delegation label %q must be in the fourth octet position", label)
+		}
+
+		octet, err := parseReverseNameOctet(start)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+
+		octets[position] = octet
+
+		if classless {
+			prefixLength, err := strconv.Atoi(length)
+			if err != nil || !isDigits(length) || prefixLength < minClasslessPrefixLength || prefixLength > maxClasslessPrefixLength {
+				return netip.Prefix{}, fmt.Errorf("classless delegation label %q must have a prefix length from %d to %d", label, minClasslessPrefixLength, maxClasslessPrefixLength)
+			}
+
+			if octet&(0xFF>>(prefixLength-24)) != 0 {
+				return netip.Prefix{}, fmt.Errorf("classless delegation label %q must start on a /%d boundary", label, prefixLength)
+			}
+
+			bits = prefixLength
+		}
+	}
+
+	prefix := netip.PrefixFrom(netip.AddrFrom4(octets), bits)
+
+	if host == "" {
+		return prefix, nil
+	}
+
+	octet, err := parseReverseNameOctet(host)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+
+	octets[3] = octet
+
+	if !prefix.Contains(netip.AddrFrom4(octets)) {
+		return netip.Prefix{}, fmt.Errorf("label %q must be an address within the classless delegation block %s", host, prefix)
+	}
+
+	return netip.PrefixFrom(netip.AddrFrom4(octets), 32), nil
+}
+
+// parseReverseNameOctet parses a decimal octet label of an IPv4 reverse name, which must not have leading zeros.
+func parseReverseNameOctet(label string) (byte, error) {
+	octet, err := strconv.ParseUint(label, 10, 8)
+	if err != nil || !isDigits(label) || (len(label) > 1 && label[0] == '0') {
+		return 0, fmt.Errorf("label %q must be a decimal octet from 0 to 255 without leading zeros", label)
+	}
+
+	return byte(octet), nil
+}
+
+// parseReverseNameIPv6 parses the labels in front of ip6.arpa, which are up to 32 hexadecimal nibbles in reverse
+// order.
+func parseReverseNameIPv6(labels []string) (netip.Prefix, error) {
+	if len(labels) > 32 {
+		return netip.Prefix{}, fmt.Errorf("must not contain more than 32 nibble labels, got %d", len(labels))
+	}
+
+	var octets [16]byte
+
+	for i, label := range labels {
+		nibble, err := strconv.ParseUint(label, 16, 4)
+		if err != nil || len(label) != 1 {
+			return netip.Prefix{}, fmt.Errorf("label %q must be a single hexadecimal digit", label)
+		}
+
+		position := len(labels) - 1 - i
+
+		if position%2 == 0 {
+			octets[position/2] |= byte(nibble) << 4
+		} else {
+			octets[position/2] |= byte(nibble)
+		}
+	}
+
+	return netip.PrefixFrom(netip.AddrFrom16(octets), len(labels)*4), nil
+}
+
+// NewReverseNameNull creates a ReverseName with a null value. Determine whether the value is null via IsNull method.
+func NewReverseNameNull() ReverseName {
+	return ReverseName{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewReverseNameUnknown creates a ReverseName with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewReverseNameUnknown() ReverseName {
+	return ReverseName{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewReverseNameValue creates a ReverseName with a known value. Access the value via ValueString method.
+func NewReverseNameValue(value string) ReverseName {
+	return ReverseName{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewReverseNamePointerValue creates a ReverseName with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewReverseNamePointerValue(value *string) ReverseName {
+	return ReverseName{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/cidrtypes"
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/dnstypes"
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/iptypes"
+)
+
+type ReverseNameResourceModel struct {
+	Address  iptypes.IPAddress    `tfsdk:"address"`
+	Network  cidrtypes.IPPrefix   `tfsdk:"network"`
+	PTRName  dnstypes.ReverseName `tfsdk:"ptr_name"`
+	ZoneName dnstypes.ReverseName `tfsdk:"zone_name"`
+}
+
+func ExampleReverseNameFromIPAddress() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := ReverseNameResourceModel{
+		Address: iptypes.NewIPAddressValue("192.0.2.65"),
+		Network: cidrtypes.NewIPPrefixValue("192.0.2.64/26"),
+	}
+
+	// Populate the computed PTR record and RFC 2317 classless reverse zone names
+	ptrName, diags := dnstypes.ReverseNameFromIPAddress(data.Address)
+	if diags.HasError() {
+		return
+	}
+
+	zoneName, diags := dnstypes.ReverseNameFromIPPrefix(data.Network)
+	if diags.HasError() {
+		return
+	}
+
+	data.PTRName = ptrName
+	data.ZoneName = zoneName
+
+	fmt.Println(data.PTRName.ValueString())
+	fmt.Println(data.ZoneName.ValueString())
+	// Output:
+	// 65.2.0.192.in-addr.arpa.
+	// 64/26.2.0.192.in-addr.arpa.
+}
@@ -0,0 +1,619 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/cidrtypes"
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/dnstypes"
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/iptypes"
+)
+
+func TestReverseNameStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentName   dnstypes.ReverseName
+		givenName     basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"semantically equal - byte-for-byte match": {
+			currentName:   dnstypes.NewReverseNameValue("1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa"),
+			givenName:     dnstypes.NewReverseNameValue("1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa"),
+			expectedMatch: true,
+		},
+		"semantically equal - letter case": {
+			currentName:   dnstypes.NewReverseNameValue("1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa"),
+			givenName:     dnstypes.NewReverseNameValue("1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.B.D.0.1.0.0.2.IP6.ARPA"),
+			expectedMatch: true,
+		},
+		"semantically equal - trailing dot": {
+			currentName:   dnstypes.NewReverseNameValue("1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa"),
+			givenName:     dnstypes.NewReverseNameValue("1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa."),
+			expectedMatch: true,
+		},
+		"semantically equal - IPv4 classless letter case and trailing dot": {
+			currentName:   dnstypes.NewReverseNameValue("0/26.2.0.192.in-addr.arpa"),
+			givenName:     dnstypes.NewReverseNameValue("0/26.2.0.192.IN-ADDR.ARPA."),
+			expectedMatch: true,
+		},
+		"not equal - different address": {
+			currentName:   dnstypes.NewReverseNameValue("1.2.0.192.in-addr.arpa"),
+			givenName:     dnstypes.NewReverseNameValue("2.2.0.192.in-addr.arpa"),
+			expectedMatch: false,
+		},
+		"not equal - different classless prefix length": {
+			currentName:   dnstypes.NewReverseNameValue("0/26.2.0.192.in-addr.arpa"),
+			givenName:     dnstypes.NewReverseNameValue("0/25.2.0.192.in-addr.arpa"),
+			expectedMatch: false,
+		},
+		"error - not given ReverseName value": {
+			currentName:   dnstypes.NewReverseNameValue("1.2.0.192.in-addr.arpa"),
+			givenName:     basetypes.NewStringValue("1.2.0.192.in-addr.arpa"),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: dnstypes.ReverseName\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentName.StringSemanticEquals(context.Background(), testCase.givenName)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestReverseNameValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		name          dnstypes.ReverseName
+		expectedError string
+	}{
+		"empty-struct": {
+			name: dnstypes.ReverseName{},
+		},
+		"null": {
+			name: dnstypes.NewReverseNameNull(),
+		},
+		"unknown": {
+			name: dnstypes.NewReverseNameUnknown(),
+		},
+		"valid - IPv4 address": {
+			name: dnstypes.NewReverseNameValue("1.2.0.192.in-addr.arpa"),
+		},
+		"valid - IPv4 address with trailing dot": {
+			name: dnstypes.NewReverseNameValue("1.2.0.192.in-addr.arpa."),
+		},
+		"valid - IPv4 zone": {
+			name: dnstypes.NewReverseNameValue("2.0.192.in-addr.arpa"),
+		},
+		"valid - IPv4 root zone": {
+			name: dnstypes.NewReverseNameValue("in-addr.arpa"),
+		},
+		"valid - IPv4 classless zone": {
+			name: dnstypes.NewReverseNameValue("64/26.2.0.192.in-addr.arpa"),
+		},
+		"valid - IPv4 classless address": {
+			name: dnstypes.NewReverseNameValue("65.64/26.2.0.192.in-addr.arpa"),
+		},
+		"valid - IPv4 classless /31": {
+			name: dnstypes.NewReverseNameValue("254/31.2.0.192.in-addr.arpa"),
+		},
+		"valid - IPv6 address": {
+			name: dnstypes.NewReverseNameValue("1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa"),
+		},
+		"valid - IPv6 zone": {
+			name: dnstypes.NewReverseNameValue("8.b.d.0.1.0.0.2.ip6.arpa"),
+		},
+		"valid - IPv6 uppercase nibbles": {
+			name: dnstypes.NewReverseNameValue("8.B.D.0.1.0.0.2.IP6.ARPA"),
+		},
+		"valid - IPv6 root zone": {
+			name: dnstypes.NewReverseNameValue("ip6.arpa"),
+		},
+		"invalid - empty": {
+			name:          dnstypes.NewReverseNameValue(""),
+			expectedError: "domain name \"\": must contain at least one label",
+		},
+		"invalid - empty label": {
+			name:          dnstypes.NewReverseNameValue("1..0.192.in-addr.arpa"),
+			expectedError: "domain name \"1..0.192.in-addr.arpa\": must not contain empty labels",
+		},
+		"invalid - not a reverse name": {
+			name:          dnstypes.NewReverseNameValue("www.example.com"),
+			expectedError: "reverse name \"www.example.com\": must end with in-addr.arpa or ip6.arpa",
+		},
+		"invalid - arpa only": {
+			name:          dnstypes.NewReverseNameValue("arpa"),
+			expectedError: "reverse name \"arpa\": must end with in-addr.arpa or ip6.arpa",
+		},
+		"invalid - IPv4 octet out of range": {
+			name:          dnstypes.NewReverseNameValue("256.2.0.192.in-addr.arpa"),
+			expectedError: "reverse name \"256.2.0.192.in-addr.arpa\": label \"256\" must be a decimal octet from 0 to 255 without leading zeros",
+		},
+		"invalid - IPv4 octet leading zero": {
+			name:          dnstypes.NewReverseNameValue("01.2.0.192.in-addr.arpa"),
+			expectedError: "reverse name \"01.2.0.192.in-addr.arpa\": label \"01\" must be a decimal octet from 0 to 255 without leading zeros",
+		},
+		"invalid - IPv4 octet not decimal": {
+			name:          dnstypes.NewReverseNameValue("a.2.0.192.in-addr.arpa"),
+			expectedError: "reverse name \"a.2.0.192.in-addr.arpa\": label \"a\" must be a decimal octet from 0 to 255 without leading zeros",
+		},
+		"invalid - IPv4 too many labels": {
+			name:          dnstypes.NewReverseNameValue("1.1.2.0.192.in-addr.arpa"),
+			expectedError: "reverse name \"1.1.2.0.192.in-addr.arpa\": must not contain more than 4 octet labels, except for an address label in front of a classless delegation label",
+		},
+		"invalid - IPv4 classless label position": {
+			name:          dnstypes.NewReverseNameValue("0/26.0.192.in-addr.arpa"),
+			expectedError: "reverse name \"0/26.0.192.in-addr.arpa\": classless delegation label \"0/26\" must be in the fourth octet position",
+		},
+		"invalid - IPv4 classless prefix length too short": {
+			name:          dnstypes.NewReverseNameValue("0/24.2.0.192.in-addr.arpa"),
+			expectedError: "reverse name \"0/24.2.0.192.in-addr.arpa\": classless delegation label \"0/24\" must have a prefix length from 25 to 31",
+		},
+		"invalid - IPv4 classless prefix length too long": {
+			name:          dnstypes.NewReverseNameValue("0/32.2.0.192.in-addr.arpa"),
+			expectedError: "reverse name \"0/32.2.0.192.in-addr.arpa\": classless delegation label \"0/32\" must have a prefix length from 25 to 31",
+		},
+		"invalid - IPv4 classless prefix length not decimal": {
+			name:          dnstypes.NewReverseNameValue("0/+26.2.0.192.in-addr.arpa"),
+			expectedError: "reverse name \"0/+26.2.0.192.in-addr.arpa\": classless delegation label \"0/+26\" must have a prefix length from 25 to 31",
+		},
+		"invalid - IPv4 classless block alignment": {
+			name:          dnstypes.NewReverseNameValue("32/26.2.0.192.in-addr.arpa"),
+			expectedError: "reverse name \"32/26.2.0.192.in-addr.arpa\": classless delegation label \"32/26\" must start on a /26 boundary",
+		},
+		"invalid - IPv4 classless address outside block": {
+			name:          dnstypes.NewReverseNameValue("1.64/26.2.0.192.in-addr.arpa"),
+			expectedError: "reverse name \"1.64/26.2.0.192.in-addr.arpa\": label \"1\" must be an address within the classless delegation block 192.0.2.64/26",
+		},
+		"invalid - IPv6 label not a nibble": {
+			name:          dnstypes.NewReverseNameValue("10.b.d.0.1.0.0.2.ip6.arpa"),
+			expectedError: "reverse name \"10.b.d.0.1.0.0.2.ip6.arpa\": label \"10\" must be a single hexadecimal digit",
+		},
+		"invalid - IPv6 label not hexadecimal": {
+			name:          dnstypes.NewReverseNameValue("g.b.d.0.1.0.0.2.ip6.arpa"),
+			expectedError: "reverse name \"g.b.d.0.1.0.0.2.ip6.arpa\": label \"g\" must be a single hexadecimal digit",
+		},
+		"invalid - IPv6 too many labels": {
+			name:          dnstypes.NewReverseNameValue("0.1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa"),
+			expectedError: "reverse name \"0.1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa\": must not contain more than 32 nibble labels, got 33",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var expectedDiags diag.Diagnostics
+
+			if testCase.expectedError != "" {
+				expectedDiags.AddAttributeError(
+					path.Root("test"),
+					"Invalid Reverse Name String Value",
+					"A string value was provided that is not valid reverse DNS name string format (RFC 1035, RFC 3596, RFC 2317).\n\n"+
+						"Given Value: "+testCase.name.ValueString()+"\n"+
+						"Error: "+testCase.expectedError,
+				)
+			}
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.name.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{Path: path.Root("test")},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestReverseNameValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		name            dnstypes.ReverseName
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			name: dnstypes.ReverseName{},
+		},
+		"null": {
+			name: dnstypes.NewReverseNameNull(),
+		},
+		"unknown": {
+			name: dnstypes.NewReverseNameUnknown(),
+		},
+		"valid": {
+			name: dnstypes.NewReverseNameValue("1.2.0.192.in-addr.arpa"),
+		},
+		"invalid": {
+			name: dnstypes.NewReverseNameValue("0/24.2.0.192.in-addr.arpa"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid Reverse Name String Value: "+
+					"A string value was provided that is not valid reverse DNS name string format (RFC 1035, RFC 3596, RFC 2317).\n\n"+
+					"Given Value: 0/24.2.0.192.in-addr.arpa\n"+
+					"Error: reverse name \"0/24.2.0.192.in-addr.arpa\": classless delegation label \"0/24\" must have a prefix length from 25 to 31",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.name.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestReverseNameValueIPAddress(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		name            dnstypes.ReverseName
+		expectedAddress iptypes.IPAddress
+		expectedDiags   diag.Diagnostics
+	}{
+		"error - null": {
+			name:            dnstypes.NewReverseNameNull(),
+			expectedAddress: iptypes.IPAddress{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"ReverseName ValueIPAddress Error",
+					"reverse name string value is null",
+				),
+			},
+		},
+		"error - unknown": {
+			name:            dnstypes.NewReverseNameUnknown(),
+			expectedAddress: iptypes.IPAddress{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"ReverseName ValueIPAddress Error",
+					"reverse name string value is unknown",
+				),
+			},
+		},
+		"IPv4": {
+			name:            dnstypes.NewReverseNameValue("1.2.0.192.IN-ADDR.ARPA."),
+			expectedAddress: iptypes.NewIPAddressValue("192.0.2.1"),
+		},
+		"IPv4 classless": {
+			name:            dnstypes.NewReverseNameValue("65.64/26.2.0.192.in-addr.arpa"),
+			expectedAddress: iptypes.NewIPAddressValue("192.0.2.65"),
+		},
+		"IPv6": {
+			name:            dnstypes.NewReverseNameValue("1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa"),
+			expectedAddress: iptypes.NewIPAddressValue("2001:db8::1"),
+		},
+		"error - prefix": {
+			name:            dnstypes.NewReverseNameValue("2.0.192.in-addr.arpa"),
+			expectedAddress: iptypes.IPAddress{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"ReverseName ValueIPAddress Error",
+					"reverse name \"2.0.192.in-addr.arpa\" is for prefix 192.0.2.0/24, not a single address",
+				),
+			},
+		},
+		"error - invalid": {
+			name:            dnstypes.NewReverseNameValue("www.example.com"),
+			expectedAddress: iptypes.IPAddress{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"ReverseName ValueIPAddress Error",
+					"reverse name \"www.example.com\": must end with in-addr.arpa or ip6.arpa",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			address, diags := testCase.name.ValueIPAddress()
+
+			if diff := cmp.Diff(address, testCase.expectedAddress); diff != "" {
+				t.Errorf("Unexpected difference in IP address (-got, +expected): %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestReverseNameValueIPPrefix(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		name           dnstypes.ReverseName
+		expectedPrefix cidrtypes.IPPrefix
+		expectedDiags  diag.Diagnostics
+	}{
+		"error - null": {
+			name:           dnstypes.NewReverseNameNull(),
+			expectedPrefix: cidrtypes.IPPrefix{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"ReverseName ValueIPPrefix Error",
+					"reverse name string value is null",
+				),
+			},
+		},
+		"error - unknown": {
+			name:           dnstypes.NewReverseNameUnknown(),
+			expectedPrefix: cidrtypes.IPPrefix{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"ReverseName ValueIPPrefix Error",
+					"reverse name string value is unknown",
+				),
+			},
+		},
+		"IPv4 address": {
+			name:           dnstypes.NewReverseNameValue("1.2.0.192.in-addr.arpa"),
+			expectedPrefix: cidrtypes.NewIPPrefixValue("192.0.2.1/32"),
+		},
+		"IPv4 zone": {
+			name:           dnstypes.NewReverseNameValue("2.0.192.in-addr.arpa."),
+			expectedPrefix: cidrtypes.NewIPPrefixValue("192.0.2.0/24"),
+		},
+		"IPv4 root zone": {
+			name:           dnstypes.NewReverseNameValue("in-addr.arpa"),
+			expectedPrefix: cidrtypes.NewIPPrefixValue("0.0.0.0/0"),
+		},
+		"IPv4 classless zone": {
+			name:           dnstypes.NewReverseNameValue("64/26.2.0.192.in-addr.arpa"),
+			expectedPrefix: cidrtypes.NewIPPrefixValue("192.0.2.64/26"),
+		},
+		"IPv4 classless address": {
+			name:           dnstypes.NewReverseNameValue("65.64/26.2.0.192.in-addr.arpa"),
+			expectedPrefix: cidrtypes.NewIPPrefixValue("192.0.2.65/32"),
+		},
+		"IPv6 address": {
+			name:           dnstypes.NewReverseNameValue("1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa"),
+			expectedPrefix: cidrtypes.NewIPPrefixValue("2001:db8::1/128"),
+		},
+		"IPv6 zone": {
+			name:           dnstypes.NewReverseNameValue("8.B.D.0.1.0.0.2.ip6.arpa"),
+			expectedPrefix: cidrtypes.NewIPPrefixValue("2001:db8::/32"),
+		},
+		"IPv6 nibble zone": {
+			name:           dnstypes.NewReverseNameValue("0.8.b.d.0.1.0.0.2.ip6.arpa"),
+			expectedPrefix: cidrtypes.NewIPPrefixValue("2001:db8::/36"),
+		},
+		"error - invalid": {
+			name:           dnstypes.NewReverseNameValue("1.2.0.192.in-addr.arpa.example.com"),
+			expectedPrefix: cidrtypes.IPPrefix{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"ReverseName ValueIPPrefix Error",
+					"reverse name \"1.2.0.192.in-addr.arpa.example.com\": must end with in-addr.arpa or ip6.arpa",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			prefix, diags := testCase.name.ValueIPPrefix()
+
+			if diff := cmp.Diff(prefix, testCase.expectedPrefix); diff != "" {
+				t.Errorf("Unexpected difference in IP prefix (-got, +expected): %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestReverseNameFromIPAddress(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		address       iptypes.IPAddress
+		expectedName  dnstypes.ReverseName
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			address:      iptypes.NewIPAddressNull(),
+			expectedName: dnstypes.NewReverseNameNull(),
+		},
+		"unknown": {
+			address:      iptypes.NewIPAddressUnknown(),
+			expectedName: dnstypes.NewReverseNameUnknown(),
+		},
+		"IPv4": {
+			address:      iptypes.NewIPAddressValue("10.0.0.1"),
+			expectedName: dnstypes.NewReverseNameValue("1.0.0.10.in-addr.arpa."),
+		},
+		"IPv6": {
+			address:      iptypes.NewIPAddressValue("2001:DB8::1"),
+			expectedName: dnstypes.NewReverseNameValue("1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa."),
+		},
+		"IPv6 with zone": {
+			address:      iptypes.NewIPAddressValue("fe80::1%eth0"),
+			expectedName: dnstypes.NewReverseNameValue("1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.e.f.ip6.arpa."),
+		},
+		"IPv4-mapped IPv6": {
+			address:      iptypes.NewIPAddressValue("::ffff:192.0.2.1"),
+			expectedName: dnstypes.NewReverseNameValue("1.0.2.0.0.0.0.c.f.f.f.f.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.ip6.arpa."),
+		},
+		"error - invalid address": {
+			address:      iptypes.NewIPAddressValue("192.0.2.256"),
+			expectedName: dnstypes.NewReverseNameUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"IPAddress ValueIPAddress Error",
+					"ParseAddr(\"192.0.2.256\"): IPv4 field has value >255",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			reverseName, diags := dnstypes.ReverseNameFromIPAddress(testCase.address)
+
+			if diff := cmp.Diff(reverseName, testCase.expectedName); diff != "" {
+				t.Errorf("Unexpected difference in reverse name (-got, +expected): %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestReverseNameFromIPPrefix(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		prefix        cidrtypes.IPPrefix
+		expectedName  dnstypes.ReverseName
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			prefix:       cidrtypes.NewIPPrefixNull(),
+			expectedName: dnstypes.NewReverseNameNull(),
+		},
+		"unknown": {
+			prefix:       cidrtypes.NewIPPrefixUnknown(),
+			expectedName: dnstypes.NewReverseNameUnknown(),
+		},
+		"IPv4 /24": {
+			prefix:       cidrtypes.NewIPPrefixValue("192.0.2.0/24"),
+			expectedName: dnstypes.NewReverseNameValue("2.0.192.in-addr.arpa."),
+		},
+		"IPv4 /8": {
+			prefix:       cidrtypes.NewIPPrefixValue("10.0.0.0/8"),
+			expectedName: dnstypes.NewReverseNameValue("10.in-addr.arpa."),
+		},
+		"IPv4 /0": {
+			prefix:       cidrtypes.NewIPPrefixValue("0.0.0.0/0"),
+			expectedName: dnstypes.NewReverseNameValue("in-addr.arpa."),
+		},
+		"IPv4 /32": {
+			prefix:       cidrtypes.NewIPPrefixValue("192.0.2.1/32"),
+			expectedName: dnstypes.NewReverseNameValue("1.2.0.192.in-addr.arpa."),
+		},
+		"IPv4 classless /26": {
+			prefix:       cidrtypes.NewIPPrefixValue("192.0.2.64/26"),
+			expectedName: dnstypes.NewReverseNameValue("64/26.2.0.192.in-addr.arpa."),
+		},
+		"IPv4 classless /31": {
+			prefix:       cidrtypes.NewIPPrefixValue("192.0.2.254/31"),
+			expectedName: dnstypes.NewReverseNameValue("254/31.2.0.192.in-addr.arpa."),
+		},
+		"IPv6 /32": {
+			prefix:       cidrtypes.NewIPPrefixValue("2001:db8::/32"),
+			expectedName: dnstypes.NewReverseNameValue("8.b.d.0.1.0.0.2.ip6.arpa."),
+		},
+		"IPv6 /36": {
+			prefix:       cidrtypes.NewIPPrefixValue("2001:db8:f000::/36"),
+			expectedName: dnstypes.NewReverseNameValue("f.8.b.d.0.1.0.0.2.ip6.arpa."),
+		},
+		"IPv6 /0": {
+			prefix:       cidrtypes.NewIPPrefixValue("::/0"),
+			expectedName: dnstypes.NewReverseNameValue("ip6.arpa."),
+		},
+		"error - IPv4 not on octet boundary": {
+			prefix:       cidrtypes.NewIPPrefixValue("10.0.0.0/20"),
+			expectedName: dnstypes.NewReverseNameUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"ReverseNameFromIPPrefix Error",
+					"prefix 10.0.0.0/20 must have a length on an octet boundary, or from /25 to /31 for classless delegation",
+				),
+			},
+		},
+		"error - IPv6 not on nibble boundary": {
+			prefix:       cidrtypes.NewIPPrefixValue("2001:db8::/33"),
+			expectedName: dnstypes.NewReverseNameUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"ReverseNameFromIPPrefix Error",
+					"prefix 2001:db8::/33 must have a length on a nibble boundary",
+				),
+			},
+		},
+		"error - host bits set": {
+			prefix:       cidrtypes.NewIPPrefixValue("192.0.2.1/24"),
+			expectedName: dnstypes.NewReverseNameUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"ReverseNameFromIPPrefix Error",
+					"prefix 192.0.2.1/24 must not have host bits set, expected 192.0.2.0/24",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			reverseName, diags := dnstypes.ReverseNameFromIPPrefix(testCase.prefix)
+
+			if diff := cmp.Diff(reverseName, testCase.expectedName); diff != "" {
+				t.Errorf("Unexpected difference in reverse name (-got, +expected): %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = (*SPFRecordType)(nil)
+
+// SPFRecordType is an attribute type that represents a valid SPF record (RFC 7208), which is a TXT record value
+// starting with v=spf1 followed by mechanisms and modifiers, such as
+// `v=spf1 ip4:192.0.2.0/24 include:_spf.example.com -all`. Semantic equality logic is defined for SPFRecordType, so
+// that quoting, chunking, whitespace, letter case of names, the default + qualifier, default prefix lengths, host bits
+// of ip4 and ip6 networks and the position of modifiers are ignored.
+//
+// All of the following are semantically equal:
+//   - v=spf1 ip4:192.0.2.0/24 include:_spf.example.com -all
+//   - "v=spf1 +ip4:192.0.2.1/24  " "INCLUDE:_spf.example.com. -all"
+type SPFRecordType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t SPFRecordType) String() string {
+	return "dnstypes.SPFRecordType"
+}
+
+// ValueType returns the Value type.
+func (t SPFRecordType) ValueType(ctx context.Context) attr.Value {
+	return SPFRecord{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t SPFRecordType) Equal(o attr.Type) bool {
+	other, ok := o.(SPFRecordType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t SPFRecordType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return SPFRecord{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t SPFRecordType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
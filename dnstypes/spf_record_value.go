@@ -0,0 +1,697 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*SPFRecord)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*SPFRecord)(nil)
+	_ xattr.ValidateableAttribute                = (*SPFRecord)(nil)
+	_ function.ValidateableParameter             = (*SPFRecord)(nil)
+)
+
+const (
+	// spfVersion is the version section that every SPF record must start with (RFC 7208 Section 4.5).
+	spfVersion = "v=spf1"
+
+	// maxSPFLookups is the maximum number of mechanisms and modifiers that cause DNS lookups during SPF evaluation
+	// (RFC 7208 Section 4.6.4).
+	maxSPFLookups = 10
+)
+
+// SPF qualifiers, which determine the result of a matching mechanism (RFC 7208 Section 4.6.2).
+const (
+	SPFQualifierPass     = "+"
+	SPFQualifierFail     = "-"
+	SPFQualifierSoftFail = "~"
+	SPFQualifierNeutral  = "?"
+)
+
+// spfDualCIDRPattern matches the optional IPv4 and IPv6 prefix lengths at the end of an a or mx mechanism.
+var spfDualCIDRPattern = regexp.MustCompile(`(/[0-9]+)?(//[0-9]+)?$`)
+
+// SPFRecord represents a valid SPF record (RFC 7208), which is a TXT record value starting with v=spf1 followed by
+// mechanisms and modifiers, such as `v=spf1 ip4:192.0.2.0/24 include:_spf.example.com -all`. The value may be
+// unquoted text or quoted character strings, as accepted by TXTRecord. Semantic equality logic is defined for
+// SPFRecord, so that quoting, chunking, whitespace, letter case of names, the default + qualifier, default prefix
+// lengths, host bits of ip4 and ip6 networks and the position of modifiers are ignored. The order of mechanisms is
+// significant, as they are evaluated from left to right.
+//
+// All of the following are semantically equal:
+//   - v=spf1 ip4:192.0.2.0/24 include:_spf.example.com -all
+//   - "v=spf1 +ip4:192.0.2.1/24  " "INCLUDE:_spf.example.com. -all"
+type SPFRecord struct {
+	basetypes.StringValue
+}
+
+// Type returns an SPFRecordType.
+func (v SPFRecord) Type(_ context.Context) attr.Type {
+	return SPFRecordType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v SPFRecord) Equal(o attr.Value) bool {
+	other, ok := o.(SPFRecord)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given SPF record string value is semantically equal to the current SPF
+// record string value. This comparison uses the canonical form of both records, so that quoting, chunking,
+// whitespace, letter case of names, the default + qualifier, default prefix lengths, host bits of ip4 and ip6
+// networks and the position of modifiers are ignored.
+//
+// All of the following are semantically equal:
+//   - v=spf1 ip4:192.0.2.0/24 include:_spf.example.com -all
+//   - "v=spf1 +ip4:192.0.2.1/24  " "INCLUDE:_spf.example.com. -all"
+func (v SPFRecord) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(SPFRecord)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// SPF records are already validated at this point, ignoring errors
+	newSPF, _ := parseSPF(newValue.ValueString())
+	currentSPF, _ := parseSPF(v.ValueString())
+
+	return currentSPF.String() == newSPF.String(), diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String
+// value that is a valid SPF record, starting with v=spf1 and followed by valid mechanisms and modifiers, with at most
+// 10 terms that cause DNS lookups.
+func (v SPFRecord) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseSPF(v.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid SPF Record String Value",
+			"A string value was provided that is not valid SPF record string format (RFC 7208).\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is a valid SPF record, starting with v=spf1 and followed by valid mechanisms and
+// modifiers, with at most 10 terms that cause DNS lookups.
+func (v SPFRecord) ValidateParameter(_ context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseSPF(v.ValueString())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid SPF Record String Value: "+
+				"A string value was provided that is not valid SPF record string format (RFC 7208).\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueSPF parses the SPFRecord StringValue into its directives and modifiers, with names in lowercase and default
+// qualifiers and prefix lengths filled in. A null or unknown value will produce an error diagnostic.
+func (v SPFRecord) ValueSPF() (SPF, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("SPFRecord ValueSPF Error", "SPF record string value is null"))
+		return SPF{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("SPFRecord ValueSPF Error", "SPF record string value is unknown"))
+		return SPF{}, diags
+	}
+
+	record, err := parseSPF(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("SPFRecord ValueSPF Error", err.Error()))
+		return SPF{}, diags
+	}
+
+	return record, nil
+}
+
+// SPF is a parsed SPF record (RFC 7208).
+type SPF struct {
+	// Directives are the mechanisms with their qualifiers, in the order they are evaluated.
+	Directives []SPFDirective
+
+	// Modifiers are the modifiers, such as redirect and exp, in the order they appear in the record.
+	Modifiers []SPFModifier
+}
+
+// SPFDirective is a mechanism of an SPF record with its qualifier (RFC 7208 Section 5).
+type SPFDirective struct {
+	// Qualifier is the result when the mechanism matches, which is one of SPFQualifierPass, SPFQualifierFail,
+	// SPFQualifierSoftFail or SPFQualifierNeutral.
+	Qualifier string
+
+	// Mechanism is the mechanism name in lowercase, which is one of all, include, a, mx, ptr, ip4, ip6 or exists.
+	Mechanism string
+
+	// DomainSpec is the domain of the include, a, mx, ptr and exists mechanisms, which is empty if the mechanism
+	// uses the current domain. A domain without macros is in lowercase without a trailing dot.
+	DomainSpec string
+
+	// Prefix is the network of the ip4 and ip6 mechanisms with host bits masked off.
+	Prefix netip.Prefix
+
+	// IPv4PrefixLength is the IPv4 prefix length of the a and mx mechanisms, which defaults to 32.
+	IPv4PrefixLength int
+
+	// IPv6PrefixLength is the IPv6 prefix length of the a and mx mechanisms, which defaults to 128.
+	IPv6PrefixLength int
+}
+
+// String returns the directive in canonical form, without the default + qualifier and default prefix lengths.
+func (d SPFDirective) String() string {
+	var b strings.Builder
+
+	if d.Qualifier != SPFQualifierPass {
+		b.WriteString(d.Qualifier)
+	}
+
+	b.WriteString(d.Mechanism)
+
+	switch d.Mechanism {
+	case "ip4", "ip6":
+		b.WriteString(":" + d.Prefix.Addr().String())
+
+		if d.Prefix.Bits() != d.Prefix.Addr().BitLen() {
+			b.WriteString("/" + strconv.Itoa(d.Prefix.Bits()))
+		}
+	default:
+		if d.DomainSpec != "" {
+			b.WriteString(":" + d.DomainSpec)
+		}
+	}
+
+	if d.Mechanism == "a" || d.Mechanism == "mx" {
+		if d.IPv4PrefixLength != 32 {
+			b.WriteString("/" + strconv.Itoa(d.IPv4PrefixLength))
+		}
+
+		if d.IPv6PrefixLength != 128 {
+			b.WriteString("//" + strconv.Itoa(d.IPv6PrefixLength))
+		}
+	}
+
+	return b.String()
+}
+
+// SPFModifier is a name=value modifier of an SPF record (RFC 7208 Section 6).
+type SPFModifier struct {
+	// Name is the modifier name in lowercase, such as redirect or exp.
+	Name string
+
+	// Value is the modifier value. A redirect or exp domain without macros is in lowercase without a trailing dot.
+	Value string
+}
+
+// String returns the modifier in canonical form, such as redirect=_spf.example.com.
+func (m SPFModifier) String() string {
+	return m.Name + "=" + m.Value
+}
+
+// String returns the SPF record in canonical form, with the directives in order followed by the modifiers sorted by
+// name, as the position of modifiers is not significant.
+func (r SPF) String() string {
+	terms := []string{spfVersion}
+
+	for _, directive := range r.Directives {
+		terms = append(terms, directive.String())
+	}
+
+	modifiers := make([]SPFModifier, len(r.Modifiers))
+	copy(modifiers, r.Modifiers)
+
+	sort.SliceStable(modifiers, func(i, j int) bool {
+		return modifiers[i].Name < modifiers[j].Name
+	})
+
+	for _, modifier := range modifiers {
+		terms = append(terms, modifier.String())
+	}
+
+	return strings.Join(terms, " ")
+}
+
+// parseSPF parses an SPF record from a TXT record value.
+func parseSPF(s string) (SPF, error) {
+	text, err := decodeTXT(s)
+	if err != nil {
+		return SPF{}, fmt.Errorf("SPF record %q: %w", s, err)
+	}
+
+	record, err := parseSPFTerms(text)
+	if err != nil {
+		return SPF{}, fmt.Errorf("SPF record %q: %w", s, err)
+	}
+
+	return record, nil
+}
+
+// parseSPFTerms parses the version and the space separated terms of SPF record text.
+func parseSPFTerms(text string) (SPF, error) {
+	terms := strings.Split(text, " ")
+
+	if !strings.EqualFold(terms[0], spfVersion) {
+		return SPF{}, fmt.Errorf("must start with %q", spfVersion)
+	}
+
+	var record SPF
+
+	lookups := 0
+	seenModifiers := make(map[string]bool)
+
+	for _, term := range terms[1:] {
+		// Terms are separated by one or more spaces
+		if term == "" {
+			continue
+		}
+
+		if name, value, ok := strings.Cut(term, "="); ok && isSPFModifierName(name) {
+			modifier, err := parseSPFModifier(strings.ToLower(name), value)
+			if err != nil {
+				return SPF{}, err
+			}
+
+			if (modifier.Name == "redirect" || modifier.Name == "exp") && seenModifiers[modifier.Name] {
+				return SPF{}, fmt.Errorf("modifier %q must not appear more than once", modifier.Name)
+			}
+
+			if modifier.Name == "redirect" {
+				lookups++
+			}
+
+			seenModifiers[modifier.Name] = true
+			record.Modifiers = append(record.Modifiers, modifier)
+
+			continue
+		}
+
+		directive, err := parseSPFDirective(term)
+		if err != nil {
+			return SPF{}, err
+		}
+
+		switch directive.Mechanism {
+		case "include", "a", "mx", "ptr", "exists":
+			lookups++
+		}
+
+		record.Directives = append(record.Directives, directive)
+	}
+
+	if lookups > maxSPFLookups {
+		return SPF{}, fmt.Errorf("must not contain more than %d mechanisms and modifiers that cause DNS lookups, got %d", maxSPFLookups, lookups)
+	}
+
+	return record, nil
+}
+
+// parseSPFModifier parses the value of a modifier, where redirect and exp require a domain-spec and any other
+// modifier is a macro-string that is otherwise ignored.
+func parseSPFModifier(name string, value string) (SPFModifier, error) {
+	if name != "redirect" && name != "exp" {
+		if err := validateSPFMacroString(value); err != nil {
+			return SPFModifier{}, fmt.Errorf("modifier %q: %w", name, err)
+		}
+
+		return SPFModifier{Name: name, Value: value}, nil
+	}
+
+	domainSpec, err := parseSPFDomainSpec(value)
+	if err != nil {
+		return SPFModifier{}, fmt.Errorf("modifier %q: %w", name, err)
+	}
+
+	return SPFModifier{Name: name, Value: domainSpec}, nil
+}
+
+// parseSPFDirective parses a mechanism with an optional qualifier (RFC 7208 Section 5).
+func parseSPFDirective(term string) (SPFDirective, error) {
+	directive := SPFDirective{Qualifier: SPFQualifierPass}
+	mechanism := term
+
+	switch term[0] {
+	case '+', '-', '~', '?':
+		directive.Qualifier = term[:1]
+		mechanism = term[1:]
+	}
+
+	end := strings.IndexAny(mechanism, ":/")
+	if end == -1 {
+		end = len(mechanism)
+	}
+
+	directive.Mechanism = strings.ToLower(mechanism[:end])
+	args := mechanism[end:]
+
+	switch directive.Mechanism {
+	case "all":
+		if args != "" {
+			return SPFDirective{}, fmt.Errorf("mechanism %q must not have arguments", term)
+		}
+	case "include", "exists", "ptr":
+		if args == "" && directive.Mechanism == "ptr" {
+			break
+		}
+
+		if !strings.HasPrefix(args, ":") {
+			return SPFDirective{}, fmt.Errorf("mechanism %q must have a domain", term)
+		}
+
+		domainSpec, err := parseSPFDomainSpec(args[1:])
+		if err != nil {
+			return SPFDirective{}, fmt.Errorf("mechanism %q: %w", term, err)
+		}
+
+		directive.DomainSpec = domainSpec
+	case "a", "mx":
+		cidr := spfDualCIDRPattern.FindStringSubmatchIndex(args)
+		domainArgs := args[:cidr[0]]
+
+		ipv4PrefixLength, err := parseSPFPrefixLength(args, cidr[2], cidr[3], 1, 32)
+		if err != nil {
+			return SPFDirective{}, fmt.Errorf("mechanism %q: %w", term, err)
+		}
+
+		ipv6PrefixLength, err := parseSPFPrefixLength(args, cidr[4], cidr[5], 2, 128)
+		if err != nil {
+			return SPFDirective{}, fmt.Errorf("mechanism %q: %w", term, err)
+		}
+
+		directive.IPv4PrefixLength = ipv4PrefixLength
+		directive.IPv6PrefixLength = ipv6PrefixLength
+
+		if domainArgs == "" {
+			break
+		}
+
+		if !strings.HasPrefix(domainArgs, ":") {
+			return SPFDirective{}, fmt.Errorf("mechanism %q must be followed by a colon and a domain", term)
+		}
+
+		domainSpec, err := parseSPFDomainSpec(domainArgs[1:])
+		if err != nil {
+			return SPFDirective{}, fmt.Errorf("mechanism %q: %w", term, err)
+		}
+
+		directive.DomainSpec = domainSpec
+	case "ip4", "ip6":
+		if !strings.HasPrefix(args, ":") {
+			return SPFDirective{}, fmt.Errorf("mechanism %q must have a network", term)
+		}
+
+		prefix, err := parseSPFNetwork(args[1:], directive.Mechanism == "ip6")
+		if err != nil {
+			return SPFDirective{}, fmt.Errorf("mechanism %q: %w", term, err)
+		}
+
+		directive.Prefix = prefix
+	default:
+		return SPFDirective{}, fmt.Errorf("mechanism %q is not a known mechanism", term)
+	}
+
+	return directive, nil
+}
+
+// parseSPFNetwork parses the network of an ip4 or ip6 mechanism, which is an address with an optional prefix length,
+// with the same netip logic as cidrtypes.IPv4Prefix and cidrtypes.IPv6Prefix. Host bits are masked off, as only the
+// network is significant when matching.
+func parseSPFNetwork(s string, ipv6 bool) (netip.Prefix, error) {
+	var prefix netip.Prefix
+
+	if strings.Contains(s, "/") {
+		// Prefix lengths with leading zeros are not allowed (RFC 7208 Section 5.6)
+		_, bits, _ := strings.Cut(s, "/")
+		if len(bits) > 1 && bits[0] == '0' {
+			return netip.Prefix{}, fmt.Errorf("prefix length %q must not have leading zeros", bits)
+		}
+
+		p, err := netip.ParsePrefix(s)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+
+		prefix = p
+	} else {
+		addr, err := netip.ParseAddr(s)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+
+		if addr.Zone() != "" {
+			return netip.Prefix{}, fmt.Errorf("address %q must not have a zone", s)
+		}
+
+		prefix = netip.PrefixFrom(addr, addr.BitLen())
+	}
+
+	if ipv6 && !prefix.Addr().Is6() {
+		return netip.Prefix{}, fmt.Errorf("network %q must be an IPv6 address or CIDR", s)
+	}
+
+	if !ipv6 && !prefix.Addr().Is4() {
+		return netip.Prefix{}, fmt.Errorf("network %q must be an IPv4 address or CIDR", s)
+	}
+
+	return prefix.Masked(), nil
+}
+
+// parseSPFPrefixLength parses a prefix length of a dual-cidr-length, which is the submatch of args between start
+// and end after the given number of slashes. A missing prefix length returns maxBits.
+func parseSPFPrefixLength(args string, start int, end int, slashes int, maxBits int) (int, error) {
+	if start == -1 {
+		return maxBits, nil
+	}
+
+	digits := args[start+slashes : end]
+
+	if len(digits) > 1 && digits[0] == '0' {
+		return 0, fmt.Errorf("prefix length %q must not have leading zeros", digits)
+	}
+
+	bits, err := strconv.Atoi(digits)
+	if err != nil || bits > maxBits {
+		return 0, fmt.Errorf("prefix length %q must be between 0 and %d", digits, maxBits)
+	}
+
+	return bits, nil
+}
+
+// parseSPFDomainSpec validates a domain-spec (RFC 7208 Section 7.1), which is a macro-string that must end with a
+// top-level label or a macro. A domain without macros is validated as a domain name and returned in lowercase
+// without a trailing dot.
+func parseSPFDomainSpec(s string) (string, error) {
+	if s == "" {
+		return "", errors.New("domain must not be empty")
+	}
+
+	if err := validateSPFMacroString(s); err != nil {
+		return "", err
+	}
+
+	if strings.Contains(s, "%") {
+		// A domain-spec with macros is expanded at evaluation time and may end with a macro
+		if strings.HasSuffix(s, "}") {
+			return s, nil
+		}
+
+		labels := strings.Split(strings.TrimSuffix(s, "."), ".")
+		if len(labels) < 2 || !isHostnameLabel(labels[len(labels)-1]) || isDigits(labels[len(labels)-1]) {
+			return "", fmt.Errorf("domain %q must end with a macro or a top-level domain label", s)
+		}
+
+		return s, nil
+	}
+
+	labels, err := splitDomainName(s)
+	if err != nil {
+		return "", err
+	}
+
+	for _, label := range labels {
+		if !isDNSLabel(label) {
+			return "", fmt.Errorf("domain name %q: label %q must only contain letters, digits, hyphens and underscores", s, label)
+		}
+	}
+
+	if len(labels) < 2 {
+		return "", fmt.Errorf("domain name %q: must contain at least 2 labels", s)
+	}
+
+	if err := validateHostnameLabels(s, labels[len(labels)-1:]); err != nil {
+		return "", err
+	}
+
+	return normalizeDomainName(s), nil
+}
+
+// validateSPFMacroString validates the macros of a macro-string (RFC 7208 Section 7.1).
+func validateSPFMacroString(s string) error {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+
+		if c < 0x21 || c > 0x7e {
+			return errors.New("must only contain printable ASCII characters")
+		}
+
+		if c != '%' {
+			continue
+		}
+
+		if i+1 == len(s) {
+			return errors.New("must not end with a single %")
+		}
+
+		i++
+
+		switch s[i] {
+		case '%', '_', '-':
+			continue
+		case '{':
+		default:
+			return errors.New("% must be followed by {, %, _ or -")
+		}
+
+		end := strings.IndexByte(s[i:], '}')
+		if end == -1 {
+			return errors.New("macro must end with }")
+		}
+
+		macro := s[i+1 : i+end]
+		i += end
+
+		if err := validateSPFMacro(macro); err != nil {
+			return fmt.Errorf("macro %%{%s}: %w", macro, err)
+		}
+	}
+
+	return nil
+}
+
+// validateSPFMacro validates the contents of a macro-expand between the braces, which is a macro letter followed by
+// optional transformers and delimiters. The c, r and t macro letters are only allowed in explanation strings, which
+// are TXT records of their own rather than part of an SPF record.
+func validateSPFMacro(macro string) error {
+	if macro == "" {
+		return errors.New("must contain a macro letter")
+	}
+
+	switch strings.ToLower(macro[:1]) {
+	case "s", "l", "o", "d", "i", "p", "h", "v":
+	case "c", "r", "t":
+		return fmt.Errorf("macro letter %q is only allowed in explanation strings", macro[:1])
+	default:
+		return fmt.Errorf("macro letter %q must be one of s, l, o, d, i, p, h or v", macro[:1])
+	}
+
+	rest := macro[1:]
+	digits := len(rest) - len(strings.TrimLeft(rest, "0123456789"))
+
+	if digits > 0 && strings.TrimLeft(rest[:digits], "0") == "" {
+		return errors.New("number of labels must not be zero")
+	}
+
+	rest = rest[digits:]
+	rest = strings.TrimPrefix(strings.TrimPrefix(rest, "r"), "R")
+
+	if strings.Trim(rest, ".-+,/_=") != "" {
+		return errors.New("transformers must be digits followed by an optional r and delimiters . - + , / _ =")
+	}
+
+	return nil
+}
+
+// isSPFModifierName returns true if s is a valid modifier name, which is a letter followed by letters, digits,
+// hyphens, underscores and dots.
+func isSPFModifierName(s string) bool {
+	if s == "" || (s[0] < 'a' || s[0] > 'z') && (s[0] < 'A' || s[0] > 'Z') {
+		return false
+	}
+
+	for i := 1; i < len(s); i++ {
+		c := s[i]
+
+		if (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') && (c < '0' || c > '9') && c != '-' && c != '_' && c != '.' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// NewSPFRecordNull creates an SPFRecord with a null value. Determine whether the value is null via IsNull method.
+func NewSPFRecordNull() SPFRecord {
+	return SPFRecord{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewSPFRecordUnknown creates an SPFRecord with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewSPFRecordUnknown() SPFRecord {
+	return SPFRecord{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewSPFRecordValue creates an SPFRecord with a known value. Access the value via ValueString method.
+func NewSPFRecordValue(value string) SPFRecord {
+	return SPFRecord{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewSPFRecordPointerValue creates an SPFRecord with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewSPFRecordPointerValue(value *string) SPFRecord {
+	return SPFRecord{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
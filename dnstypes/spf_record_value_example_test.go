@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/dnstypes"
+)
+
+type SPFRecordResourceModel struct {
+	Value dnstypes.SPFRecord `tfsdk:"value"`
+}
+
+func ExampleSPFRecord_ValueSPF() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := SPFRecordResourceModel{
+		Value: dnstypes.NewSPFRecordValue("v=spf1 ip4:192.0.2.0/24 ip6:2001:db8::/32 include:_spf.example.com -all"),
+	}
+
+	// Check that the SPFRecord data is known and list the networks that are allowed to send mail
+	if !data.Value.IsNull() && !data.Value.IsUnknown() {
+		spf, diags := data.Value.ValueSPF()
+		if diags.HasError() {
+			return
+		}
+
+		for _, directive := range spf.Directives {
+			if directive.Prefix.IsValid() && directive.Qualifier == dnstypes.SPFQualifierPass {
+				fmt.Println(directive.Prefix)
+			}
+		}
+
+		// Output:
+		// 192.0.2.0/24
+		// 2001:db8::/32
+	}
+}
@@ -0,0 +1,382 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes_test
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/dnstypes"
+)
+
+func TestSPFRecordStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentSPF    dnstypes.SPFRecord
+		givenSPF      basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"semantically equal - byte-for-byte match": {
+			currentSPF:    dnstypes.NewSPFRecordValue("v=spf1 ip4:192.0.2.0/24 include:_spf.example.com -all"),
+			givenSPF:      dnstypes.NewSPFRecordValue("v=spf1 ip4:192.0.2.0/24 include:_spf.example.com -all"),
+			expectedMatch: true,
+		},
+		"semantically equal - quoted and chunked": {
+			currentSPF:    dnstypes.NewSPFRecordValue("v=spf1 ip4:192.0.2.0/24 include:_spf.example.com -all"),
+			givenSPF:      dnstypes.NewSPFRecordValue(`"v=spf1 ip4:192.0.2.0/24 " "include:_spf.example.com -all"`),
+			expectedMatch: true,
+		},
+		"semantically equal - whitespace": {
+			currentSPF:    dnstypes.NewSPFRecordValue("v=spf1 ip4:192.0.2.0/24 include:_spf.example.com -all"),
+			givenSPF:      dnstypes.NewSPFRecordValue("v=spf1  ip4:192.0.2.0/24   include:_spf.example.com -all "),
+			expectedMatch: true,
+		},
+		"semantically equal - letter case": {
+			currentSPF:    dnstypes.NewSPFRecordValue("v=spf1 ip4:192.0.2.0/24 include:_spf.example.com -all"),
+			givenSPF:      dnstypes.NewSPFRecordValue("V=SPF1 IP4:192.0.2.0/24 Include:_SPF.Example.COM -ALL"),
+			expectedMatch: true,
+		},
+		"semantically equal - default qualifier": {
+			currentSPF:    dnstypes.NewSPFRecordValue("v=spf1 mx ip6:2001:db8::/32 -all"),
+			givenSPF:      dnstypes.NewSPFRecordValue("v=spf1 +mx +ip6:2001:db8::/32 -all"),
+			expectedMatch: true,
+		},
+		"semantically equal - host bits": {
+			currentSPF:    dnstypes.NewSPFRecordValue("v=spf1 ip4:192.0.2.0/24 ip6:2001:db8::/32 -all"),
+			givenSPF:      dnstypes.NewSPFRecordValue("v=spf1 ip4:192.0.2.1/24 ip6:2001:db8::1/32 -all"),
+			expectedMatch: true,
+		},
+		"semantically equal - default prefix lengths": {
+			currentSPF:    dnstypes.NewSPFRecordValue("v=spf1 a ip4:192.0.2.1 ip6:2001:db8::1 -all"),
+			givenSPF:      dnstypes.NewSPFRecordValue("v=spf1 a/32//128 ip4:192.0.2.1/32 ip6:2001:db8::1/128 -all"),
+			expectedMatch: true,
+		},
+		"semantically equal - trailing dot": {
+			currentSPF:    dnstypes.NewSPFRecordValue("v=spf1 include:_spf.example.com -all"),
+			givenSPF:      dnstypes.NewSPFRecordValue("v=spf1 include:_spf.example.com. -all"),
+			expectedMatch: true,
+		},
+		"semantically equal - modifier position": {
+			currentSPF:    dnstypes.NewSPFRecordValue("v=spf1 mx exp=explain._spf.example.com redirect=_spf.example.com"),
+			givenSPF:      dnstypes.NewSPFRecordValue("v=spf1 redirect=_spf.example.com mx exp=explain._spf.example.com"),
+			expectedMatch: true,
+		},
+		"not equal - mechanism order": {
+			currentSPF:    dnstypes.NewSPFRecordValue("v=spf1 ip4:192.0.2.0/24 -all"),
+			givenSPF:      dnstypes.NewSPFRecordValue("v=spf1 -all ip4:192.0.2.0/24"),
+			expectedMatch: false,
+		},
+		"not equal - different qualifier": {
+			currentSPF:    dnstypes.NewSPFRecordValue("v=spf1 mx -all"),
+			givenSPF:      dnstypes.NewSPFRecordValue("v=spf1 mx ~all"),
+			expectedMatch: false,
+		},
+		"not equal - different prefix length": {
+			currentSPF:    dnstypes.NewSPFRecordValue("v=spf1 ip4:192.0.2.0/24 -all"),
+			givenSPF:      dnstypes.NewSPFRecordValue("v=spf1 ip4:192.0.2.0/25 -all"),
+			expectedMatch: false,
+		},
+		"not equal - macro letter case": {
+			currentSPF:    dnstypes.NewSPFRecordValue("v=spf1 exists:%{i}._spf.%{d} -all"),
+			givenSPF:      dnstypes.NewSPFRecordValue("v=spf1 exists:%{I}._spf.%{d} -all"),
+			expectedMatch: false,
+		},
+		"error - not given SPFRecord value": {
+			currentSPF:    dnstypes.NewSPFRecordValue("v=spf1 -all"),
+			givenSPF:      basetypes.NewStringValue("v=spf1 -all"),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: dnstypes.SPFRecord\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentSPF.StringSemanticEquals(context.Background(), testCase.givenSPF)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSPFRecordValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		spfValue      dnstypes.SPFRecord
+		expectedError string
+	}{
+		"empty-struct": {
+			spfValue: dnstypes.SPFRecord{},
+		},
+		"null": {
+			spfValue: dnstypes.NewSPFRecordNull(),
+		},
+		"unknown": {
+			spfValue: dnstypes.NewSPFRecordUnknown(),
+		},
+		"valid - version only": {
+			spfValue: dnstypes.NewSPFRecordValue("v=spf1"),
+		},
+		"valid - null policy": {
+			spfValue: dnstypes.NewSPFRecordValue("v=spf1 -all"),
+		},
+		"valid - all mechanisms": {
+			spfValue: dnstypes.NewSPFRecordValue("v=spf1 a mx ptr a:mail.example.com/24//64 mx:example.com//48 ip4:192.0.2.0/24 ip6:2001:db8::/32 exists:%{i}._spf.%{d} include:_spf.example.com ?all"),
+		},
+		"valid - modifiers": {
+			spfValue: dnstypes.NewSPFRecordValue("v=spf1 redirect=_spf.example.com exp=explain.%{d2} unknown-modifier=%{l}"),
+		},
+		"valid - macros": {
+			spfValue: dnstypes.NewSPFRecordValue("v=spf1 exists:%{ir}.%{l1r+-}._spf.%{d} include:%{d2}.example.com -all"),
+		},
+		"valid - quoted": {
+			spfValue: dnstypes.NewSPFRecordValue(`"v=spf1 include:_spf.example.com " "-all"`),
+		},
+		"valid - 10 DNS lookups": {
+			spfValue: dnstypes.NewSPFRecordValue("v=spf1 a mx ptr exists:%{i}.example.com include:a.example.com include:b.example.com include:c.example.com include:d.example.com include:e.example.com redirect=f.example.com"),
+		},
+		"invalid - empty": {
+			spfValue:      dnstypes.NewSPFRecordValue(""),
+			expectedError: "SPF record \"\": must start with \"v=spf1\"",
+		},
+		"invalid - version": {
+			spfValue:      dnstypes.NewSPFRecordValue("v=spf2 -all"),
+			expectedError: "SPF record \"v=spf2 -all\": must start with \"v=spf1\"",
+		},
+		"invalid - version prefix": {
+			spfValue:      dnstypes.NewSPFRecordValue("v=spf1-all"),
+			expectedError: "SPF record \"v=spf1-all\": must start with \"v=spf1\"",
+		},
+		"invalid - unknown mechanism": {
+			spfValue:      dnstypes.NewSPFRecordValue("v=spf1 ip:192.0.2.1 -all"),
+			expectedError: "SPF record \"v=spf1 ip:192.0.2.1 -all\": mechanism \"ip:192.0.2.1\" is not a known mechanism",
+		},
+		"invalid - all with arguments": {
+			spfValue:      dnstypes.NewSPFRecordValue("v=spf1 -all:example.com"),
+			expectedError: "SPF record \"v=spf1 -all:example.com\": mechanism \"-all:example.com\" must not have arguments",
+		},
+		"invalid - include without domain": {
+			spfValue:      dnstypes.NewSPFRecordValue("v=spf1 include -all"),
+			expectedError: "SPF record \"v=spf1 include -all\": mechanism \"include\" must have a domain",
+		},
+		"invalid - include domain": {
+			spfValue:      dnstypes.NewSPFRecordValue("v=spf1 include:example -all"),
+			expectedError: "SPF record \"v=spf1 include:example -all\": mechanism \"include:example\": domain name \"example\": must contain at least 2 labels",
+		},
+		"invalid - ip4 with IPv6 network": {
+			spfValue:      dnstypes.NewSPFRecordValue("v=spf1 ip4:2001:db8::/32 -all"),
+			expectedError: "SPF record \"v=spf1 ip4:2001:db8::/32 -all\": mechanism \"ip4:2001:db8::/32\": network \"2001:db8::/32\" must be an IPv4 address or CIDR",
+		},
+		"invalid - ip6 with IPv4 network": {
+			spfValue:      dnstypes.NewSPFRecordValue("v=spf1 ip6:192.0.2.0/24 -all"),
+			expectedError: "SPF record \"v=spf1 ip6:192.0.2.0/24 -all\": mechanism \"ip6:192.0.2.0/24\": network \"192.0.2.0/24\" must be an IPv6 address or CIDR",
+		},
+		"invalid - ip4 prefix length": {
+			spfValue:      dnstypes.NewSPFRecordValue("v=spf1 ip4:192.0.2.0/33 -all"),
+			expectedError: "SPF record \"v=spf1 ip4:192.0.2.0/33 -all\": mechanism \"ip4:192.0.2.0/33\": netip.ParsePrefix(\"192.0.2.0/33\"): prefix length out of range",
+		},
+		"invalid - ip4 prefix length with leading zero": {
+			spfValue:      dnstypes.NewSPFRecordValue("v=spf1 ip4:192.0.2.0/024 -all"),
+			expectedError: "SPF record \"v=spf1 ip4:192.0.2.0/024 -all\": mechanism \"ip4:192.0.2.0/024\": prefix length \"024\" must not have leading zeros",
+		},
+		"invalid - a prefix length": {
+			spfValue:      dnstypes.NewSPFRecordValue("v=spf1 a/33 -all"),
+			expectedError: "SPF record \"v=spf1 a/33 -all\": mechanism \"a/33\": prefix length \"33\" must be between 0 and 32",
+		},
+		"invalid - mx IPv6 prefix length": {
+			spfValue:      dnstypes.NewSPFRecordValue("v=spf1 mx//129 -all"),
+			expectedError: "SPF record \"v=spf1 mx//129 -all\": mechanism \"mx//129\": prefix length \"129\" must be between 0 and 128",
+		},
+		"invalid - macro letter": {
+			spfValue:      dnstypes.NewSPFRecordValue("v=spf1 exists:%{x}.example.com -all"),
+			expectedError: "SPF record \"v=spf1 exists:%{x}.example.com -all\": mechanism \"exists:%{x}.example.com\": macro %{x}: macro letter \"x\" must be one of s, l, o, d, i, p, h or v",
+		},
+		"invalid - explanation macro letter": {
+			spfValue:      dnstypes.NewSPFRecordValue("v=spf1 exists:%{c}.example.com -all"),
+			expectedError: "SPF record \"v=spf1 exists:%{c}.example.com -all\": mechanism \"exists:%{c}.example.com\": macro %{c}: macro letter \"c\" is only allowed in explanation strings",
+		},
+		"invalid - unterminated macro": {
+			spfValue:      dnstypes.NewSPFRecordValue("v=spf1 exists:%{i.example.com -all"),
+			expectedError: "SPF record \"v=spf1 exists:%{i.example.com -all\": mechanism \"exists:%{i.example.com\": macro must end with }",
+		},
+		"invalid - duplicate redirect": {
+			spfValue:      dnstypes.NewSPFRecordValue("v=spf1 redirect=a.example.com redirect=b.example.com"),
+			expectedError: "SPF record \"v=spf1 redirect=a.example.com redirect=b.example.com\": modifier \"redirect\" must not appear more than once",
+		},
+		"invalid - too many DNS lookups": {
+			spfValue:      dnstypes.NewSPFRecordValue("v=spf1 a mx ptr exists:%{i}.example.com include:a.example.com include:b.example.com include:c.example.com include:d.example.com include:e.example.com include:f.example.com redirect=g.example.com"),
+			expectedError: "SPF record \"v=spf1 a mx ptr exists:%{i}.example.com include:a.example.com include:b.example.com include:c.example.com include:d.example.com include:e.example.com include:f.example.com redirect=g.example.com\": must not contain more than 10 mechanisms and modifiers that cause DNS lookups, got 11",
+		},
+		"invalid - quoting": {
+			spfValue:      dnstypes.NewSPFRecordValue(`"v=spf1 -all`),
+			expectedError: "SPF record \"\\\"v=spf1 -all\": quoted field is missing its closing quote",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var expectedDiags diag.Diagnostics
+
+			if testCase.expectedError != "" {
+				expectedDiags.AddAttributeError(
+					path.Root("test"),
+					"Invalid SPF Record String Value",
+					"A string value was provided that is not valid SPF record string format (RFC 7208).\n\n"+
+						"Given Value: "+testCase.spfValue.ValueString()+"\n"+
+						"Error: "+testCase.expectedError,
+				)
+			}
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.spfValue.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{Path: path.Root("test")},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSPFRecordValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		spfValue        dnstypes.SPFRecord
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			spfValue: dnstypes.SPFRecord{},
+		},
+		"null": {
+			spfValue: dnstypes.NewSPFRecordNull(),
+		},
+		"unknown": {
+			spfValue: dnstypes.NewSPFRecordUnknown(),
+		},
+		"valid": {
+			spfValue: dnstypes.NewSPFRecordValue("v=spf1 ip4:192.0.2.0/24 include:_spf.example.com -all"),
+		},
+		"invalid": {
+			spfValue: dnstypes.NewSPFRecordValue("v=spf1 ip4:192.0.2.0/33 -all"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid SPF Record String Value: "+
+					"A string value was provided that is not valid SPF record string format (RFC 7208).\n\n"+
+					"Given Value: "+"v=spf1 ip4:192.0.2.0/33 -all"+"\n"+
+					"Error: "+"SPF record \"v=spf1 ip4:192.0.2.0/33 -all\": mechanism \"ip4:192.0.2.0/33\": netip.ParsePrefix(\"192.0.2.0/33\"): prefix length out of range",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.spfValue.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSPFRecordValueSPF(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		spfValue      dnstypes.SPFRecord
+		expectedSPF   dnstypes.SPF
+		expectedDiags diag.Diagnostics
+	}{
+		"SPF record value is null": {
+			spfValue: dnstypes.NewSPFRecordNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"SPFRecord ValueSPF Error",
+					"SPF record string value is null",
+				),
+			},
+		},
+		"SPF record value is unknown": {
+			spfValue: dnstypes.NewSPFRecordUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"SPFRecord ValueSPF Error",
+					"SPF record string value is unknown",
+				),
+			},
+		},
+		"valid": {
+			spfValue: dnstypes.NewSPFRecordValue("v=spf1 MX/24 ip4:192.0.2.1/24 ~ip6:2001:db8::1 include:_SPF.example.com. redirect=_spf.example.net -all"),
+			expectedSPF: dnstypes.SPF{
+				Directives: []dnstypes.SPFDirective{
+					{Qualifier: dnstypes.SPFQualifierPass, Mechanism: "mx", IPv4PrefixLength: 24, IPv6PrefixLength: 128},
+					{Qualifier: dnstypes.SPFQualifierPass, Mechanism: "ip4", Prefix: netip.MustParsePrefix("192.0.2.0/24")},
+					{Qualifier: dnstypes.SPFQualifierSoftFail, Mechanism: "ip6", Prefix: netip.MustParsePrefix("2001:db8::1/128")},
+					{Qualifier: dnstypes.SPFQualifierPass, Mechanism: "include", DomainSpec: "_spf.example.com"},
+					{Qualifier: dnstypes.SPFQualifierFail, Mechanism: "all"},
+				},
+				Modifiers: []dnstypes.SPFModifier{
+					{Name: "redirect", Value: "_spf.example.net"},
+				},
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			record, diags := testCase.spfValue.ValueSPF()
+
+			if diff := cmp.Diff(record, testCase.expectedSPF, cmp.Comparer(func(x, y netip.Prefix) bool { return x == y })); diff != "" {
+				t.Errorf("Unexpected difference in SPF record (-got, +expected): %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
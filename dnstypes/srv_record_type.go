@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = (*SRVRecordType)(nil)
+
+// SRVRecordType is an attribute type that represents valid SRV record RDATA in presentation format, which is a 16-bit
+// priority, weight and port followed by a target hostname, such as `10 60 5060 sip.example.com.`. A target of "."
+// indicates that the service is not available. Semantic equality logic is defined for SRVRecordType, so that
+// whitespace, letter case and a trailing dot on the target are ignored.
+//
+// All of the following are semantically equal:
+//   - 10 60 5060 sip.example.com.
+//   - 10 60 5060 sip.example.com
+//   - 10	60	5060	SIP.example.com.
+type SRVRecordType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t SRVRecordType) String() string {
+	return "dnstypes.SRVRecordType"
+}
+
+// ValueType returns the Value type.
+func (t SRVRecordType) ValueType(ctx context.Context) attr.Value {
+	return SRVRecord{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t SRVRecordType) Equal(o attr.Type) bool {
+	other, ok := o.(SRVRecordType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t SRVRecordType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return SRVRecord{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t SRVRecordType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
@@ -0,0 +1,237 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*SRVRecord)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*SRVRecord)(nil)
+	_ xattr.ValidateableAttribute                = (*SRVRecord)(nil)
+	_ function.ValidateableParameter             = (*SRVRecord)(nil)
+)
+
+// SRVRecord represents valid SRV record RDATA in presentation format, which is a 16-bit priority, weight and port
+// followed by a target hostname, such as `10 60 5060 sip.example.com.`. A target of "." indicates that the service
+// is not available. Semantic equality logic is defined for SRVRecord, so that whitespace, letter case and a trailing
+// dot on the target are ignored.
+//
+// All of the following are semantically equal:
+//   - 10 60 5060 sip.example.com.
+//   - 10 60 5060 sip.example.com
+//   - 10	60	5060	SIP.example.com.
+type SRVRecord struct {
+	basetypes.StringValue
+}
+
+// Type returns an SRVRecordType.
+func (v SRVRecord) Type(_ context.Context) attr.Type {
+	return SRVRecordType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v SRVRecord) Equal(o attr.Value) bool {
+	other, ok := o.(SRVRecord)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given SRV record string value is semantically equal to the current SRV
+// record string value. This comparison uses the canonical presentation format of both values, so that whitespace,
+// letter case and a trailing dot on the target are ignored.
+//
+// All of the following are semantically equal:
+//   - 10 60 5060 sip.example.com.
+//   - 10 60 5060 sip.example.com
+//   - 10	60	5060	SIP.example.com.
+func (v SRVRecord) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(SRVRecord)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// SRV records are already validated at this point, ignoring errors
+	newSRV, _ := parseSRV(newValue.ValueString())
+	currentSRV, _ := parseSRV(v.ValueString())
+
+	return currentSRV.String() == newSRV.String(), diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String
+// value that is valid SRV record RDATA, with a 16-bit priority, weight and port and a valid target hostname.
+func (v SRVRecord) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseSRV(v.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid SRV Record String Value",
+			"A string value was provided that is not valid SRV record string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is valid SRV record RDATA, with a 16-bit priority, weight and port and a valid
+// target hostname.
+func (v SRVRecord) ValidateParameter(_ context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseSRV(v.ValueString())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid SRV Record String Value: "+
+				"A string value was provided that is not valid SRV record string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueSRV parses the SRVRecord StringValue into its priority, weight, port and target, with the target in lowercase
+// with a trailing dot. A null or unknown value will produce an error diagnostic.
+func (v SRVRecord) ValueSRV() (SRV, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("SRVRecord ValueSRV Error", "SRV record string value is null"))
+		return SRV{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("SRVRecord ValueSRV Error", "SRV record string value is unknown"))
+		return SRV{}, diags
+	}
+
+	record, err := parseSRV(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("SRVRecord ValueSRV Error", err.Error()))
+		return SRV{}, diags
+	}
+
+	return record, nil
+}
+
+// SRV is the parsed RDATA of an SRV record (RFC 2782).
+type SRV struct {
+	// Priority is the priority of the target host, where lower values are preferred.
+	Priority uint16
+
+	// Weight is the relative weight for target hosts with the same priority.
+	Weight uint16
+
+	// Port is the port of the service on the target host.
+	Port uint16
+
+	// Target is the target hostname in lowercase with a trailing dot, or "." if the service is not available.
+	Target string
+}
+
+// String returns the SRV RDATA in canonical presentation format, such as `10 60 5060 sip.example.com.`.
+func (r SRV) String() string {
+	return fmt.Sprintf("%d %d %d %s", r.Priority, r.Weight, r.Port, r.Target)
+}
+
+// parseSRV parses SRV RDATA in presentation format.
+func parseSRV(s string) (SRV, error) {
+	record, err := parseSRVFields(s)
+	if err != nil {
+		return SRV{}, fmt.Errorf("SRV record %q: %w", s, err)
+	}
+
+	return record, nil
+}
+
+// parseSRVFields parses the priority, weight, port and target fields of SRV RDATA.
+func parseSRVFields(s string) (SRV, error) {
+	tokens, err := splitRDATA(s)
+	if err != nil {
+		return SRV{}, err
+	}
+
+	if len(tokens) != 4 {
+		return SRV{}, fmt.Errorf("must contain priority, weight, port and target fields, got %d fields", len(tokens))
+	}
+
+	var numbers [3]uint16
+
+	for i, field := range []string{"priority", "weight", "port"} {
+		number, err := parseRDATAUint(tokens[i], field, 16)
+		if err != nil {
+			return SRV{}, err
+		}
+
+		numbers[i] = uint16(number)
+	}
+
+	target, err := parseRDATADomainName(tokens[3], "target", true)
+	if err != nil {
+		return SRV{}, err
+	}
+
+	return SRV{Priority: numbers[0], Weight: numbers[1], Port: numbers[2], Target: target}, nil
+}
+
+// NewSRVRecordNull creates an SRVRecord with a null value. Determine whether the value is null via IsNull method.
+func NewSRVRecordNull() SRVRecord {
+	return SRVRecord{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewSRVRecordUnknown creates an SRVRecord with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewSRVRecordUnknown() SRVRecord {
+	return SRVRecord{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewSRVRecordValue creates an SRVRecord with a known value. Access the value via ValueString method.
+func NewSRVRecordValue(value string) SRVRecord {
+	return SRVRecord{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewSRVRecordPointerValue creates an SRVRecord with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewSRVRecordPointerValue(value *string) SRVRecord {
+	return SRVRecord{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
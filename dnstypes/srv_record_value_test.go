@@ -0,0 +1,255 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/dnstypes"
+)
+
+func TestSRVRecordStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentSRV    dnstypes.SRVRecord
+		givenSRV      basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"semantically equal - byte-for-byte match": {
+			currentSRV:    dnstypes.NewSRVRecordValue("10 60 5060 sip.example.com."),
+			givenSRV:      dnstypes.NewSRVRecordValue("10 60 5060 sip.example.com."),
+			expectedMatch: true,
+		},
+		"semantically equal - missing trailing dot": {
+			currentSRV:    dnstypes.NewSRVRecordValue("10 60 5060 sip.example.com."),
+			givenSRV:      dnstypes.NewSRVRecordValue("10 60 5060 sip.example.com"),
+			expectedMatch: true,
+		},
+		"semantically equal - letter case and whitespace": {
+			currentSRV:    dnstypes.NewSRVRecordValue("10 60 5060 sip.example.com."),
+			givenSRV:      dnstypes.NewSRVRecordValue("10  60  5060  SIP.example.COM."),
+			expectedMatch: true,
+		},
+		"not equal - different weight": {
+			currentSRV:    dnstypes.NewSRVRecordValue("10 60 5060 sip.example.com."),
+			givenSRV:      dnstypes.NewSRVRecordValue("10 40 5060 sip.example.com."),
+			expectedMatch: false,
+		},
+		"not equal - different port": {
+			currentSRV:    dnstypes.NewSRVRecordValue("10 60 5060 sip.example.com."),
+			givenSRV:      dnstypes.NewSRVRecordValue("10 60 5061 sip.example.com."),
+			expectedMatch: false,
+		},
+		"error - not given SRVRecord value": {
+			currentSRV:    dnstypes.NewSRVRecordValue("10 60 5060 sip.example.com."),
+			givenSRV:      basetypes.NewStringValue("10 60 5060 sip.example.com."),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: dnstypes.SRVRecord\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentSRV.StringSemanticEquals(context.Background(), testCase.givenSRV)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSRVRecordValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		srvValue      dnstypes.SRVRecord
+		expectedError string
+	}{
+		"empty-struct": {
+			srvValue: dnstypes.SRVRecord{},
+		},
+		"null": {
+			srvValue: dnstypes.NewSRVRecordNull(),
+		},
+		"unknown": {
+			srvValue: dnstypes.NewSRVRecordUnknown(),
+		},
+		"valid": {
+			srvValue: dnstypes.NewSRVRecordValue("10 60 5060 sip.example.com."),
+		},
+		"valid - service not available": {
+			srvValue: dnstypes.NewSRVRecordValue("0 0 0 ."),
+		},
+		"invalid - missing target": {
+			srvValue:      dnstypes.NewSRVRecordValue("10 60 5060"),
+			expectedError: "SRV record \"10 60 5060\": must contain priority, weight, port and target fields, got 3 fields",
+		},
+		"invalid - port out of range": {
+			srvValue:      dnstypes.NewSRVRecordValue("10 60 65536 sip.example.com."),
+			expectedError: "SRV record \"10 60 65536 sip.example.com.\": port \"65536\" must be an unsigned 16-bit decimal integer",
+		},
+		"invalid - weight not a number": {
+			srvValue:      dnstypes.NewSRVRecordValue("10 high 5060 sip.example.com."),
+			expectedError: "SRV record \"10 high 5060 sip.example.com.\": weight \"high\" must be an unsigned 16-bit decimal integer",
+		},
+		"invalid - target with empty label": {
+			srvValue:      dnstypes.NewSRVRecordValue("10 60 5060 sip..example.com."),
+			expectedError: "SRV record \"10 60 5060 sip..example.com.\": target: domain name \"sip..example.com.\": must not contain empty labels",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var expectedDiags diag.Diagnostics
+
+			if testCase.expectedError != "" {
+				expectedDiags.AddAttributeError(
+					path.Root("test"),
+					"Invalid SRV Record String Value",
+					"A string value was provided that is not valid SRV record string format.\n\n"+
+						"Given Value: "+testCase.srvValue.ValueString()+"\n"+
+						"Error: "+testCase.expectedError,
+				)
+			}
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.srvValue.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{Path: path.Root("test")},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSRVRecordValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		srvValue        dnstypes.SRVRecord
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			srvValue: dnstypes.SRVRecord{},
+		},
+		"null": {
+			srvValue: dnstypes.NewSRVRecordNull(),
+		},
+		"unknown": {
+			srvValue: dnstypes.NewSRVRecordUnknown(),
+		},
+		"valid": {
+			srvValue: dnstypes.NewSRVRecordValue("10 60 5060 sip.example.com."),
+		},
+		"invalid": {
+			srvValue: dnstypes.NewSRVRecordValue("10 60 5060 *.example.com."),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid SRV Record String Value: "+
+					"A string value was provided that is not valid SRV record string format.\n\n"+
+					"Given Value: "+"10 60 5060 *.example.com."+"\n"+
+					"Error: "+"SRV record \"10 60 5060 *.example.com.\": target: domain name \"*.example.com.\": label \"*\" must only contain letters, digits and hyphens, and must not start or end with a hyphen",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.srvValue.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSRVRecordValueSRV(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		srvValue      dnstypes.SRVRecord
+		expectedSRV   dnstypes.SRV
+		expectedDiags diag.Diagnostics
+	}{
+		"SRV record value is null": {
+			srvValue: dnstypes.NewSRVRecordNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"SRVRecord ValueSRV Error",
+					"SRV record string value is null",
+				),
+			},
+		},
+		"SRV record value is unknown": {
+			srvValue: dnstypes.NewSRVRecordUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"SRVRecord ValueSRV Error",
+					"SRV record string value is unknown",
+				),
+			},
+		},
+		"valid": {
+			srvValue:    dnstypes.NewSRVRecordValue("10 60 5060 SIP.example.com"),
+			expectedSRV: dnstypes.SRV{Priority: 10, Weight: 60, Port: 5060, Target: "sip.example.com."},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			record, diags := testCase.srvValue.ValueSRV()
+
+			if diff := cmp.Diff(record, testCase.expectedSRV); diff != "" {
+				t.Errorf("Unexpected difference in SRV record (-got, +expected): %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = (*SSHFPRecordType)(nil)
+
+// SSHFPRecordType is an attribute type that represents valid SSHFP record RDATA in presentation format, which is an
+// 8-bit algorithm and fingerprint type followed by a hexadecimal fingerprint, such as `4 2
+// 9963C1749CDF7820E4A8CF57CFCA4FFC47F5E0B3C6E08A19A37F5C0388019025`. The SHA-1 and SHA-256 fingerprint types are
+// supported. Semantic equality logic is defined for SSHFPRecordType, so that whitespace and letter case of the
+// fingerprint are ignored.
+//
+// All of the following are semantically equal:
+//   - 4 2 9963C1749CDF7820E4A8CF57CFCA4FFC47F5E0B3C6E08A19A37F5C0388019025
+//   - 4 2 9963c1749cdf7820e4a8cf57cfca4ffc47f5e0b3c6e08a19a37f5c0388019025
+//   - 4 2 9963c1749cdf7820e4a8cf57cfca4ffc 47f5e0b3c6e08a19a37f5c0388019025
+type SSHFPRecordType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t SSHFPRecordType) String() string {
+	return "dnstypes.SSHFPRecordType"
+}
+
+// ValueType returns the Value type.
+func (t SSHFPRecordType) ValueType(ctx context.Context) attr.Value {
+	return SSHFPRecord{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t SSHFPRecordType) Equal(o attr.Type) bool {
+	other, ok := o.(SSHFPRecordType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t SSHFPRecordType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return SSHFPRecord{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t SSHFPRecordType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
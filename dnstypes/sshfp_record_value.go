@@ -0,0 +1,256 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*SSHFPRecord)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*SSHFPRecord)(nil)
+	_ xattr.ValidateableAttribute                = (*SSHFPRecord)(nil)
+	_ function.ValidateableParameter             = (*SSHFPRecord)(nil)
+)
+
+// SSHFPRecord represents valid SSHFP record RDATA in presentation format, which is an 8-bit algorithm and
+// fingerprint type followed by a hexadecimal fingerprint, such as
+// `4 2 9963C1749CDF7820E4A8CF57CFCA4FFC47F5E0B3C6E08A19A37F5C0388019025`. The SHA-1 and SHA-256 fingerprint types
+// are supported. Semantic equality logic is defined for SSHFPRecord, so that whitespace and letter case of the
+// fingerprint are ignored.
+//
+// All of the following are semantically equal:
+//   - 4 2 9963C1749CDF7820E4A8CF57CFCA4FFC47F5E0B3C6E08A19A37F5C0388019025
+//   - 4 2 9963c1749cdf7820e4a8cf57cfca4ffc47f5e0b3c6e08a19a37f5c0388019025
+//   - 4 2 9963c1749cdf7820e4a8cf57cfca4ffc 47f5e0b3c6e08a19a37f5c0388019025
+type SSHFPRecord struct {
+	basetypes.StringValue
+}
+
+// Type returns an SSHFPRecordType.
+func (v SSHFPRecord) Type(_ context.Context) attr.Type {
+	return SSHFPRecordType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v SSHFPRecord) Equal(o attr.Value) bool {
+	other, ok := o.(SSHFPRecord)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given SSHFP record string value is semantically equal to the current
+// SSHFP record string value. This comparison uses the canonical presentation format of both values, so that
+// whitespace and letter case of the fingerprint are ignored.
+//
+// All of the following are semantically equal:
+//   - 4 2 9963C1749CDF7820E4A8CF57CFCA4FFC47F5E0B3C6E08A19A37F5C0388019025
+//   - 4 2 9963c1749cdf7820e4a8cf57cfca4ffc47f5e0b3c6e08a19a37f5c0388019025
+//   - 4 2 9963c1749cdf7820e4a8cf57cfca4ffc 47f5e0b3c6e08a19a37f5c0388019025
+func (v SSHFPRecord) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(SSHFPRecord)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// SSHFP records are already validated at this point, ignoring errors
+	newSSHFP, _ := parseSSHFP(newValue.ValueString())
+	currentSSHFP, _ := parseSSHFP(v.ValueString())
+
+	return currentSSHFP.String() == newSSHFP.String(), diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String
+// value that is valid SSHFP record RDATA, with a non-zero algorithm and a SHA-1 or SHA-256 fingerprint of the
+// correct length.
+func (v SSHFPRecord) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseSSHFP(v.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid SSHFP Record String Value",
+			"A string value was provided that is not valid SSHFP record string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is valid SSHFP record RDATA, with a non-zero algorithm and a SHA-1 or SHA-256
+// fingerprint of the correct length.
+func (v SSHFPRecord) ValidateParameter(_ context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseSSHFP(v.ValueString())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid SSHFP Record String Value: "+
+				"A string value was provided that is not valid SSHFP record string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueSSHFP parses the SSHFPRecord StringValue into its algorithm, fingerprint type and decoded fingerprint. A null
+// or unknown value will produce an error diagnostic.
+func (v SSHFPRecord) ValueSSHFP() (SSHFP, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("SSHFPRecord ValueSSHFP Error", "SSHFP record string value is null"))
+		return SSHFP{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("SSHFPRecord ValueSSHFP Error", "SSHFP record string value is unknown"))
+		return SSHFP{}, diags
+	}
+
+	record, err := parseSSHFP(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("SSHFPRecord ValueSSHFP Error", err.Error()))
+		return SSHFP{}, diags
+	}
+
+	return record, nil
+}
+
+// SSHFP is the parsed RDATA of an SSHFP record (RFC 4255 Section 3.1).
+type SSHFP struct {
+	// Algorithm is the SSH public key algorithm number, such as 4 for Ed25519.
+	Algorithm uint8
+
+	// Type is the fingerprint type number, 1 for SHA-1 or 2 for SHA-256.
+	Type uint8
+
+	// Fingerprint is the decoded fingerprint digest.
+	Fingerprint []byte
+}
+
+// String returns the SSHFP RDATA in canonical presentation format with an uppercase hexadecimal fingerprint.
+func (r SSHFP) String() string {
+	return fmt.Sprintf("%d %d %X", r.Algorithm, r.Type, r.Fingerprint)
+}
+
+// sshfpFingerprintLengths maps the fingerprint types of the IANA SSHFP RR Types for Fingerprint Types registry to
+// their digest length in bytes.
+var sshfpFingerprintLengths = map[uint64]int{
+	1: 20, // SHA-1 (RFC 4255)
+	2: 32, // SHA-256 (RFC 6594)
+}
+
+// parseSSHFP parses SSHFP RDATA in presentation format.
+func parseSSHFP(s string) (SSHFP, error) {
+	record, err := parseSSHFPFields(s)
+	if err != nil {
+		return SSHFP{}, fmt.Errorf("SSHFP record %q: %w", s, err)
+	}
+
+	return record, nil
+}
+
+// parseSSHFPFields parses the algorithm, fingerprint type and fingerprint fields of SSHFP RDATA.
+func parseSSHFPFields(s string) (SSHFP, error) {
+	tokens, err := splitRDATA(s)
+	if err != nil {
+		return SSHFP{}, err
+	}
+
+	if len(tokens) < 3 {
+		return SSHFP{}, fmt.Errorf("must contain algorithm, fingerprint type and fingerprint fields, got %d fields", len(tokens))
+	}
+
+	algorithm, err := parseRDATAUint(tokens[0], "algorithm", 8)
+	if err != nil {
+		return SSHFP{}, err
+	}
+
+	if algorithm == 0 {
+		return SSHFP{}, errors.New("algorithm 0 is reserved")
+	}
+
+	fingerprintType, err := parseRDATAUint(tokens[1], "fingerprint type", 8)
+	if err != nil {
+		return SSHFP{}, err
+	}
+
+	length, ok := sshfpFingerprintLengths[fingerprintType]
+	if !ok {
+		return SSHFP{}, fmt.Errorf("fingerprint type %d must be 1 (SHA-1) or 2 (SHA-256)", fingerprintType)
+	}
+
+	fingerprint, err := parseRDATAHex(tokens[2:], "fingerprint")
+	if err != nil {
+		return SSHFP{}, err
+	}
+
+	if len(fingerprint) != length {
+		return SSHFP{}, fmt.Errorf("fingerprint type %d must be %d bytes, got %d", fingerprintType, length, len(fingerprint))
+	}
+
+	return SSHFP{Algorithm: uint8(algorithm), Type: uint8(fingerprintType), Fingerprint: fingerprint}, nil
+}
+
+// NewSSHFPRecordNull creates an SSHFPRecord with a null value. Determine whether the value is null via IsNull method.
+func NewSSHFPRecordNull() SSHFPRecord {
+	return SSHFPRecord{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewSSHFPRecordUnknown creates an SSHFPRecord with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewSSHFPRecordUnknown() SSHFPRecord {
+	return SSHFPRecord{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewSSHFPRecordValue creates an SSHFPRecord with a known value. Access the value via ValueString method.
+func NewSSHFPRecordValue(value string) SSHFPRecord {
+	return SSHFPRecord{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewSSHFPRecordPointerValue creates an SSHFPRecord with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewSSHFPRecordPointerValue(value *string) SSHFPRecord {
+	return SSHFPRecord{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
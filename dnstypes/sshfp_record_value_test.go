@@ -0,0 +1,263 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/dnstypes"
+)
+
+func TestSSHFPRecordStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentSSHFP  dnstypes.SSHFPRecord
+		givenSSHFP    basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"semantically equal - byte-for-byte match": {
+			currentSSHFP:  dnstypes.NewSSHFPRecordValue("4 2 9963C1749CDF7820E4A8CF57CFCA4FFC47F5E0B3C6E08A19A37F5C0388019025"),
+			givenSSHFP:    dnstypes.NewSSHFPRecordValue("4 2 9963C1749CDF7820E4A8CF57CFCA4FFC47F5E0B3C6E08A19A37F5C0388019025"),
+			expectedMatch: true,
+		},
+		"semantically equal - letter case": {
+			currentSSHFP:  dnstypes.NewSSHFPRecordValue("4 2 9963C1749CDF7820E4A8CF57CFCA4FFC47F5E0B3C6E08A19A37F5C0388019025"),
+			givenSSHFP:    dnstypes.NewSSHFPRecordValue("4 2 9963c1749cdf7820e4a8cf57cfca4ffc47f5e0b3c6e08a19a37f5c0388019025"),
+			expectedMatch: true,
+		},
+		"semantically equal - split fingerprint": {
+			currentSSHFP:  dnstypes.NewSSHFPRecordValue("4 2 9963C1749CDF7820E4A8CF57CFCA4FFC47F5E0B3C6E08A19A37F5C0388019025"),
+			givenSSHFP:    dnstypes.NewSSHFPRecordValue("4 2 9963c1749cdf7820e4a8cf57cfca4ffc 47f5e0b3c6e08a19a37f5c0388019025"),
+			expectedMatch: true,
+		},
+		"not equal - different algorithm": {
+			currentSSHFP:  dnstypes.NewSSHFPRecordValue("4 2 9963C1749CDF7820E4A8CF57CFCA4FFC47F5E0B3C6E08A19A37F5C0388019025"),
+			givenSSHFP:    dnstypes.NewSSHFPRecordValue("1 2 9963C1749CDF7820E4A8CF57CFCA4FFC47F5E0B3C6E08A19A37F5C0388019025"),
+			expectedMatch: false,
+		},
+		"not equal - different fingerprint type": {
+			currentSSHFP:  dnstypes.NewSSHFPRecordValue("4 1 aaf4c61ddcc5e8a2dabede0f3b482cd9aea9434d"),
+			givenSSHFP:    dnstypes.NewSSHFPRecordValue("4 2 9963C1749CDF7820E4A8CF57CFCA4FFC47F5E0B3C6E08A19A37F5C0388019025"),
+			expectedMatch: false,
+		},
+		"error - not given SSHFPRecord value": {
+			currentSSHFP:  dnstypes.NewSSHFPRecordValue("4 2 9963C1749CDF7820E4A8CF57CFCA4FFC47F5E0B3C6E08A19A37F5C0388019025"),
+			givenSSHFP:    basetypes.NewStringValue("4 2 9963C1749CDF7820E4A8CF57CFCA4FFC47F5E0B3C6E08A19A37F5C0388019025"),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: dnstypes.SSHFPRecord\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentSSHFP.StringSemanticEquals(context.Background(), testCase.givenSSHFP)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSSHFPRecordValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		sshfpValue    dnstypes.SSHFPRecord
+		expectedError string
+	}{
+		"empty-struct": {
+			sshfpValue: dnstypes.SSHFPRecord{},
+		},
+		"null": {
+			sshfpValue: dnstypes.NewSSHFPRecordNull(),
+		},
+		"unknown": {
+			sshfpValue: dnstypes.NewSSHFPRecordUnknown(),
+		},
+		"valid - SHA-1": {
+			sshfpValue: dnstypes.NewSSHFPRecordValue("1 1 aaf4c61ddcc5e8a2dabede0f3b482cd9aea9434d"),
+		},
+		"valid - SHA-256": {
+			sshfpValue: dnstypes.NewSSHFPRecordValue("4 2 9963c1749cdf7820e4a8cf57cfca4ffc47f5e0b3c6e08a19a37f5c0388019025"),
+		},
+		"invalid - missing fingerprint": {
+			sshfpValue:    dnstypes.NewSSHFPRecordValue("4 2"),
+			expectedError: "SSHFP record \"4 2\": must contain algorithm, fingerprint type and fingerprint fields, got 2 fields",
+		},
+		"invalid - reserved algorithm": {
+			sshfpValue:    dnstypes.NewSSHFPRecordValue("0 2 9963c1749cdf7820e4a8cf57cfca4ffc47f5e0b3c6e08a19a37f5c0388019025"),
+			expectedError: "SSHFP record \"0 2 9963c1749cdf7820e4a8cf57cfca4ffc47f5e0b3c6e08a19a37f5c0388019025\": algorithm 0 is reserved",
+		},
+		"invalid - unknown fingerprint type": {
+			sshfpValue:    dnstypes.NewSSHFPRecordValue("4 3 9963c1749cdf7820e4a8cf57cfca4ffc47f5e0b3c6e08a19a37f5c0388019025"),
+			expectedError: "SSHFP record \"4 3 9963c1749cdf7820e4a8cf57cfca4ffc47f5e0b3c6e08a19a37f5c0388019025\": fingerprint type 3 must be 1 (SHA-1) or 2 (SHA-256)",
+		},
+		"invalid - fingerprint length": {
+			sshfpValue:    dnstypes.NewSSHFPRecordValue("4 2 aaf4c61ddcc5e8a2dabede0f3b482cd9aea9434d"),
+			expectedError: "SSHFP record \"4 2 aaf4c61ddcc5e8a2dabede0f3b482cd9aea9434d\": fingerprint type 2 must be 32 bytes, got 20",
+		},
+		"invalid - fingerprint not hexadecimal": {
+			sshfpValue:    dnstypes.NewSSHFPRecordValue("4 2 9963c1749cdf7820e4a8cf57cfca4ffc47f5e0b3c6e08a19a37f5c038801902g"),
+			expectedError: "SSHFP record \"4 2 9963c1749cdf7820e4a8cf57cfca4ffc47f5e0b3c6e08a19a37f5c038801902g\": fingerprint must be a non-empty even number of hexadecimal digits",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var expectedDiags diag.Diagnostics
+
+			if testCase.expectedError != "" {
+				expectedDiags.AddAttributeError(
+					path.Root("test"),
+					"Invalid SSHFP Record String Value",
+					"A string value was provided that is not valid SSHFP record string format.\n\n"+
+						"Given Value: "+testCase.sshfpValue.ValueString()+"\n"+
+						"Error: "+testCase.expectedError,
+				)
+			}
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.sshfpValue.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{Path: path.Root("test")},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSSHFPRecordValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		sshfpValue      dnstypes.SSHFPRecord
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			sshfpValue: dnstypes.SSHFPRecord{},
+		},
+		"null": {
+			sshfpValue: dnstypes.NewSSHFPRecordNull(),
+		},
+		"unknown": {
+			sshfpValue: dnstypes.NewSSHFPRecordUnknown(),
+		},
+		"valid": {
+			sshfpValue: dnstypes.NewSSHFPRecordValue("4 2 9963C1749CDF7820E4A8CF57CFCA4FFC47F5E0B3C6E08A19A37F5C0388019025"),
+		},
+		"invalid": {
+			sshfpValue: dnstypes.NewSSHFPRecordValue("4 1 9963c1749cdf7820e4a8cf57cfca4ffc47f5e0b3c6e08a19a37f5c0388019025"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid SSHFP Record String Value: "+
+					"A string value was provided that is not valid SSHFP record string format.\n\n"+
+					"Given Value: "+"4 1 9963c1749cdf7820e4a8cf57cfca4ffc47f5e0b3c6e08a19a37f5c0388019025"+"\n"+
+					"Error: "+"SSHFP record \"4 1 9963c1749cdf7820e4a8cf57cfca4ffc47f5e0b3c6e08a19a37f5c0388019025\": fingerprint type 1 must be 20 bytes, got 32",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.sshfpValue.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSSHFPRecordValueSSHFP(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		sshfpValue    dnstypes.SSHFPRecord
+		expectedSSHFP dnstypes.SSHFP
+		expectedDiags diag.Diagnostics
+	}{
+		"SSHFP record value is null": {
+			sshfpValue: dnstypes.NewSSHFPRecordNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"SSHFPRecord ValueSSHFP Error",
+					"SSHFP record string value is null",
+				),
+			},
+		},
+		"SSHFP record value is unknown": {
+			sshfpValue: dnstypes.NewSSHFPRecordUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"SSHFPRecord ValueSSHFP Error",
+					"SSHFP record string value is unknown",
+				),
+			},
+		},
+		"valid": {
+			sshfpValue: dnstypes.NewSSHFPRecordValue("1 1 aaf4c61ddcc5e8a2dabede0f3b482cd9aea9434d"),
+			expectedSSHFP: dnstypes.SSHFP{Algorithm: 1, Type: 1, Fingerprint: []byte{
+				0xaa, 0xf4, 0xc6, 0x1d, 0xdc, 0xc5, 0xe8, 0xa2,
+				0xda, 0xbe, 0xde, 0x0f, 0x3b, 0x48, 0x2c, 0xd9,
+				0xae, 0xa9, 0x43, 0x4d,
+			}},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			record, diags := testCase.sshfpValue.ValueSSHFP()
+
+			if diff := cmp.Diff(record, testCase.expectedSSHFP); diff != "" {
+				t.Errorf("Unexpected difference in SSHFP record (-got, +expected): %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
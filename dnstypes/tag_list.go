@@ -0,0 +1,123 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// tagSpec is a single tag=value pair of a tag list, with surrounding whitespace removed.
+type tagSpec struct {
+	name  string
+	value string
+}
+
+// parseTagList parses a tag list (RFC 6376 Section 3.2), which is a semicolon separated list of tag=value pairs with
+// an optional trailing semicolon, such as DKIM key records and DMARC records. Whitespace around the tag names, the
+// equals signs and the values is ignored. Tags are returned in the given order and must not be duplicated.
+func parseTagList(s string) ([]tagSpec, error) {
+	specs := strings.Split(s, ";")
+
+	// A single trailing semicolon is allowed
+	if len(specs) > 1 && strings.TrimFunc(specs[len(specs)-1], isTagListSpace) == "" {
+		specs = specs[:len(specs)-1]
+	}
+
+	tags := make([]tagSpec, 0, len(specs))
+	seen := make(map[string]bool, len(specs))
+
+	for i, spec := range specs {
+		name, value, ok := strings.Cut(spec, "=")
+		if !ok {
+			if strings.TrimFunc(spec, isTagListSpace) == "" {
+				return nil, fmt.Errorf("tag %d must not be empty", i+1)
+			}
+
+			return nil, fmt.Errorf("tag %q must be a tag=value pair", strings.TrimFunc(spec, isTagListSpace))
+		}
+
+		name = strings.TrimFunc(name, isTagListSpace)
+		value = strings.TrimFunc(value, isTagListSpace)
+
+		if !isTagName(name) {
+			return nil, fmt.Errorf("tag name %q must start with a letter and only contain letters, digits and underscores", name)
+		}
+
+		for j := 0; j < len(value); j++ {
+			// Values are printable ASCII and whitespace, where a semicolon would have ended the tag
+			if (value[j] < 0x21 || value[j] > 0x7e) && !isTagListSpace(rune(value[j])) {
+				return nil, fmt.Errorf("tag %q value must only contain printable ASCII characters and whitespace", name)
+			}
+		}
+
+		if seen[name] {
+			return nil, fmt.Errorf("tag %q must not be duplicated", name)
+		}
+
+		seen[name] = true
+		tags = append(tags, tagSpec{name: name, value: value})
+	}
+
+	return tags, nil
+}
+
+// formatTagList formats tags as a tag list in canonical form, with each tag separated by a semicolon and a space.
+// The leading tags are kept in place and the remaining tags are sorted by name, as tag order is not significant.
+func formatTagList(tags []tagSpec, leading int) string {
+	sorted := make([]tagSpec, len(tags))
+	copy(sorted, tags)
+
+	sort.SliceStable(sorted[leading:], func(i, j int) bool {
+		return sorted[leading+i].name < sorted[leading+j].name
+	})
+
+	specs := make([]string, len(sorted))
+
+	for i, tag := range sorted {
+		specs[i] = tag.name + "=" + tag.value
+	}
+
+	return strings.Join(specs, "; ")
+}
+
+// isTagName returns true if s is a valid tag name, which is a letter followed by letters, digits and underscores.
+func isTagName(s string) bool {
+	if s == "" || (s[0] < 'a' || s[0] > 'z') && (s[0] < 'A' || s[0] > 'Z') {
+		return false
+	}
+
+	for i := 1; i < len(s); i++ {
+		c := s[i]
+
+		if (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') && (c < '0' || c > '9') && c != '_' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isTagListSpace returns true if r is whitespace that may surround tags and values of a tag list.
+func isTagListSpace(r rune) bool {
+	return r == ' ' || r == '\t' || r == '\r' || r == '\n'
+}
+
+// splitTagValueList splits a tag value into its colon or comma separated elements, with surrounding whitespace
+// removed from each element.
+func splitTagValueList(value string, sep string) ([]string, error) {
+	elements := strings.Split(value, sep)
+
+	for i, element := range elements {
+		elements[i] = strings.TrimFunc(element, isTagListSpace)
+
+		if elements[i] == "" {
+			return nil, errors.New("list must not contain empty elements")
+		}
+	}
+
+	return elements, nil
+}
@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = (*TLSARecordType)(nil)
+
+// TLSARecordType is an attribute type that represents valid TLSA record RDATA in presentation format, which is a
+// certificate usage, selector and matching type followed by hexadecimal certificate association data, such as `3 1 1
+// 9963C1749CDF7820E4A8CF57CFCA4FFC47F5E0B3C6E08A19A37F5C0388019025`. Semantic equality logic is defined for TLSARecord,
+// so that whitespace and letter case of the certificate association data are ignored.
+//
+// All of the following are semantically equal:
+//   - 3 1 1 9963C1749CDF7820E4A8CF57CFCA4FFC47F5E0B3C6E08A19A37F5C0388019025
+//   - 3 1 1 9963c1749cdf7820e4a8cf57cfca4ffc47f5e0b3c6e08a19a37f5c0388019025
+//   - 3 1 1 9963c1749cdf7820e4a8cf57cfca4ffc 47f5e0b3c6e08a19a37f5c0388019025
+type TLSARecordType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t TLSARecordType) String() string {
+	return "dnstypes.TLSARecordType"
+}
+
+// ValueType returns the Value type.
+func (t TLSARecordType) ValueType(ctx context.Context) attr.Value {
+	return TLSARecord{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t TLSARecordType) Equal(o attr.Type) bool {
+	other, ok := o.(TLSARecordType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t TLSARecordType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return TLSARecord{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t TLSARecordType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
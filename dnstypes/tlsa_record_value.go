@@ -0,0 +1,264 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*TLSARecord)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*TLSARecord)(nil)
+	_ xattr.ValidateableAttribute                = (*TLSARecord)(nil)
+	_ function.ValidateableParameter             = (*TLSARecord)(nil)
+)
+
+// TLSARecord represents valid TLSA record RDATA in presentation format, which is a certificate usage, selector and
+// matching type followed by hexadecimal certificate association data, such as
+// `3 1 1 9963C1749CDF7820E4A8CF57CFCA4FFC47F5E0B3C6E08A19A37F5C0388019025`. Semantic equality logic is defined for
+// TLSARecord, so that whitespace and letter case of the certificate association data are ignored.
+//
+// All of the following are semantically equal:
+//   - 3 1 1 9963C1749CDF7820E4A8CF57CFCA4FFC47F5E0B3C6E08A19A37F5C0388019025
+//   - 3 1 1 9963c1749cdf7820e4a8cf57cfca4ffc47f5e0b3c6e08a19a37f5c0388019025
+//   - 3 1 1 9963c1749cdf7820e4a8cf57cfca4ffc 47f5e0b3c6e08a19a37f5c0388019025
+type TLSARecord struct {
+	basetypes.StringValue
+}
+
+// Type returns a TLSARecordType.
+func (v TLSARecord) Type(_ context.Context) attr.Type {
+	return TLSARecordType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v TLSARecord) Equal(o attr.Value) bool {
+	other, ok := o.(TLSARecord)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given TLSA record string value is semantically equal to the current TLSA
+// record string value. This comparison uses the canonical presentation format of both values, so that whitespace and
+// letter case of the certificate association data are ignored.
+//
+// All of the following are semantically equal:
+//   - 3 1 1 9963C1749CDF7820E4A8CF57CFCA4FFC47F5E0B3C6E08A19A37F5C0388019025
+//   - 3 1 1 9963c1749cdf7820e4a8cf57cfca4ffc47f5e0b3c6e08a19a37f5c0388019025
+//   - 3 1 1 9963c1749cdf7820e4a8cf57cfca4ffc 47f5e0b3c6e08a19a37f5c0388019025
+func (v TLSARecord) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(TLSARecord)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// TLSA records are already validated at this point, ignoring errors
+	newTLSA, _ := parseTLSA(newValue.ValueString())
+	currentTLSA, _ := parseTLSA(v.ValueString())
+
+	return currentTLSA.String() == newTLSA.String(), diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String
+// value that is valid TLSA record RDATA, with a certificate usage from 0 to 3, a selector of 0 or 1, a matching type
+// from 0 to 2 and certificate association data of the correct length.
+func (v TLSARecord) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseTLSA(v.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid TLSA Record String Value",
+			"A string value was provided that is not valid TLSA record string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is valid TLSA record RDATA, with a certificate usage from 0 to 3, a selector of
+// 0 or 1, a matching type from 0 to 2 and certificate association data of the correct length.
+func (v TLSARecord) ValidateParameter(_ context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseTLSA(v.ValueString())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid TLSA Record String Value: "+
+				"A string value was provided that is not valid TLSA record string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueTLSA parses the TLSARecord StringValue into its certificate usage, selector, matching type and decoded
+// certificate association data. A null or unknown value will produce an error diagnostic.
+func (v TLSARecord) ValueTLSA() (TLSA, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("TLSARecord ValueTLSA Error", "TLSA record string value is null"))
+		return TLSA{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("TLSARecord ValueTLSA Error", "TLSA record string value is unknown"))
+		return TLSA{}, diags
+	}
+
+	record, err := parseTLSA(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("TLSARecord ValueTLSA Error", err.Error()))
+		return TLSA{}, diags
+	}
+
+	return record, nil
+}
+
+// TLSA is the parsed RDATA of a TLSA record (RFC 6698 Section 2.1).
+type TLSA struct {
+	// Usage is the certificate usage, from 0 (PKIX-TA) to 3 (DANE-EE).
+	Usage uint8
+
+	// Selector is the part of the certificate that is matched, 0 for the full certificate or 1 for the
+	// SubjectPublicKeyInfo.
+	Selector uint8
+
+	// MatchingType is how the certificate association data is presented, 0 for the exact data, 1 for a SHA-256
+	// digest or 2 for a SHA-512 digest.
+	MatchingType uint8
+
+	// Data is the decoded certificate association data.
+	Data []byte
+}
+
+// String returns the TLSA RDATA in canonical presentation format with uppercase hexadecimal certificate association
+// data.
+func (r TLSA) String() string {
+	return fmt.Sprintf("%d %d %d %X", r.Usage, r.Selector, r.MatchingType, r.Data)
+}
+
+// tlsaDigestLengths maps the digest matching types of the IANA TLSA Matching Types registry to their digest length in
+// bytes. Matching type 0 contains the exact selected data, which has no fixed length.
+var tlsaDigestLengths = map[uint64]int{
+	1: 32, // SHA-256
+	2: 64, // SHA-512
+}
+
+// parseTLSA parses TLSA RDATA in presentation format.
+func parseTLSA(s string) (TLSA, error) {
+	record, err := parseTLSAFields(s)
+	if err != nil {
+		return TLSA{}, fmt.Errorf("TLSA record %q: %w", s, err)
+	}
+
+	return record, nil
+}
+
+// parseTLSAFields parses the certificate usage, selector, matching type and certificate association data fields of
+// TLSA RDATA.
+func parseTLSAFields(s string) (TLSA, error) {
+	tokens, err := splitRDATA(s)
+	if err != nil {
+		return TLSA{}, err
+	}
+
+	if len(tokens) < 4 {
+		return TLSA{}, fmt.Errorf("must contain certificate usage, selector, matching type and certificate association data fields, got %d fields", len(tokens))
+	}
+
+	var numbers [3]uint64
+
+	for i, field := range []struct {
+		name    string
+		maximum uint64
+	}{
+		{"certificate usage", 3},
+		{"selector", 1},
+		{"matching type", 2},
+	} {
+		number, err := parseRDATAUint(tokens[i], field.name, 8)
+		if err != nil {
+			return TLSA{}, err
+		}
+
+		if number > field.maximum {
+			return TLSA{}, fmt.Errorf("%s %d must be from 0 to %d", field.name, number, field.maximum)
+		}
+
+		numbers[i] = number
+	}
+
+	data, err := parseRDATAHex(tokens[3:], "certificate association data")
+	if err != nil {
+		return TLSA{}, err
+	}
+
+	if length, ok := tlsaDigestLengths[numbers[2]]; ok && len(data) != length {
+		return TLSA{}, fmt.Errorf("certificate association data for matching type %d must be %d bytes, got %d", numbers[2], length, len(data))
+	}
+
+	return TLSA{Usage: uint8(numbers[0]), Selector: uint8(numbers[1]), MatchingType: uint8(numbers[2]), Data: data}, nil
+}
+
+// NewTLSARecordNull creates a TLSARecord with a null value. Determine whether the value is null via IsNull method.
+func NewTLSARecordNull() TLSARecord {
+	return TLSARecord{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewTLSARecordUnknown creates a TLSARecord with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewTLSARecordUnknown() TLSARecord {
+	return TLSARecord{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewTLSARecordValue creates a TLSARecord with a known value. Access the value via ValueString method.
+func NewTLSARecordValue(value string) TLSARecord {
+	return TLSARecord{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewTLSARecordPointerValue creates a TLSARecord with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewTLSARecordPointerValue(value *string) TLSARecord {
+	return TLSARecord{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
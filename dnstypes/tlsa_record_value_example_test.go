@@ -0,0 +1,48 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/pem"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/dnstypes"
+)
+
+type TLSARecordResourceModel struct {
+	Value     dnstypes.TLSARecord `tfsdk:"value"`
+	PublicKey string              `tfsdk:"public_key"`
+}
+
+func ExampleTLSARecord_ValueTLSA() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := TLSARecordResourceModel{
+		Value: dnstypes.NewTLSARecordValue("3 1 1 00B0E15AFEAC4C4515AB003107CD3BD31036A8E99E5740ACEA177E860BBAAB2D"),
+		PublicKey: `-----BEGIN PUBLIC KEY-----
+MFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAE4gmus9owjiOq7nKzo8VgvPYCBLoP
+Q93FyjqmNdFgZQQ09whfF0PXNYaz31IQHqU5Tdj8rKOmMLwMTd5StWrNKg==
+-----END PUBLIC KEY-----`,
+	}
+
+	// Check that the TLSARecord data is known and is a DANE-EE SHA-256 digest of the SubjectPublicKeyInfo
+	if !data.Value.IsNull() && !data.Value.IsUnknown() {
+		tlsa, diags := data.Value.ValueTLSA()
+		if diags.HasError() {
+			return
+		}
+
+		block, _ := pem.Decode([]byte(data.PublicKey))
+		if block == nil {
+			return
+		}
+
+		digest := sha256.Sum256(block.Bytes)
+
+		// Output: true
+		fmt.Println(tlsa.Usage == 3 && tlsa.Selector == 1 && tlsa.MatchingType == 1 && bytes.Equal(tlsa.Data, digest[:]))
+	}
+}
@@ -0,0 +1,268 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/dnstypes"
+)
+
+func TestTLSARecordStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentTLSA   dnstypes.TLSARecord
+		givenTLSA     basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"semantically equal - byte-for-byte match": {
+			currentTLSA:   dnstypes.NewTLSARecordValue("3 1 1 9963C1749CDF7820E4A8CF57CFCA4FFC47F5E0B3C6E08A19A37F5C0388019025"),
+			givenTLSA:     dnstypes.NewTLSARecordValue("3 1 1 9963C1749CDF7820E4A8CF57CFCA4FFC47F5E0B3C6E08A19A37F5C0388019025"),
+			expectedMatch: true,
+		},
+		"semantically equal - letter case": {
+			currentTLSA:   dnstypes.NewTLSARecordValue("3 1 1 9963C1749CDF7820E4A8CF57CFCA4FFC47F5E0B3C6E08A19A37F5C0388019025"),
+			givenTLSA:     dnstypes.NewTLSARecordValue("3 1 1 9963c1749cdf7820e4a8cf57cfca4ffc47f5e0b3c6e08a19a37f5c0388019025"),
+			expectedMatch: true,
+		},
+		"semantically equal - split data": {
+			currentTLSA:   dnstypes.NewTLSARecordValue("3 1 1 9963C1749CDF7820E4A8CF57CFCA4FFC47F5E0B3C6E08A19A37F5C0388019025"),
+			givenTLSA:     dnstypes.NewTLSARecordValue("3 1 1 9963c1749cdf7820e4a8cf57cfca4ffc\t47f5e0b3c6e08a19a37f5c0388019025"),
+			expectedMatch: true,
+		},
+		"not equal - different usage": {
+			currentTLSA:   dnstypes.NewTLSARecordValue("3 1 1 9963C1749CDF7820E4A8CF57CFCA4FFC47F5E0B3C6E08A19A37F5C0388019025"),
+			givenTLSA:     dnstypes.NewTLSARecordValue("2 1 1 9963C1749CDF7820E4A8CF57CFCA4FFC47F5E0B3C6E08A19A37F5C0388019025"),
+			expectedMatch: false,
+		},
+		"not equal - different selector": {
+			currentTLSA:   dnstypes.NewTLSARecordValue("3 1 1 9963C1749CDF7820E4A8CF57CFCA4FFC47F5E0B3C6E08A19A37F5C0388019025"),
+			givenTLSA:     dnstypes.NewTLSARecordValue("3 0 1 9963C1749CDF7820E4A8CF57CFCA4FFC47F5E0B3C6E08A19A37F5C0388019025"),
+			expectedMatch: false,
+		},
+		"error - not given TLSARecord value": {
+			currentTLSA:   dnstypes.NewTLSARecordValue("3 1 1 9963C1749CDF7820E4A8CF57CFCA4FFC47F5E0B3C6E08A19A37F5C0388019025"),
+			givenTLSA:     basetypes.NewStringValue("3 1 1 9963C1749CDF7820E4A8CF57CFCA4FFC47F5E0B3C6E08A19A37F5C0388019025"),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: dnstypes.TLSARecord\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentTLSA.StringSemanticEquals(context.Background(), testCase.givenTLSA)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestTLSARecordValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		tlsaValue     dnstypes.TLSARecord
+		expectedError string
+	}{
+		"empty-struct": {
+			tlsaValue: dnstypes.TLSARecord{},
+		},
+		"null": {
+			tlsaValue: dnstypes.NewTLSARecordNull(),
+		},
+		"unknown": {
+			tlsaValue: dnstypes.NewTLSARecordUnknown(),
+		},
+		"valid - SHA-256": {
+			tlsaValue: dnstypes.NewTLSARecordValue("3 1 1 9963c1749cdf7820e4a8cf57cfca4ffc47f5e0b3c6e08a19a37f5c0388019025"),
+		},
+		"valid - exact match": {
+			tlsaValue: dnstypes.NewTLSARecordValue("3 1 0 3059301306072a8648ce3d020106082a8648ce3d03010703420004"),
+		},
+		"invalid - missing data": {
+			tlsaValue:     dnstypes.NewTLSARecordValue("3 1 1"),
+			expectedError: "TLSA record \"3 1 1\": must contain certificate usage, selector, matching type and certificate association data fields, got 3 fields",
+		},
+		"invalid - usage out of range": {
+			tlsaValue:     dnstypes.NewTLSARecordValue("4 1 1 9963c1749cdf7820e4a8cf57cfca4ffc47f5e0b3c6e08a19a37f5c0388019025"),
+			expectedError: "TLSA record \"4 1 1 9963c1749cdf7820e4a8cf57cfca4ffc47f5e0b3c6e08a19a37f5c0388019025\": certificate usage 4 must be from 0 to 3",
+		},
+		"invalid - selector out of range": {
+			tlsaValue:     dnstypes.NewTLSARecordValue("3 2 1 9963c1749cdf7820e4a8cf57cfca4ffc47f5e0b3c6e08a19a37f5c0388019025"),
+			expectedError: "TLSA record \"3 2 1 9963c1749cdf7820e4a8cf57cfca4ffc47f5e0b3c6e08a19a37f5c0388019025\": selector 2 must be from 0 to 1",
+		},
+		"invalid - matching type out of range": {
+			tlsaValue:     dnstypes.NewTLSARecordValue("3 1 3 9963c1749cdf7820e4a8cf57cfca4ffc47f5e0b3c6e08a19a37f5c0388019025"),
+			expectedError: "TLSA record \"3 1 3 9963c1749cdf7820e4a8cf57cfca4ffc47f5e0b3c6e08a19a37f5c0388019025\": matching type 3 must be from 0 to 2",
+		},
+		"invalid - SHA-512 data length": {
+			tlsaValue:     dnstypes.NewTLSARecordValue("3 1 2 9963c1749cdf7820e4a8cf57cfca4ffc47f5e0b3c6e08a19a37f5c0388019025"),
+			expectedError: "TLSA record \"3 1 2 9963c1749cdf7820e4a8cf57cfca4ffc47f5e0b3c6e08a19a37f5c0388019025\": certificate association data for matching type 2 must be 64 bytes, got 32",
+		},
+		"invalid - odd number of digits": {
+			tlsaValue:     dnstypes.NewTLSARecordValue("3 1 0 abc"),
+			expectedError: "TLSA record \"3 1 0 abc\": certificate association data must be a non-empty even number of hexadecimal digits",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var expectedDiags diag.Diagnostics
+
+			if testCase.expectedError != "" {
+				expectedDiags.AddAttributeError(
+					path.Root("test"),
+					"Invalid TLSA Record String Value",
+					"A string value was provided that is not valid TLSA record string format.\n\n"+
+						"Given Value: "+testCase.tlsaValue.ValueString()+"\n"+
+						"Error: "+testCase.expectedError,
+				)
+			}
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.tlsaValue.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{Path: path.Root("test")},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestTLSARecordValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		tlsaValue       dnstypes.TLSARecord
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			tlsaValue: dnstypes.TLSARecord{},
+		},
+		"null": {
+			tlsaValue: dnstypes.NewTLSARecordNull(),
+		},
+		"unknown": {
+			tlsaValue: dnstypes.NewTLSARecordUnknown(),
+		},
+		"valid": {
+			tlsaValue: dnstypes.NewTLSARecordValue("3 1 1 9963C1749CDF7820E4A8CF57CFCA4FFC47F5E0B3C6E08A19A37F5C0388019025"),
+		},
+		"invalid": {
+			tlsaValue: dnstypes.NewTLSARecordValue("3 1 1 aaf4c61ddcc5e8a2dabede0f3b482cd9aea9434d"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid TLSA Record String Value: "+
+					"A string value was provided that is not valid TLSA record string format.\n\n"+
+					"Given Value: "+"3 1 1 aaf4c61ddcc5e8a2dabede0f3b482cd9aea9434d"+"\n"+
+					"Error: "+"TLSA record \"3 1 1 aaf4c61ddcc5e8a2dabede0f3b482cd9aea9434d\": certificate association data for matching type 1 must be 32 bytes, got 20",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.tlsaValue.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestTLSARecordValueTLSA(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		tlsaValue     dnstypes.TLSARecord
+		expectedTLSA  dnstypes.TLSA
+		expectedDiags diag.Diagnostics
+	}{
+		"TLSA record value is null": {
+			tlsaValue: dnstypes.NewTLSARecordNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"TLSARecord ValueTLSA Error",
+					"TLSA record string value is null",
+				),
+			},
+		},
+		"TLSA record value is unknown": {
+			tlsaValue: dnstypes.NewTLSARecordUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"TLSARecord ValueTLSA Error",
+					"TLSA record string value is unknown",
+				),
+			},
+		},
+		"valid": {
+			tlsaValue: dnstypes.NewTLSARecordValue("3 1 1 9963c1749cdf7820e4a8cf57cfca4ffc47f5e0b3c6e08a19a37f5c0388019025"),
+			expectedTLSA: dnstypes.TLSA{Usage: 3, Selector: 1, MatchingType: 1, Data: []byte{
+				0x99, 0x63, 0xc1, 0x74, 0x9c, 0xdf, 0x78, 0x20,
+				0xe4, 0xa8, 0xcf, 0x57, 0xcf, 0xca, 0x4f, 0xfc,
+				0x47, 0xf5, 0xe0, 0xb3, 0xc6, 0xe0, 0x8a, 0x19,
+				0xa3, 0x7f, 0x5c, 0x03, 0x88, 0x01, 0x90, 0x25,
+			}},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			record, diags := testCase.tlsaValue.ValueTLSA()
+
+			if diff := cmp.Diff(record, testCase.expectedTLSA); diff != "" {
+				t.Errorf("Unexpected difference in TLSA record (-got, +expected): %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = (*TXTRecordType)(nil)
+
+// TXTRecordType is an attribute type that represents a valid TXT record value, either as one or more quoted
+// character strings in presentation format, such as `"v=spf1 " "-all"`, or as the unquoted text itself. Semantic
+// equality logic is defined for TXTRecordType, so that the quoting, escaping and chunking of the same text are
+// ignored.
+//
+// All of the following are semantically equal:
+//   - v=spf1 include:_spf.example.com -all
+//   - "v=spf1 include:_spf.example.com -all"
+//   - "v=spf1 include:_spf" ".example.com -all"
+type TXTRecordType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t TXTRecordType) String() string {
+	return "dnstypes.TXTRecordType"
+}
+
+// ValueType returns the Value type.
+func (t TXTRecordType) ValueType(ctx context.Context) attr.Value {
+	return TXTRecord{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t TXTRecordType) Equal(o attr.Type) bool {
+	other, ok := o.(TXTRecordType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t TXTRecordType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return TXTRecord{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t TXTRecordType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
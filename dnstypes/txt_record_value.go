@@ -0,0 +1,273 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*TXTRecord)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*TXTRecord)(nil)
+	_ xattr.ValidateableAttribute                = (*TXTRecord)(nil)
+	_ function.ValidateableParameter             = (*TXTRecord)(nil)
+)
+
+// TXTRecord represents a valid TXT record value. A value starting with a quote is parsed as one or more character
+// strings in presentation format, such as `"v=spf1 " "-all"`, where each string must not be longer than 255 octets
+// after escape sequences are decoded. Any other value is taken literally as the text itself, which may be longer than
+// 255 octets. Semantic equality logic is defined for TXTRecord, so that the quoting, escaping and chunking of the
+// same text are ignored.
+//
+// All of the following are semantically equal:
+//   - v=spf1 include:_spf.example.com -all
+//   - "v=spf1 include:_spf.example.com -all"
+//   - "v=spf1 include:_spf" ".example.com -all"
+type TXTRecord struct {
+	basetypes.StringValue
+}
+
+// Type returns a TXTRecordType.
+func (v TXTRecord) Type(_ context.Context) attr.Type {
+	return TXTRecordType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v TXTRecord) Equal(o attr.Value) bool {
+	other, ok := o.(TXTRecord)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given TXT record string value is semantically equal to the current TXT
+// record string value. This comparison concatenates the decoded character strings of both values, so that the
+// quoting, escaping and chunking of the same text are ignored.
+//
+// All of the following are semantically equal:
+//   - v=spf1 include:_spf.example.com -all
+//   - "v=spf1 include:_spf.example.com -all"
+//   - "v=spf1 include:_spf" ".example.com -all"
+func (v TXTRecord) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(TXTRecord)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// TXT records are already validated at this point, ignoring errors
+	newText, _ := parseTXT(newValue.ValueString())
+	currentText, _ := parseTXT(v.ValueString())
+
+	return currentText == newText, diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String
+// value that is either unquoted text, or quoted character strings in presentation format with valid escape sequences
+// where each string is not longer than 255 octets.
+func (v TXTRecord) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseTXT(v.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid TXT Record String Value",
+			"A string value was provided that is not valid TXT record string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is either unquoted text, or quoted character strings in presentation format
+// with valid escape sequences where each string is not longer than 255 octets.
+func (v TXTRecord) ValidateParameter(_ context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseTXT(v.ValueString())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid TXT Record String Value: "+
+				"A string value was provided that is not valid TXT record string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueText returns the text of the TXTRecord StringValue, which is the concatenation of its decoded character
+// strings. A null or unknown value will produce an error diagnostic.
+func (v TXTRecord) ValueText() (string, diag.Diagnostics) {
+	return v.valueText("ValueText")
+}
+
+// ValueChunks returns the text of the TXTRecord StringValue split into character strings of at most 255 octets,
+// regardless of how the value itself is chunked. A null or unknown value will produce an error diagnostic.
+func (v TXTRecord) ValueChunks() ([]string, diag.Diagnostics) {
+	text, diags := v.valueText("ValueChunks")
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return chunkTXT(text), nil
+}
+
+// ValueChunkedString returns the text of the TXTRecord StringValue in presentation format, as quoted character
+// strings of at most 255 octets separated by a space, such as `"v=DKIM1; k=rsa; p=MIIB..." "...IDAQAB"`. This is the
+// form expected by most DNS provider APIs for long TXT records. A null or unknown value will produce an error
+// diagnostic.
+func (v TXTRecord) ValueChunkedString() (string, diag.Diagnostics) {
+	text, diags := v.valueText("ValueChunkedString")
+	if diags.HasError() {
+		return "", diags
+	}
+
+	chunks := chunkTXT(text)
+
+	for i, chunk := range chunks {
+		chunks[i] = formatCharacterString(chunk)
+	}
+
+	return strings.Join(chunks, " "), nil
+}
+
+// valueText parses the TXTRecord StringValue into its text, with diagnostics for the given accessor method name.
+func (v TXTRecord) valueText(method string) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("TXTRecord "+method+" Error", "TXT record string value is null"))
+		return "", diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("TXTRecord "+method+" Error", "TXT record string value is unknown"))
+		return "", diags
+	}
+
+	text, err := parseTXT(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("TXTRecord "+method+" Error", err.Error()))
+		return "", diags
+	}
+
+	return text, nil
+}
+
+// parseTXT parses a TXT record value into its text. A value starting with a quote is parsed as character strings in
+// presentation format, which are concatenated, otherwise the value is the text itself.
+func parseTXT(s string) (string, error) {
+	text, err := decodeTXT(s)
+	if err != nil {
+		return "", fmt.Errorf("TXT record %q: %w", s, err)
+	}
+
+	return text, nil
+}
+
+// decodeTXT decodes the text of a TXT record value, which is shared by the types of TXT records with a specific
+// format, such as SPF, DMARC and DKIM records.
+func decodeTXT(s string) (string, error) {
+	text := s
+
+	if strings.HasPrefix(s, `"`) {
+		tokens, err := splitRDATA(s)
+		if err != nil {
+			return "", err
+		}
+
+		var b strings.Builder
+
+		for i, token := range tokens {
+			chunk, err := parseCharacterString(token, maxCharacterStringLength)
+			if err != nil {
+				return "", fmt.Errorf("character string %d: %w", i+1, err)
+			}
+
+			b.WriteString(chunk)
+		}
+
+		text = b.String()
+	}
+
+	// Each character string of the RDATA is prefixed with a length octet
+	chunks := len(chunkTXT(text))
+
+	if len(text)+chunks > maxRDATALength {
+		return "", fmt.Errorf("text must not be longer than %d octets, got %d", maxRDATALength-chunks, len(text))
+	}
+
+	return text, nil
+}
+
+// chunkTXT splits text into character strings of at most 255 octets. Empty text is a single empty character string.
+func chunkTXT(text string) []string {
+	chunks := []string{}
+
+	for len(text) > maxCharacterStringLength {
+		chunks = append(chunks, text[:maxCharacterStringLength])
+		text = text[maxCharacterStringLength:]
+	}
+
+	return append(chunks, text)
+}
+
+// NewTXTRecordNull creates a TXTRecord with a null value. Determine whether the value is null via IsNull method.
+func NewTXTRecordNull() TXTRecord {
+	return TXTRecord{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewTXTRecordUnknown creates a TXTRecord with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewTXTRecordUnknown() TXTRecord {
+	return TXTRecord{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewTXTRecordValue creates a TXTRecord with a known value. Access the value via ValueString method.
+func NewTXTRecordValue(value string) TXTRecord {
+	return TXTRecord{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewTXTRecordPointerValue creates a TXTRecord with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewTXTRecordPointerValue(value *string) TXTRecord {
+	return TXTRecord{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
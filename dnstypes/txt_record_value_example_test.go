@@ -0,0 +1,39 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes_test
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/dnstypes"
+)
+
+type TXTRecordResourceModel struct {
+	Value dnstypes.TXTRecord `tfsdk:"value"`
+}
+
+func ExampleTXTRecord_ValueChunkedString() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := TXTRecordResourceModel{
+		Value: dnstypes.NewTXTRecordValue("v=DKIM1; k=rsa; p=" + strings.Repeat("A", 300)),
+	}
+
+	// Check that the TXTRecord data is known and split it into chunks for the DNS provider API
+	if !data.Value.IsNull() && !data.Value.IsUnknown() {
+		chunkedString, diags := data.Value.ValueChunkedString()
+		if diags.HasError() {
+			return
+		}
+
+		for _, chunk := range strings.SplitAfter(chunkedString, `" `) {
+			fmt.Println(len(chunk))
+		}
+
+		// Output:
+		// 258
+		// 65
+	}
+}
@@ -0,0 +1,406 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/dnstypes"
+)
+
+func TestTXTRecordStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	longText := strings.Repeat("a", 255) + strings.Repeat("b", 45)
+
+	testCases := map[string]struct {
+		currentTXT    dnstypes.TXTRecord
+		givenTXT      basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"semantically equal - byte-for-byte match": {
+			currentTXT:    dnstypes.NewTXTRecordValue("v=spf1 include:_spf.example.com -all"),
+			givenTXT:      dnstypes.NewTXTRecordValue("v=spf1 include:_spf.example.com -all"),
+			expectedMatch: true,
+		},
+		"semantically equal - unquoted and quoted": {
+			currentTXT:    dnstypes.NewTXTRecordValue("v=spf1 include:_spf.example.com -all"),
+			givenTXT:      dnstypes.NewTXTRecordValue(`"v=spf1 include:_spf.example.com -all"`),
+			expectedMatch: true,
+		},
+		"semantically equal - unquoted and chunked": {
+			currentTXT:    dnstypes.NewTXTRecordValue("v=spf1 include:_spf.example.com -all"),
+			givenTXT:      dnstypes.NewTXTRecordValue(`"v=spf1 include:_spf" ".example.com -all"`),
+			expectedMatch: true,
+		},
+		"semantically equal - long unquoted and chunked at 255 octets": {
+			currentTXT:    dnstypes.NewTXTRecordValue(longText),
+			givenTXT:      dnstypes.NewTXTRecordValue(`"` + longText[:255] + `" "` + longText[255:] + `"`),
+			expectedMatch: true,
+		},
+		"semantically equal - differently chunked": {
+			currentTXT:    dnstypes.NewTXTRecordValue(`"v=DKIM1; " "k=rsa"`),
+			givenTXT:      dnstypes.NewTXTRecordValue(`"v=DKIM1;" " k=rsa"`),
+			expectedMatch: true,
+		},
+		"semantically equal - escape sequences": {
+			currentTXT:    dnstypes.NewTXTRecordValue(`say "hello"`),
+			givenTXT:      dnstypes.NewTXTRecordValue(`"say \"hello\""`),
+			expectedMatch: true,
+		},
+		"semantically equal - decimal escape sequences": {
+			currentTXT:    dnstypes.NewTXTRecordValue(`"caf\195\169"`),
+			givenTXT:      dnstypes.NewTXTRecordValue("café"),
+			expectedMatch: true,
+		},
+		"not equal - whitespace within a character string": {
+			currentTXT:    dnstypes.NewTXTRecordValue(`"v=DKIM1; k=rsa"`),
+			givenTXT:      dnstypes.NewTXTRecordValue(`"v=DKIM1;  k=rsa"`),
+			expectedMatch: false,
+		},
+		"not equal - letter case": {
+			currentTXT:    dnstypes.NewTXTRecordValue("v=spf1 -all"),
+			givenTXT:      dnstypes.NewTXTRecordValue("V=SPF1 -ALL"),
+			expectedMatch: false,
+		},
+		"error - not given TXTRecord value": {
+			currentTXT:    dnstypes.NewTXTRecordValue("v=spf1 -all"),
+			givenTXT:      basetypes.NewStringValue("v=spf1 -all"),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: dnstypes.TXTRecord\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentTXT.StringSemanticEquals(context.Background(), testCase.givenTXT)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestTXTRecordValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	longChunk := `"` + strings.Repeat("a", 256) + `"`
+	tooLongText := strings.Repeat("a", 65280)
+
+	testCases := map[string]struct {
+		txtValue      dnstypes.TXTRecord
+		expectedError string
+	}{
+		"empty-struct": {
+			txtValue: dnstypes.TXTRecord{},
+		},
+		"null": {
+			txtValue: dnstypes.NewTXTRecordNull(),
+		},
+		"unknown": {
+			txtValue: dnstypes.NewTXTRecordUnknown(),
+		},
+		"valid - unquoted": {
+			txtValue: dnstypes.NewTXTRecordValue("v=spf1 include:_spf.example.com -all"),
+		},
+		"valid - unquoted longer than 255 octets": {
+			txtValue: dnstypes.NewTXTRecordValue(strings.Repeat("a", 1000)),
+		},
+		"valid - empty": {
+			txtValue: dnstypes.NewTXTRecordValue(""),
+		},
+		"valid - empty quoted": {
+			txtValue: dnstypes.NewTXTRecordValue(`""`),
+		},
+		"valid - chunked": {
+			txtValue: dnstypes.NewTXTRecordValue(`"v=DKIM1; k=rsa; " "p=MIGfMA0GCSqGSIb3DQEBAQUAA4GNADCBiQKBgQ"`),
+		},
+		"valid - chunk of 255 octets": {
+			txtValue: dnstypes.NewTXTRecordValue(`"` + strings.Repeat("a", 255) + `"`),
+		},
+		"valid - maximum length": {
+			txtValue: dnstypes.NewTXTRecordValue(strings.Repeat("a", 65279)),
+		},
+		"invalid - chunk longer than 255 octets": {
+			txtValue:      dnstypes.NewTXTRecordValue(longChunk),
+			expectedError: "TXT record \"\\\"" + strings.Repeat("a", 256) + "\\\"\": character string 1: character string must not be longer than 255 octets, got 256",
+		},
+		"invalid - missing closing quote": {
+			txtValue:      dnstypes.NewTXTRecordValue(`"v=spf1 -all`),
+			expectedError: "TXT record \"\\\"v=spf1 -all\": quoted field is missing its closing quote",
+		},
+		"invalid - text after closing quote": {
+			txtValue:      dnstypes.NewTXTRecordValue(`"v=spf1"-all`),
+			expectedError: "TXT record \"\\\"v=spf1\\\"-all\": closing quote must be followed by whitespace",
+		},
+		"invalid - trailing backslash": {
+			txtValue:      dnstypes.NewTXTRecordValue(`"v=spf1 -all" \`),
+			expectedError: "TXT record \"\\\"v=spf1 -all\\\" \\\\\": backslash must be followed by an escaped character",
+		},
+		"invalid - decimal escape sequence out of range": {
+			txtValue:      dnstypes.NewTXTRecordValue(`"caf\300"`),
+			expectedError: "TXT record \"\\\"caf\\\\300\\\"\": character string 1: escape sequence \\300 must be a decimal octet from 000 to 255",
+		},
+		"invalid - text too long": {
+			txtValue:      dnstypes.NewTXTRecordValue(tooLongText),
+			expectedError: "TXT record \"" + tooLongText + "\": text must not be longer than 65279 octets, got 65280",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var expectedDiags diag.Diagnostics
+
+			if testCase.expectedError != "" {
+				expectedDiags.AddAttributeError(
+					path.Root("test"),
+					"Invalid TXT Record String Value",
+					"A string value was provided that is not valid TXT record string format.\n\n"+
+						"Given Value: "+testCase.txtValue.ValueString()+"\n"+
+						"Error: "+testCase.expectedError,
+				)
+			}
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.txtValue.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{Path: path.Root("test")},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestTXTRecordValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		txtValue        dnstypes.TXTRecord
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			txtValue: dnstypes.TXTRecord{},
+		},
+		"null": {
+			txtValue: dnstypes.NewTXTRecordNull(),
+		},
+		"unknown": {
+			txtValue: dnstypes.NewTXTRecordUnknown(),
+		},
+		"valid - chunked": {
+			txtValue: dnstypes.NewTXTRecordValue(`"v=spf1 include:_spf" ".example.com -all"`),
+		},
+		"invalid - missing closing quote": {
+			txtValue: dnstypes.NewTXTRecordValue(`"v=spf1 -all`),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid TXT Record String Value: "+
+					"A string value was provided that is not valid TXT record string format.\n\n"+
+					"Given Value: \"v=spf1 -all\n"+
+					"Error: TXT record \"\\\"v=spf1 -all\": quoted field is missing its closing quote",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.txtValue.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestTXTRecordValueText(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		txtValue      dnstypes.TXTRecord
+		expectedText  string
+		expectedDiags diag.Diagnostics
+	}{
+		"TXT record value is null": {
+			txtValue: dnstypes.NewTXTRecordNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"TXTRecord ValueText Error",
+					"TXT record string value is null",
+				),
+			},
+		},
+		"TXT record value is unknown": {
+			txtValue: dnstypes.NewTXTRecordUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"TXTRecord ValueText Error",
+					"TXT record string value is unknown",
+				),
+			},
+		},
+		"unquoted": {
+			txtValue:     dnstypes.NewTXTRecordValue(`v=spf1 -all \ "`),
+			expectedText: `v=spf1 -all \ "`,
+		},
+		"chunked with escape sequences": {
+			txtValue:     dnstypes.NewTXTRecordValue(`"say \"hello\" " "to caf\195\169\\"`),
+			expectedText: `say "hello" to café\`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			text, diags := testCase.txtValue.ValueText()
+
+			if text != testCase.expectedText {
+				t.Errorf("Unexpected difference in text, got: %s, expected: %s", text, testCase.expectedText)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestTXTRecordValueChunks(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		txtValue       dnstypes.TXTRecord
+		expectedChunks []string
+		expectedDiags  diag.Diagnostics
+	}{
+		"TXT record value is null": {
+			txtValue: dnstypes.NewTXTRecordNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"TXTRecord ValueChunks Error",
+					"TXT record string value is null",
+				),
+			},
+		},
+		"empty": {
+			txtValue:       dnstypes.NewTXTRecordValue(""),
+			expectedChunks: []string{""},
+		},
+		"short": {
+			txtValue:       dnstypes.NewTXTRecordValue(`"v=spf1 " "-all"`),
+			expectedChunks: []string{"v=spf1 -all"},
+		},
+		"exactly 255 octets": {
+			txtValue:       dnstypes.NewTXTRecordValue(strings.Repeat("a", 255)),
+			expectedChunks: []string{strings.Repeat("a", 255)},
+		},
+		"longer than 255 octets": {
+			txtValue:       dnstypes.NewTXTRecordValue(strings.Repeat("a", 600)),
+			expectedChunks: []string{strings.Repeat("a", 255), strings.Repeat("a", 255), strings.Repeat("a", 90)},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			chunks, diags := testCase.txtValue.ValueChunks()
+
+			if diff := cmp.Diff(chunks, testCase.expectedChunks); diff != "" {
+				t.Errorf("Unexpected difference in chunks (-got, +expected): %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestTXTRecordValueChunkedString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		txtValue       dnstypes.TXTRecord
+		expectedString string
+		expectedDiags  diag.Diagnostics
+	}{
+		"TXT record value is unknown": {
+			txtValue: dnstypes.NewTXTRecordUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"TXTRecord ValueChunkedString Error",
+					"TXT record string value is unknown",
+				),
+			},
+		},
+		"empty": {
+			txtValue:       dnstypes.NewTXTRecordValue(""),
+			expectedString: `""`,
+		},
+		"escaped": {
+			txtValue:       dnstypes.NewTXTRecordValue(`say "hello" to café\`),
+			expectedString: `"say \"hello\" to caf\195\169\\"`,
+		},
+		"longer than 255 octets": {
+			txtValue:       dnstypes.NewTXTRecordValue(strings.Repeat("a", 300)),
+			expectedString: `"` + strings.Repeat("a", 255) + `" "` + strings.Repeat("a", 45) + `"`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			chunkedString, diags := testCase.txtValue.ValueChunkedString()
+
+			if chunkedString != testCase.expectedString {
+				t.Errorf("Unexpected difference in chunked string, got: %s, expected: %s", chunkedString, testCase.expectedString)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
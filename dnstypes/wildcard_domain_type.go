@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = (*WildcardDomainType)(nil)
+
+// WildcardDomainType is an attribute type that represents a valid DNS hostname, which may be a wildcard name where
+// the whole leftmost label is an asterisk (RFC 6125 Section 6.4.3), such as `*.example.com`. Semantic equality logic
+// is defined for WildcardDomainType, so that letter case and a trailing dot are ignored.
+//
+// All of the following are semantically equal:
+//   - *.example.com
+//   - *.Example.COM
+//   - *.example.com.
+type WildcardDomainType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t WildcardDomainType) String() string {
+	return "dnstypes.WildcardDomainType"
+}
+
+// ValueType returns the Value type.
+func (t WildcardDomainType) ValueType(ctx context.Context) attr.Value {
+	return WildcardDomain{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t WildcardDomainType) Equal(o attr.Type) bool {
+	other, ok := o.(WildcardDomainType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t WildcardDomainType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return WildcardDomain{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t WildcardDomainType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
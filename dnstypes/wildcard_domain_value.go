@@ -0,0 +1,226 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*WildcardDomain)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*WildcardDomain)(nil)
+	_ xattr.ValidateableAttribute                = (*WildcardDomain)(nil)
+	_ function.ValidateableParameter             = (*WildcardDomain)(nil)
+)
+
+// WildcardDomain represents a valid DNS hostname, which may be a wildcard name where the whole leftmost label is an
+// asterisk (RFC 6125 Section 6.4.3), such as `*.example.com`. Partial wildcards such as `f*.example.com`, wildcards
+// in any other label and wildcards directly below a top-level domain, such as `*.com`, are not valid. A single
+// trailing dot is permitted. Semantic equality logic is defined for WildcardDomain, so that letter case and a
+// trailing dot are ignored.
+//
+// All of the following are semantically equal:
+//   - *.example.com
+//   - *.Example.COM
+//   - *.example.com.
+type WildcardDomain struct {
+	basetypes.StringValue
+}
+
+// Type returns a WildcardDomainType.
+func (v WildcardDomain) Type(_ context.Context) attr.Type {
+	return WildcardDomainType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v WildcardDomain) Equal(o attr.Value) bool {
+	other, ok := o.(WildcardDomain)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given wildcard domain string value is semantically equal to the current
+// wildcard domain string value. This comparison ignores letter case (RFC 4343) and a trailing dot.
+//
+// All of the following are semantically equal:
+//   - *.example.com
+//   - *.Example.COM
+//   - *.example.com.
+func (v WildcardDomain) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(WildcardDomain)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	return normalizeDomainName(v.ValueString()) == normalizeDomainName(newValue.ValueString()), diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String
+// value that is a valid DNS hostname, where a wildcard may only be the whole leftmost label.
+func (v WildcardDomain) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseWildcardDomain(v.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Wildcard Domain String Value",
+			"A string value was provided that is not valid wildcard domain string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is a valid DNS hostname, where a wildcard may only be the whole leftmost label.
+func (v WildcardDomain) ValidateParameter(_ context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseWildcardDomain(v.ValueString())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid Wildcard Domain String Value: "+
+				"A string value was provided that is not valid wildcard domain string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// Matches returns true if the given hostname is covered by the WildcardDomain StringValue, following the certificate
+// name matching rules of RFC 6125 Section 6.4. A wildcard matches exactly one leftmost label, so `*.example.com`
+// matches `www.example.com` but neither `example.com` nor `a.b.example.com`. Letter case and a trailing dot are
+// ignored. A hostname that is not valid never matches. A null or unknown value will produce an error diagnostic.
+func (v WildcardDomain) Matches(hostname string) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("WildcardDomain Matches Error", "wildcard domain string value is null"))
+		return false, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("WildcardDomain Matches Error", "wildcard domain string value is unknown"))
+		return false, diags
+	}
+
+	labels, err := parseWildcardDomain(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("WildcardDomain Matches Error", err.Error()))
+		return false, diags
+	}
+
+	hostnameLabels, err := splitDomainName(hostname)
+	if err != nil || validateHostnameLabels(hostname, hostnameLabels) != nil {
+		return false, nil
+	}
+
+	if len(hostnameLabels) != len(labels) {
+		return false, nil
+	}
+
+	for i, label := range labels {
+		if i == 0 && label == wildcardLabel {
+			continue
+		}
+
+		if !strings.EqualFold(label, hostnameLabels[i]) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// parseWildcardDomain splits a hostname or wildcard domain name into its labels, validating that a wildcard is only
+// the whole leftmost label and is followed by at least two labels.
+func parseWildcardDomain(s string) ([]string, error) {
+	labels, err := splitDomainName(s)
+	if err != nil {
+		return nil, err
+	}
+
+	hostnameLabels := labels
+
+	if labels[0] == wildcardLabel {
+		hostnameLabels = labels[1:]
+
+		if len(hostnameLabels) < 2 {
+			return nil, fmt.Errorf("domain name %q: wildcard must be followed by at least two labels", s)
+		}
+	}
+
+	for _, label := range hostnameLabels {
+		if strings.Contains(label, wildcardLabel) {
+			return nil, fmt.Errorf("domain name %q: wildcard must be the whole leftmost label", s)
+		}
+	}
+
+	err = validateHostnameLabels(s, hostnameLabels)
+	if err != nil {
+		return nil, err
+	}
+
+	return labels, nil
+}
+
+// NewWildcardDomainNull creates a WildcardDomain with a null value. Determine whether the value is null via IsNull method.
+func NewWildcardDomainNull() WildcardDomain {
+	return WildcardDomain{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewWildcardDomainUnknown creates a WildcardDomain with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewWildcardDomainUnknown() WildcardDomain {
+	return WildcardDomain{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewWildcardDomainValue creates a WildcardDomain with a known value. Access the value via ValueString method.
+func NewWildcardDomainValue(value string) WildcardDomain {
+	return WildcardDomain{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewWildcardDomainPointerValue creates a WildcardDomain with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewWildcardDomainPointerValue(value *string) WildcardDomain {
+	return WildcardDomain{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/dnstypes"
+)
+
+type CertificateBindingResourceModel struct {
+	SubjectAlternativeName dnstypes.WildcardDomain `tfsdk:"subject_alternative_name"`
+	Hostname               string                  `tfsdk:"hostname"`
+}
+
+func ExampleWildcardDomain_Matches() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := CertificateBindingResourceModel{
+		SubjectAlternativeName: dnstypes.NewWildcardDomainValue("*.example.com"),
+		Hostname:               "www.example.com",
+	}
+
+	// Check that the WildcardDomain data is known and covers the hostname
+	if !data.SubjectAlternativeName.IsNull() && !data.SubjectAlternativeName.IsUnknown() {
+		match, diags := data.SubjectAlternativeName.Matches(data.Hostname)
+		if diags.HasError() {
+			return
+		}
+
+		// Output: true
+		fmt.Println(match)
+	}
+}
@@ -0,0 +1,357 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dnstypes_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/dnstypes"
+)
+
+func TestWildcardDomainStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentDomain dnstypes.WildcardDomain
+		givenDomain   basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"semantically equal - byte-for-byte match": {
+			currentDomain: dnstypes.NewWildcardDomainValue("*.example.com"),
+			givenDomain:   dnstypes.NewWildcardDomainValue("*.example.com"),
+			expectedMatch: true,
+		},
+		"semantically equal - letter case": {
+			currentDomain: dnstypes.NewWildcardDomainValue("*.example.com"),
+			givenDomain:   dnstypes.NewWildcardDomainValue("*.Example.COM"),
+			expectedMatch: true,
+		},
+		"semantically equal - trailing dot": {
+			currentDomain: dnstypes.NewWildcardDomainValue("www.example.com."),
+			givenDomain:   dnstypes.NewWildcardDomainValue("WWW.example.com"),
+			expectedMatch: true,
+		},
+		"not equal - wildcard and hostname": {
+			currentDomain: dnstypes.NewWildcardDomainValue("*.example.com"),
+			givenDomain:   dnstypes.NewWildcardDomainValue("www.example.com"),
+			expectedMatch: false,
+		},
+		"not equal - different domain": {
+			currentDomain: dnstypes.NewWildcardDomainValue("*.example.com"),
+			givenDomain:   dnstypes.NewWildcardDomainValue("*.example.net"),
+			expectedMatch: false,
+		},
+		"error - not given WildcardDomain value": {
+			currentDomain: dnstypes.NewWildcardDomainValue("*.example.com"),
+			givenDomain:   basetypes.NewStringValue("*.example.com"),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: dnstypes.WildcardDomain\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentDomain.StringSemanticEquals(context.Background(), testCase.givenDomain)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestWildcardDomainValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	longLabel := strings.Repeat("a", 64)
+	longName := strings.Repeat(strings.Repeat("a", 63)+".", 4) + "com"
+
+	testCases := map[string]struct {
+		domain        dnstypes.WildcardDomain
+		expectedError string
+	}{
+		"empty-struct": {
+			domain: dnstypes.WildcardDomain{},
+		},
+		"null": {
+			domain: dnstypes.NewWildcardDomainNull(),
+		},
+		"unknown": {
+			domain: dnstypes.NewWildcardDomainUnknown(),
+		},
+		"valid - wildcard": {
+			domain: dnstypes.NewWildcardDomainValue("*.example.com"),
+		},
+		"valid - wildcard with trailing dot": {
+			domain: dnstypes.NewWildcardDomainValue("*.sub.example.com."),
+		},
+		"valid - hostname": {
+			domain: dnstypes.NewWildcardDomainValue("www.example.com"),
+		},
+		"valid - single label": {
+			domain: dnstypes.NewWildcardDomainValue("localhost"),
+		},
+		"valid - IDNA A-label": {
+			domain: dnstypes.NewWildcardDomainValue("*.xn--bcher-kva.example"),
+		},
+		"valid - numeric labels": {
+			domain: dnstypes.NewWildcardDomainValue("1.2.3.example"),
+		},
+		"invalid - empty": {
+			domain:        dnstypes.NewWildcardDomainValue(""),
+			expectedError: "domain name \"\": must contain at least one label",
+		},
+		"invalid - root": {
+			domain:        dnstypes.NewWildcardDomainValue("."),
+			expectedError: "domain name \".\": must contain at least one label",
+		},
+		"invalid - empty label": {
+			domain:        dnstypes.NewWildcardDomainValue("www..example.com"),
+			expectedError: "domain name \"www..example.com\": must not contain empty labels",
+		},
+		"invalid - partial wildcard": {
+			domain:        dnstypes.NewWildcardDomainValue("f*.example.com"),
+			expectedError: "domain name \"f*.example.com\": wildcard must be the whole leftmost label",
+		},
+		"invalid - wildcard not leftmost": {
+			domain:        dnstypes.NewWildcardDomainValue("www.*.example.com"),
+			expectedError: "domain name \"www.*.example.com\": wildcard must be the whole leftmost label",
+		},
+		"invalid - multiple wildcards": {
+			domain:        dnstypes.NewWildcardDomainValue("*.*.example.com"),
+			expectedError: "domain name \"*.*.example.com\": wildcard must be the whole leftmost label",
+		},
+		"invalid - wildcard below top-level domain": {
+			domain:        dnstypes.NewWildcardDomainValue("*.com"),
+			expectedError: "domain name \"*.com\": wildcard must be followed by at least two labels",
+		},
+		"invalid - underscore": {
+			domain:        dnstypes.NewWildcardDomainValue("_acme-challenge.example.com"),
+			expectedError: "domain name \"_acme-challenge.example.com\": label \"_acme-challenge\" must only contain letters, digits and hyphens, and must not start or end with a hyphen",
+		},
+		"invalid - leading hyphen": {
+			domain:        dnstypes.NewWildcardDomainValue("-www.example.com"),
+			expectedError: "domain name \"-www.example.com\": label \"-www\" must only contain letters, digits and hyphens, and must not start or end with a hyphen",
+		},
+		"invalid - IPv4 address": {
+			domain:        dnstypes.NewWildcardDomainValue("192.0.2.1"),
+			expectedError: "domain name \"192.0.2.1\": top-level label must not be all-numeric",
+		},
+		"invalid - label too long": {
+			domain:        dnstypes.NewWildcardDomainValue(longLabel + ".example.com"),
+			expectedError: "domain name \"" + longLabel + ".example.com\": label \"" + longLabel + "\" must not be longer than 63 characters",
+		},
+		"invalid - name too long": {
+			domain:        dnstypes.NewWildcardDomainValue(longName),
+			expectedError: "domain name \"" + longName + "\": must not be longer than 253 characters, got 259",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var expectedDiags diag.Diagnostics
+
+			if testCase.expectedError != "" {
+				expectedDiags.AddAttributeError(
+					path.Root("test"),
+					"Invalid Wildcard Domain String Value",
+					"A string value was provided that is not valid wildcard domain string format.\n\n"+
+						"Given Value: "+testCase.domain.ValueString()+"\n"+
+						"Error: "+testCase.expectedError,
+				)
+			}
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.domain.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{Path: path.Root("test")},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestWildcardDomainValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		domain          dnstypes.WildcardDomain
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			domain: dnstypes.WildcardDomain{},
+		},
+		"null": {
+			domain: dnstypes.NewWildcardDomainNull(),
+		},
+		"unknown": {
+			domain: dnstypes.NewWildcardDomainUnknown(),
+		},
+		"valid - wildcard": {
+			domain: dnstypes.NewWildcardDomainValue("*.example.com"),
+		},
+		"invalid - partial wildcard": {
+			domain: dnstypes.NewWildcardDomainValue("*www.example.com"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid Wildcard Domain String Value: "+
+					"A string value was provided that is not valid wildcard domain string format.\n\n"+
+					"Given Value: *www.example.com\n"+
+					"Error: domain name \"*www.example.com\": wildcard must be the whole leftmost label",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.domain.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestWildcardDomainMatches(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		domain        dnstypes.WildcardDomain
+		hostname      string
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"wildcard domain value is null": {
+			domain:   dnstypes.NewWildcardDomainNull(),
+			hostname: "www.example.com",
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"WildcardDomain Matches Error",
+					"wildcard domain string value is null",
+				),
+			},
+		},
+		"wildcard domain value is unknown": {
+			domain:   dnstypes.NewWildcardDomainUnknown(),
+			hostname: "www.example.com",
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"WildcardDomain Matches Error",
+					"wildcard domain string value is unknown",
+				),
+			},
+		},
+		"invalid wildcard domain value": {
+			domain:   dnstypes.NewWildcardDomainValue("*.com"),
+			hostname: "example.com",
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"WildcardDomain Matches Error",
+					"domain name \"*.com\": wildcard must be followed by at least two labels",
+				),
+			},
+		},
+		"wildcard - matches leftmost label": {
+			domain:        dnstypes.NewWildcardDomainValue("*.example.com"),
+			hostname:      "www.example.com",
+			expectedMatch: true,
+		},
+		"wildcard - matches letter case and trailing dot": {
+			domain:        dnstypes.NewWildcardDomainValue("*.Example.com."),
+			hostname:      "API.example.COM",
+			expectedMatch: true,
+		},
+		"wildcard - does not match base domain": {
+			domain:        dnstypes.NewWildcardDomainValue("*.example.com"),
+			hostname:      "example.com",
+			expectedMatch: false,
+		},
+		"wildcard - does not match multiple labels": {
+			domain:        dnstypes.NewWildcardDomainValue("*.example.com"),
+			hostname:      "a.b.example.com",
+			expectedMatch: false,
+		},
+		"wildcard - does not match different domain": {
+			domain:        dnstypes.NewWildcardDomainValue("*.example.com"),
+			hostname:      "www.example.net",
+			expectedMatch: false,
+		},
+		"wildcard - does not match invalid hostname": {
+			domain:        dnstypes.NewWildcardDomainValue("*.example.com"),
+			hostname:      "www_1.example.com",
+			expectedMatch: false,
+		},
+		"wildcard - does not match wildcard hostname": {
+			domain:        dnstypes.NewWildcardDomainValue("*.example.com"),
+			hostname:      "*.example.com",
+			expectedMatch: false,
+		},
+		"hostname - matches exactly": {
+			domain:        dnstypes.NewWildcardDomainValue("www.example.com"),
+			hostname:      "WWW.example.com.",
+			expectedMatch: true,
+		},
+		"hostname - does not match subdomain": {
+			domain:        dnstypes.NewWildcardDomainValue("example.com"),
+			hostname:      "www.example.com",
+			expectedMatch: false,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.domain.Matches(testCase.hostname)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected Matches to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package hwtypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = (*ClientIdentifierType)(nil)
+
+// ClientIdentifierType is an attribute type that represents a valid DHCPv4 client identifier (RFC 2132 Section 9.14),
+// made up of a type octet followed by the identifier, such as a hardware type and address (RFC 2132) or an IAID and
+// DUID (RFC 4361). Semantic equality logic is defined for ClientIdentifierType, so that identifiers expressed with
+// varying case and separators are considered equal.
+//
+// All of the following are semantically equal:
+//   - 01:00:00:5e:00:53:01
+//   - 01:00:00:5E:00:53:01
+//   - 01-00-00-5e-00-53-01
+//   - 1:0:0:5e:0:53:1
+//   - 0100.005e.0053.01
+//   - 0100005e005301
+type ClientIdentifierType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t ClientIdentifierType) String() string {
+	return "hwtypes.ClientIdentifierType"
+}
+
+// ValueType returns the Value type.
+func (t ClientIdentifierType) ValueType(ctx context.Context) attr.Value {
+	return ClientIdentifier{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t ClientIdentifierType) Equal(o attr.Type) bool {
+	other, ok := o.(ClientIdentifierType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t ClientIdentifierType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return ClientIdentifier{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t ClientIdentifierType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
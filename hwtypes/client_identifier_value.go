@@ -0,0 +1,262 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package hwtypes
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*ClientIdentifier)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*ClientIdentifier)(nil)
+	_ xattr.ValidateableAttribute                = (*ClientIdentifier)(nil)
+	_ function.ValidateableParameter             = (*ClientIdentifier)(nil)
+)
+
+const (
+	// clientIdentifierTypeEthernet is the client identifier type octet for an Ethernet hardware address (RFC 1700 hardware type 1).
+	clientIdentifierTypeEthernet = 1
+
+	// clientIdentifierTypeDUID is the client identifier type octet for an IAID and DUID (RFC 4361 Section 6.1).
+	clientIdentifierTypeDUID = 255
+
+	// clientIdentifierMaxLength is the maximum length in octets of a DHCPv4 option value.
+	clientIdentifierMaxLength = 255
+)
+
+// ClientIdentifier represents a valid DHCPv4 client identifier (RFC 2132 Section 9.14), made up of a type octet
+// followed by the identifier. A type of 0 is an arbitrary identifier, a type of 255 is an IAID followed by a DUID
+// (RFC 4361), and any other type is a hardware type followed by a hardware address. The identifier is written as
+// hexadecimal octets, which may be colon- or hyphen-delimited with optional leading zeroes, dot-delimited in groups
+// of two octets, or undelimited. Semantic equality logic is defined for ClientIdentifier, so that identifiers
+// expressed with varying case and separators are considered equal.
+//
+// All of the following are semantically equal:
+//   - 01:00:00:5e:00:53:01
+//   - 01:00:00:5E:00:53:01
+//   - 01-00-00-5e-00-53-01
+//   - 1:0:0:5e:0:53:1
+//   - 0100.005e.0053.01
+//   - 0100005e005301
+type ClientIdentifier struct {
+	basetypes.StringValue
+}
+
+// Type returns a ClientIdentifierType.
+func (v ClientIdentifier) Type(_ context.Context) attr.Type {
+	return ClientIdentifierType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v ClientIdentifier) Equal(o attr.Value) bool {
+	other, ok := o.(ClientIdentifier)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given client identifier string value is semantically equal to the current
+// client identifier string value. This comparison decodes both values into their octets, so that identifiers expressed
+// with varying case and separators are considered equal.
+//
+// All of the following are semantically equal:
+//   - 01:00:00:5e:00:53:01
+//   - 01:00:00:5E:00:53:01
+//   - 01-00-00-5e-00-53-01
+//   - 1:0:0:5e:0:53:1
+//   - 0100.005e.0053.01
+//   - 0100005e005301
+func (v ClientIdentifier) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(ClientIdentifier)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// Client identifiers are already validated at this point, ignoring errors
+	newClientID, _ := parseClientIdentifier(newValue.ValueString())
+	currentClientID, _ := parseClientIdentifier(v.ValueString())
+
+	return bytes.Equal(currentClientID, newClientID), diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String
+// value that is a valid client identifier of between 2 and 255 octets. Ethernet (type 1) identifiers must contain a
+// six octet MAC address and RFC 4361 (type 255) identifiers must contain an IAID and a valid DUID.
+func (v ClientIdentifier) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseClientIdentifier(v.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Client Identifier String Value",
+			"A string value was provided that is not valid DHCP client identifier string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is a valid client identifier of between 2 and 255 octets. Ethernet (type 1)
+// identifiers must contain a six octet MAC address and RFC 4361 (type 255) identifiers must contain an IAID and a valid DUID.
+func (v ClientIdentifier) ValidateParameter(_ context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseClientIdentifier(v.ValueString())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid Client Identifier String Value: "+
+				"A string value was provided that is not valid DHCP client identifier string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueClientIdentifier decodes the ClientIdentifier StringValue into its octets, the first of which is the type. A
+// null or unknown value will produce an error diagnostic.
+func (v ClientIdentifier) ValueClientIdentifier() ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("ClientIdentifier ValueClientIdentifier Error", "client identifier string value is null"))
+		return nil, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("ClientIdentifier ValueClientIdentifier Error", "client identifier string value is unknown"))
+		return nil, diags
+	}
+
+	clientID, err := parseClientIdentifier(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("ClientIdentifier ValueClientIdentifier Error", err.Error()))
+		return nil, diags
+	}
+
+	return clientID, nil
+}
+
+// ValueLinkLayerAddress returns the hardware address embedded in the client identifier as a MACAddress. For an RFC 4361
+// (type 255) identifier, the link-layer address of the embedded DUID-LLT or DUID-LL is returned. An arbitrary (type 0)
+// identifier, an address that is not 6, 8 or 20 octets, or a null or unknown value will produce an error diagnostic.
+func (v ClientIdentifier) ValueLinkLayerAddress() (MACAddress, diag.Diagnostics) {
+	clientID, diags := v.ValueClientIdentifier()
+	if diags.HasError() {
+		return NewMACAddressNull(), diags
+	}
+
+	var linkLayerAddr []byte
+	var err error
+
+	switch clientID[0] {
+	case 0:
+		err = errors.New("client identifier type 0 is an arbitrary identifier and does not contain a hardware address")
+	case clientIdentifierTypeDUID:
+		linkLayerAddr, err = duidLinkLayerAddress(clientID[5:])
+	default:
+		linkLayerAddr = clientID[1:]
+
+		if !isMACAddressLength(len(linkLayerAddr)) {
+			err = fmt.Errorf("hardware address must be 6, 8 or 20 octets to be represented as a MAC address, got %d", len(linkLayerAddr))
+		}
+	}
+
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("ClientIdentifier ValueLinkLayerAddress Error", err.Error()))
+		return NewMACAddressNull(), diags
+	}
+
+	return newMACAddressFromOctets(linkLayerAddr), nil
+}
+
+// parseClientIdentifier decodes a client identifier string into its octets and validates the length for its type.
+func parseClientIdentifier(s string) ([]byte, error) {
+	clientID, err := parseHexOctets(s)
+	if err != nil {
+		return nil, fmt.Errorf("client identifier %q: %w", s, err)
+	}
+
+	if len(clientID) < 2 || len(clientID) > clientIdentifierMaxLength {
+		return nil, fmt.Errorf("client identifier %q: must be between 2 and %d octets, got %d", s, clientIdentifierMaxLength, len(clientID))
+	}
+
+	switch clientID[0] {
+	case clientIdentifierTypeEthernet:
+		if len(clientID) != 7 {
+			return nil, fmt.Errorf("client identifier %q: Ethernet (type 1) must be a type octet and 6 octet MAC address, got %d octets", s, len(clientID))
+		}
+	case clientIdentifierTypeDUID:
+		// type, 4 octet IAID and DUID
+		if len(clientID) < 5 {
+			return nil, fmt.Errorf("client identifier %q: RFC 4361 (type 255) must be a type octet, 4 octet IAID and DUID, got %d octets", s, len(clientID))
+		}
+
+		err = validateDUID(clientID[5:])
+		if err != nil {
+			return nil, fmt.Errorf("client identifier %q: RFC 4361 (type 255) DUID %w", s, err)
+		}
+	}
+
+	return clientID, nil
+}
+
+// NewClientIdentifierNull creates a ClientIdentifier with a null value. Determine whether the value is null via IsNull method.
+func NewClientIdentifierNull() ClientIdentifier {
+	return ClientIdentifier{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewClientIdentifierUnknown creates a ClientIdentifier with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewClientIdentifierUnknown() ClientIdentifier {
+	return ClientIdentifier{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewClientIdentifierValue creates a ClientIdentifier with a known value. Access the value via ValueString method.
+func NewClientIdentifierValue(value string) ClientIdentifier {
+	return ClientIdentifier{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewClientIdentifierPointerValue creates a ClientIdentifier with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewClientIdentifierPointerValue(value *string) ClientIdentifier {
+	return ClientIdentifier{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
@@ -0,0 +1,325 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package hwtypes_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/hwtypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestClientIdentifierStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentClientID hwtypes.ClientIdentifier
+		givenClientID   basetypes.StringValuable
+		expectedMatch   bool
+		expectedDiags   diag.Diagnostics
+	}{
+		"semantically equal - colon-delimited byte-for-byte match": {
+			currentClientID: hwtypes.NewClientIdentifierValue("01:00:00:5e:00:53:01"),
+			givenClientID:   hwtypes.NewClientIdentifierValue("01:00:00:5e:00:53:01"),
+			expectedMatch:   true,
+		},
+		"semantically equal - case insensitive": {
+			currentClientID: hwtypes.NewClientIdentifierValue("01:00:00:5e:00:53:01"),
+			givenClientID:   hwtypes.NewClientIdentifierValue("01:00:00:5E:00:53:01"),
+			expectedMatch:   true,
+		},
+		"semantically equal - leading zeroes omitted": {
+			currentClientID: hwtypes.NewClientIdentifierValue("01:00:00:5e:00:53:01"),
+			givenClientID:   hwtypes.NewClientIdentifierValue("1:0:0:5e:0:53:1"),
+			expectedMatch:   true,
+		},
+		"semantically equal - colon vs dot delimited": {
+			currentClientID: hwtypes.NewClientIdentifierValue("01:00:00:5e:00:53:01"),
+			givenClientID:   hwtypes.NewClientIdentifierValue("0100.005e.0053.01"),
+			expectedMatch:   true,
+		},
+		"semantically equal - hyphen vs undelimited": {
+			currentClientID: hwtypes.NewClientIdentifierValue("01-00-00-5e-00-53-01"),
+			givenClientID:   hwtypes.NewClientIdentifierValue("0100005e005301"),
+			expectedMatch:   true,
+		},
+		"not equal - type mismatch": {
+			currentClientID: hwtypes.NewClientIdentifierValue("01:00:00:5e:00:53:01"),
+			givenClientID:   hwtypes.NewClientIdentifierValue("00:00:00:5e:00:53:01"),
+			expectedMatch:   false,
+		},
+		"error - not given ClientIdentifier value": {
+			currentClientID: hwtypes.NewClientIdentifierValue("01:00:00:5e:00:53:01"),
+			givenClientID:   basetypes.NewStringValue("01:00:00:5e:00:53:01"),
+			expectedMatch:   false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: hwtypes.ClientIdentifier\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentClientID.StringSemanticEquals(context.Background(), testCase.givenClientID)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestClientIdentifierValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		clientIDValue hwtypes.ClientIdentifier
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			clientIDValue: hwtypes.ClientIdentifier{},
+		},
+		"null": {
+			clientIDValue: hwtypes.NewClientIdentifierNull(),
+		},
+		"unknown": {
+			clientIDValue: hwtypes.NewClientIdentifierUnknown(),
+		},
+		"valid client identifier - Ethernet": {
+			clientIDValue: hwtypes.NewClientIdentifierValue("01:00:00:5e:00:53:01"),
+		},
+		"valid client identifier - arbitrary": {
+			clientIDValue: hwtypes.NewClientIdentifierValue("00:68:6f:73:74"),
+		},
+		"valid client identifier - RFC 4361": {
+			clientIDValue: hwtypes.NewClientIdentifierValue("ff:00:00:00:01:00:03:00:01:00:00:5e:00:53:01"),
+		},
+		"invalid client identifier - too short": {
+			clientIDValue: hwtypes.NewClientIdentifierValue("01"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Client Identifier String Value",
+					"A string value was provided that is not valid DHCP client identifier string format.\n\n"+
+						"Given Value: 01\n"+
+						"Error: client identifier \"01\": must be between 2 and 255 octets, got 1",
+				),
+			},
+		},
+		"invalid client identifier - Ethernet length": {
+			clientIDValue: hwtypes.NewClientIdentifierValue("01:00:00:5e:00:53"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Client Identifier String Value",
+					"A string value was provided that is not valid DHCP client identifier string format.\n\n"+
+						"Given Value: 01:00:00:5e:00:53\n"+
+						"Error: client identifier \"01:00:00:5e:00:53\": Ethernet (type 1) must be a type octet and 6 octet MAC address, got 6 octets",
+				),
+			},
+		},
+		"invalid client identifier - RFC 4361 invalid DUID": {
+			clientIDValue: hwtypes.NewClientIdentifierValue("ff:00:00:00:01:00:09:00:01"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Client Identifier String Value",
+					"A string value was provided that is not valid DHCP client identifier string format.\n\n"+
+						"Given Value: ff:00:00:00:01:00:09:00:01\n"+
+						"Error: client identifier \"ff:00:00:00:01:00:09:00:01\": RFC 4361 (type 255) DUID unknown DUID type 9, expected 1 (DUID-LLT), 2 (DUID-EN), 3 (DUID-LL) or 4 (DUID-UUID)",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.clientIDValue.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{Path: path.Root("test")},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestClientIdentifierValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		clientIDValue   hwtypes.ClientIdentifier
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			clientIDValue: hwtypes.ClientIdentifier{},
+		},
+		"null": {
+			clientIDValue: hwtypes.NewClientIdentifierNull(),
+		},
+		"unknown": {
+			clientIDValue: hwtypes.NewClientIdentifierUnknown(),
+		},
+		"valid client identifier": {
+			clientIDValue: hwtypes.NewClientIdentifierValue("0100.005e.0053.01"),
+		},
+		"invalid client identifier - RFC 4361 missing IAID": {
+			clientIDValue: hwtypes.NewClientIdentifierValue("ff:00:00"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid Client Identifier String Value: "+
+					"A string value was provided that is not valid DHCP client identifier string format.\n\n"+
+					"Given Value: ff:00:00\n"+
+					"Error: client identifier \"ff:00:00\": RFC 4361 (type 255) must be a type octet, 4 octet IAID and DUID, got 3 octets",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.clientIDValue.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestClientIdentifierValueClientIdentifier(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		clientIDValue    hwtypes.ClientIdentifier
+		expectedClientID []byte
+		expectedDiags    diag.Diagnostics
+	}{
+		"client identifier value is null": {
+			clientIDValue: hwtypes.NewClientIdentifierNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"ClientIdentifier ValueClientIdentifier Error",
+					"client identifier string value is null",
+				),
+			},
+		},
+		"client identifier value is unknown": {
+			clientIDValue: hwtypes.NewClientIdentifierUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"ClientIdentifier ValueClientIdentifier Error",
+					"client identifier string value is unknown",
+				),
+			},
+		},
+		"valid client identifier": {
+			clientIDValue:    hwtypes.NewClientIdentifierValue("1:0:0:5e:0:53:1"),
+			expectedClientID: []byte{0x01, 0x00, 0x00, 0x5e, 0x00, 0x53, 0x01},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			clientID, diags := testCase.clientIDValue.ValueClientIdentifier()
+
+			if !bytes.Equal(clientID, testCase.expectedClientID) {
+				t.Errorf("Unexpected difference in client identifier, got: %x, expected: %x", clientID, testCase.expectedClientID)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestClientIdentifierValueLinkLayerAddress(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		clientIDValue      hwtypes.ClientIdentifier
+		expectedMACAddress hwtypes.MACAddress
+		expectedDiags      diag.Diagnostics
+	}{
+		"Ethernet": {
+			clientIDValue:      hwtypes.NewClientIdentifierValue("0100.005E.0053.01"),
+			expectedMACAddress: hwtypes.NewMACAddressValue("00:00:5e:00:53:01"),
+		},
+		"RFC 4361 with DUID-LL": {
+			clientIDValue:      hwtypes.NewClientIdentifierValue("ff:00:00:00:01:00:03:00:01:00:00:5e:00:53:01"),
+			expectedMACAddress: hwtypes.NewMACAddressValue("00:00:5e:00:53:01"),
+		},
+		"RFC 4361 with DUID-EN": {
+			clientIDValue:      hwtypes.NewClientIdentifierValue("ff:00:00:00:01:00:02:00:00:00:09:0c:c0:84:d3:03:00:09:12"),
+			expectedMACAddress: hwtypes.NewMACAddressNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"ClientIdentifier ValueLinkLayerAddress Error",
+					"DUID type 2 does not contain a link-layer address, expected 1 (DUID-LLT) or 3 (DUID-LL)",
+				),
+			},
+		},
+		"arbitrary": {
+			clientIDValue:      hwtypes.NewClientIdentifierValue("00:68:6f:73:74"),
+			expectedMACAddress: hwtypes.NewMACAddressNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"ClientIdentifier ValueLinkLayerAddress Error",
+					"client identifier type 0 is an arbitrary identifier and does not contain a hardware address",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			macAddress, diags := testCase.clientIDValue.ValueLinkLayerAddress()
+
+			if !macAddress.Equal(testCase.expectedMACAddress) {
+				t.Errorf("Unexpected difference in MACAddress, got: %s, expected: %s", macAddress, testCase.expectedMACAddress)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
@@ -1,5 +1,6 @@
 // Copyright IBM Corp. 2023, 2026
 // SPDX-License-Identifier: MPL-2.0
 
-// Package hwtypes contains Terraform Plugin Framework Custom Type implementations for hardware address strings.
+// Package hwtypes contains Terraform Plugin Framework Custom Type implementations for hardware address and identifier
+// strings, such as MAC addresses, PCI addresses, InfiniBand GUIDs and iSCSI or NVMe storage network names.
 package hwtypes
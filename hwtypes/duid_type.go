@@ -0,0 +1,80 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package hwtypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = (*DUIDType)(nil)
+
+// DUIDType is an attribute type that represents a valid DHCPv6 DHCP Unique Identifier (RFC 8415), of type DUID-LLT,
+// DUID-EN, DUID-LL or DUID-UUID. Semantic equality logic is defined for DUIDType, so that identifiers expressed with
+// varying case and separators are considered equal.
+//
+// All of the following are semantically equal:
+//   - 00:03:00:01:00:00:5e:00:53:01
+//   - 00:03:00:01:00:00:5E:00:53:01
+//   - 00-03-00-01-00-00-5e-00-53-01
+//   - 0:3:0:1:0:0:5e:0:53:1
+//   - 0003.0001.0000.5e00.5301
+//   - 0003000100005e005301
+type DUIDType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t DUIDType) String() string {
+	return "hwtypes.DUIDType"
+}
+
+// ValueType returns the Value type.
+func (t DUIDType) ValueType(ctx context.Context) attr.Value {
+	return DUID{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t DUIDType) Equal(o attr.Type) bool {
+	other, ok := o.(DUIDType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t DUIDType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return DUID{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t DUIDType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
@@ -0,0 +1,293 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package hwtypes
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*DUID)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*DUID)(nil)
+	_ xattr.ValidateableAttribute                = (*DUID)(nil)
+	_ function.ValidateableParameter             = (*DUID)(nil)
+)
+
+const (
+	// DUIDTypeLLT is a DUID based on link-layer address plus time (RFC 8415 Section 11.2).
+	DUIDTypeLLT = 1
+
+	// DUIDTypeEN is a DUID assigned by vendor based on enterprise number (RFC 8415 Section 11.3).
+	DUIDTypeEN = 2
+
+	// DUIDTypeLL is a DUID based on link-layer address (RFC 8415 Section 11.4).
+	DUIDTypeLL = 3
+
+	// DUIDTypeUUID is a DUID based on a universally unique identifier (RFC 8415 Section 11.5).
+	DUIDTypeUUID = 4
+
+	// duidMaxLength is the maximum length in octets of a DUID, including the two octet type code (RFC 8415 Section 11.1).
+	duidMaxLength = 130
+)
+
+// DUID represents a valid DHCPv6 DHCP Unique Identifier (RFC 8415), of type DUID-LLT, DUID-EN, DUID-LL or DUID-UUID.
+// The identifier is written as hexadecimal octets, which may be colon- or hyphen-delimited with optional leading
+// zeroes, dot-delimited in groups of two octets, or undelimited. Semantic equality logic is defined for DUID, so that
+// identifiers expressed with varying case and separators are considered equal.
+//
+// All of the following are semantically equal:
+//   - 00:03:00:01:00:00:5e:00:53:01
+//   - 00:03:00:01:00:00:5E:00:53:01
+//   - 00-03-00-01-00-00-5e-00-53-01
+//   - 0:3:0:1:0:0:5e:0:53:1
+//   - 0003.0001.0000.5e00.5301
+//   - 0003000100005e005301
+type DUID struct {
+	basetypes.StringValue
+}
+
+// Type returns a DUIDType.
+func (v DUID) Type(_ context.Context) attr.Type {
+	return DUIDType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v DUID) Equal(o attr.Value) bool {
+	other, ok := o.(DUID)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given DUID string value is semantically equal to the current DUID string value.
+// This comparison decodes both values into their octets, so that identifiers expressed with varying case and separators
+// are considered equal.
+//
+// All of the following are semantically equal:
+//   - 00:03:00:01:00:00:5e:00:53:01
+//   - 00:03:00:01:00:00:5E:00:53:01
+//   - 00-03-00-01-00-00-5e-00-53-01
+//   - 0:3:0:1:0:0:5e:0:53:1
+//   - 0003.0001.0000.5e00.5301
+//   - 0003000100005e005301
+func (v DUID) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(DUID)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// DUIDs are already validated at this point, ignoring errors
+	newDUID, _ := parseDUID(newValue.ValueString())
+	currentDUID, _ := parseDUID(v.ValueString())
+
+	return bytes.Equal(currentDUID, newDUID), diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String
+// value that is a valid DUID of a type defined by RFC 8415, with a length appropriate for that type.
+func (v DUID) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseDUID(v.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid DUID String Value",
+			"A string value was provided that is not valid DHCP Unique Identifier string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is a valid DUID of a type defined by RFC 8415, with a length appropriate for that type.
+func (v DUID) ValidateParameter(_ context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseDUID(v.ValueString())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid DUID String Value: "+
+				"A string value was provided that is not valid DHCP Unique Identifier string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueDUID decodes the DUID StringValue into its octets, the first two of which are the DUID type code. A null or
+// unknown value will produce an error diagnostic.
+func (v DUID) ValueDUID() ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("DUID ValueDUID Error", "DUID string value is null"))
+		return nil, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("DUID ValueDUID Error", "DUID string value is unknown"))
+		return nil, diags
+	}
+
+	duid, err := parseDUID(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("DUID ValueDUID Error", err.Error()))
+		return nil, diags
+	}
+
+	return duid, nil
+}
+
+// ValueLinkLayerAddress returns the link-layer address embedded in a DUID-LLT or DUID-LL as a MACAddress. Any other
+// DUID type, a link-layer address that is not 6, 8 or 20 octets, or a null or unknown value will produce an error diagnostic.
+func (v DUID) ValueLinkLayerAddress() (MACAddress, diag.Diagnostics) {
+	duid, diags := v.ValueDUID()
+	if diags.HasError() {
+		return NewMACAddressNull(), diags
+	}
+
+	linkLayerAddr, err := duidLinkLayerAddress(duid)
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("DUID ValueLinkLayerAddress Error", err.Error()))
+		return NewMACAddressNull(), diags
+	}
+
+	return newMACAddressFromOctets(linkLayerAddr), nil
+}
+
+// parseDUID decodes a DUID string into its octets and validates the type and length.
+func parseDUID(s string) ([]byte, error) {
+	duid, err := parseHexOctets(s)
+	if err != nil {
+		return nil, fmt.Errorf("DUID %q: %w", s, err)
+	}
+
+	err = validateDUID(duid)
+	if err != nil {
+		return nil, fmt.Errorf("DUID %q: %w", s, err)
+	}
+
+	return duid, nil
+}
+
+// validateDUID validates the type code and length of DUID octets.
+func validateDUID(duid []byte) error {
+	if len(duid) < 3 {
+		return fmt.Errorf("must be at least 3 octets, got %d", len(duid))
+	}
+
+	if len(duid) > duidMaxLength {
+		return fmt.Errorf("must be at most %d octets, got %d", duidMaxLength, len(duid))
+	}
+
+	var name string
+	var minLength, maxLength int
+
+	switch duidType := binary.BigEndian.Uint16(duid); duidType {
+	case DUIDTypeLLT:
+		// type, hardware type, time and a non-empty link-layer address
+		name, minLength, maxLength = "DUID-LLT", 9, duidMaxLength
+	case DUIDTypeEN:
+		// type, enterprise number and a non-empty identifier
+		name, minLength, maxLength = "DUID-EN", 7, duidMaxLength
+	case DUIDTypeLL:
+		// type, hardware type and a non-empty link-layer address
+		name, minLength, maxLength = "DUID-LL", 5, duidMaxLength
+	case DUIDTypeUUID:
+		// type and a 16 octet UUID
+		name, minLength, maxLength = "DUID-UUID", 18, 18
+	default:
+		return fmt.Errorf("unknown DUID type %d, expected 1 (DUID-LLT), 2 (DUID-EN), 3 (DUID-LL) or 4 (DUID-UUID)", duidType)
+	}
+
+	if minLength == maxLength && len(duid) != minLength {
+		return fmt.Errorf("%s must be exactly %d octets, got %d", name, minLength, len(duid))
+	}
+
+	if len(duid) < minLength {
+		return fmt.Errorf("%s must be at least %d octets, got %d", name, minLength, len(duid))
+	}
+
+	return nil
+}
+
+// duidLinkLayerAddress returns the link-layer address embedded in validated DUID-LLT or DUID-LL octets.
+func duidLinkLayerAddress(duid []byte) ([]byte, error) {
+	var linkLayerAddr []byte
+
+	switch duidType := binary.BigEndian.Uint16(duid); duidType {
+	case DUIDTypeLLT:
+		linkLayerAddr = duid[8:]
+	case DUIDTypeLL:
+		linkLayerAddr = duid[4:]
+	default:
+		return nil, fmt.Errorf("DUID type %d does not contain a link-layer address, expected 1 (DUID-LLT) or 3 (DUID-LL)", duidType)
+	}
+
+	if !isMACAddressLength(len(linkLayerAddr)) {
+		return nil, fmt.Errorf("link-layer address must be 6, 8 or 20 octets to be represented as a MAC address, got %d", len(linkLayerAddr))
+	}
+
+	return linkLayerAddr, nil
+}
+
+// NewDUIDNull creates a DUID with a null value. Determine whether the value is null via IsNull method.
+func NewDUIDNull() DUID {
+	return DUID{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewDUIDUnknown creates a DUID with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewDUIDUnknown() DUID {
+	return DUID{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewDUIDValue creates a DUID with a known value. Access the value via ValueString method.
+func NewDUIDValue(value string) DUID {
+	return DUID{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewDUIDPointerValue creates a DUID with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewDUIDPointerValue(value *string) DUID {
+	return DUID{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package hwtypes_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/hwtypes"
+)
+
+type DHCPReservationResourceModel struct {
+	DUID             hwtypes.DUID             `tfsdk:"duid"`
+	ClientIdentifier hwtypes.ClientIdentifier `tfsdk:"client_identifier"`
+}
+
+func ExampleDUID_ValueLinkLayerAddress() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := DHCPReservationResourceModel{
+		DUID: hwtypes.NewDUIDValue("0:1:0:1:2b:3c:4d:5e:0:0:5e:0:53:1"),
+	}
+
+	// Check that the DUID data is known and contains a link-layer address
+	if !data.DUID.IsNull() && !data.DUID.IsUnknown() {
+		macAddress, diags := data.DUID.ValueLinkLayerAddress()
+		if diags.HasError() {
+			return
+		}
+
+		// Output: 00:00:5e:00:53:01
+		fmt.Println(macAddress.ValueString())
+	}
+}
+
+func ExampleClientIdentifier_ValueLinkLayerAddress() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := DHCPReservationResourceModel{
+		ClientIdentifier: hwtypes.NewClientIdentifierValue("0100.005e.0053.01"),
+	}
+
+	// Check that the ClientIdentifier data is known and contains a hardware address
+	if !data.ClientIdentifier.IsNull() && !data.ClientIdentifier.IsUnknown() {
+		macAddress, diags := data.ClientIdentifier.ValueLinkLayerAddress()
+		if diags.HasError() {
+			return
+		}
+
+		// Output: 00:00:5e:00:53:01
+		fmt.Println(macAddress.ValueString())
+	}
+}
@@ -0,0 +1,359 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package hwtypes_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/hwtypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestDUIDStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentDUID   hwtypes.DUID
+		givenDUID     basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"semantically equal - colon-delimited byte-for-byte match": {
+			currentDUID:   hwtypes.NewDUIDValue("00:03:00:01:00:00:5e:00:53:01"),
+			givenDUID:     hwtypes.NewDUIDValue("00:03:00:01:00:00:5e:00:53:01"),
+			expectedMatch: true,
+		},
+		"semantically equal - case insensitive": {
+			currentDUID:   hwtypes.NewDUIDValue("00:03:00:01:00:00:5e:00:53:01"),
+			givenDUID:     hwtypes.NewDUIDValue("00:03:00:01:00:00:5E:00:53:01"),
+			expectedMatch: true,
+		},
+		"semantically equal - colon vs hyphen delimited": {
+			currentDUID:   hwtypes.NewDUIDValue("00:03:00:01:00:00:5e:00:53:01"),
+			givenDUID:     hwtypes.NewDUIDValue("00-03-00-01-00-00-5e-00-53-01"),
+			expectedMatch: true,
+		},
+		"semantically equal - leading zeroes omitted": {
+			currentDUID:   hwtypes.NewDUIDValue("00:03:00:01:00:00:5e:00:53:01"),
+			givenDUID:     hwtypes.NewDUIDValue("0:3:0:1:0:0:5e:0:53:1"),
+			expectedMatch: true,
+		},
+		"semantically equal - colon vs dot delimited": {
+			currentDUID:   hwtypes.NewDUIDValue("00:03:00:01:00:00:5e:00:53:01"),
+			givenDUID:     hwtypes.NewDUIDValue("0003.0001.0000.5e00.5301"),
+			expectedMatch: true,
+		},
+		"semantically equal - colon vs undelimited": {
+			currentDUID:   hwtypes.NewDUIDValue("00:03:00:01:00:00:5e:00:53:01"),
+			givenDUID:     hwtypes.NewDUIDValue("0003000100005E005301"),
+			expectedMatch: true,
+		},
+		"not equal - link-layer address mismatch": {
+			currentDUID:   hwtypes.NewDUIDValue("00:03:00:01:00:00:5e:00:53:01"),
+			givenDUID:     hwtypes.NewDUIDValue("00:03:00:01:00:00:5e:00:53:02"),
+			expectedMatch: false,
+		},
+		"error - not given DUID value": {
+			currentDUID:   hwtypes.NewDUIDValue("00:03:00:01:00:00:5e:00:53:01"),
+			givenDUID:     basetypes.NewStringValue("00:03:00:01:00:00:5e:00:53:01"),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: hwtypes.DUID\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentDUID.StringSemanticEquals(context.Background(), testCase.givenDUID)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDUIDValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		duidValue     hwtypes.DUID
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			duidValue: hwtypes.DUID{},
+		},
+		"null": {
+			duidValue: hwtypes.NewDUIDNull(),
+		},
+		"unknown": {
+			duidValue: hwtypes.NewDUIDUnknown(),
+		},
+		"valid DUID-LLT": {
+			duidValue: hwtypes.NewDUIDValue("00:01:00:01:2b:3c:4d:5e:00:00:5e:00:53:01"),
+		},
+		"valid DUID-EN": {
+			duidValue: hwtypes.NewDUIDValue("00:02:00:00:00:09:0c:c0:84:d3:03:00:09:12"),
+		},
+		"valid DUID-LL": {
+			duidValue: hwtypes.NewDUIDValue("00:03:00:01:00:00:5e:00:53:01"),
+		},
+		"valid DUID-UUID": {
+			duidValue: hwtypes.NewDUIDValue("00:04:6b:a7:b8:10:9d:ad:11:d1:80:b4:00:c0:4f:d4:30:c8"),
+		},
+		"invalid DUID - unknown type": {
+			duidValue: hwtypes.NewDUIDValue("00:05:00:01:00:00:5e:00:53:01"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid DUID String Value",
+					"A string value was provided that is not valid DHCP Unique Identifier string format.\n\n"+
+						"Given Value: 00:05:00:01:00:00:5e:00:53:01\n"+
+						"Error: DUID \"00:05:00:01:00:00:5e:00:53:01\": unknown DUID type 5, expected 1 (DUID-LLT), 2 (DUID-EN), 3 (DUID-LL) or 4 (DUID-UUID)",
+				),
+			},
+		},
+		"invalid DUID - DUID-LLT too short": {
+			duidValue: hwtypes.NewDUIDValue("00:01:00:01:2b:3c:4d:5e"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid DUID String Value",
+					"A string value was provided that is not valid DHCP Unique Identifier string format.\n\n"+
+						"Given Value: 00:01:00:01:2b:3c:4d:5e\n"+
+						"Error: DUID \"00:01:00:01:2b:3c:4d:5e\": DUID-LLT must be at least 9 octets, got 8",
+				),
+			},
+		},
+		"invalid DUID - DUID-UUID length": {
+			duidValue: hwtypes.NewDUIDValue("00:04:6b:a7:b8:10"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid DUID String Value",
+					"A string value was provided that is not valid DHCP Unique Identifier string format.\n\n"+
+						"Given Value: 00:04:6b:a7:b8:10\n"+
+						"Error: DUID \"00:04:6b:a7:b8:10\": DUID-UUID must be exactly 18 octets, got 6",
+				),
+			},
+		},
+		"invalid DUID - bogus digit": {
+			duidValue: hwtypes.NewDUIDValue("00:03:00:01:00:00:5g:00:53:01"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid DUID String Value",
+					"A string value was provided that is not valid DHCP Unique Identifier string format.\n\n"+
+						"Given Value: 00:03:00:01:00:00:5g:00:53:01\n"+
+						"Error: DUID \"00:03:00:01:00:00:5g:00:53:01\": must be hexadecimal octets, optionally delimited by colons, hyphens or dots",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.duidValue.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{Path: path.Root("test")},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDUIDValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		duidValue       hwtypes.DUID
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			duidValue: hwtypes.DUID{},
+		},
+		"null": {
+			duidValue: hwtypes.NewDUIDNull(),
+		},
+		"unknown": {
+			duidValue: hwtypes.NewDUIDUnknown(),
+		},
+		"valid DUID-LL": {
+			duidValue: hwtypes.NewDUIDValue("00:03:00:01:00:00:5e:00:53:01"),
+		},
+		"invalid DUID - too short": {
+			duidValue: hwtypes.NewDUIDValue("00:03"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid DUID String Value: "+
+					"A string value was provided that is not valid DHCP Unique Identifier string format.\n\n"+
+					"Given Value: 00:03\n"+
+					"Error: DUID \"00:03\": must be at least 3 octets, got 2",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.duidValue.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDUIDValueDUID(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		duidValue     hwtypes.DUID
+		expectedDUID  []byte
+		expectedDiags diag.Diagnostics
+	}{
+		"DUID value is null": {
+			duidValue: hwtypes.NewDUIDNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"DUID ValueDUID Error",
+					"DUID string value is null",
+				),
+			},
+		},
+		"DUID value is unknown": {
+			duidValue: hwtypes.NewDUIDUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"DUID ValueDUID Error",
+					"DUID string value is unknown",
+				),
+			},
+		},
+		"valid DUID": {
+			duidValue:    hwtypes.NewDUIDValue("0:3:0:1:0:0:5e:0:53:1"),
+			expectedDUID: []byte{0x00, 0x03, 0x00, 0x01, 0x00, 0x00, 0x5e, 0x00, 0x53, 0x01},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			duid, diags := testCase.duidValue.ValueDUID()
+
+			if !bytes.Equal(duid, testCase.expectedDUID) {
+				t.Errorf("Unexpected difference in DUID, got: %x, expected: %x", duid, testCase.expectedDUID)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDUIDValueLinkLayerAddress(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		duidValue          hwtypes.DUID
+		expectedMACAddress hwtypes.MACAddress
+		expectedDiags      diag.Diagnostics
+	}{
+		"DUID value is null": {
+			duidValue:          hwtypes.NewDUIDNull(),
+			expectedMACAddress: hwtypes.NewMACAddressNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"DUID ValueDUID Error",
+					"DUID string value is null",
+				),
+			},
+		},
+		"DUID-LLT": {
+			duidValue:          hwtypes.NewDUIDValue("00:01:00:01:2b:3c:4d:5e:00:00:5E:00:53:01"),
+			expectedMACAddress: hwtypes.NewMACAddressValue("00:00:5e:00:53:01"),
+		},
+		"DUID-LL": {
+			duidValue:          hwtypes.NewDUIDValue("0003.0001.0000.5e00.5301"),
+			expectedMACAddress: hwtypes.NewMACAddressValue("00:00:5e:00:53:01"),
+		},
+		"DUID-LL - EUI-64": {
+			duidValue:          hwtypes.NewDUIDValue("00:03:00:1b:02:00:5e:10:00:00:00:01"),
+			expectedMACAddress: hwtypes.NewMACAddressValue("02:00:5e:10:00:00:00:01"),
+		},
+		"DUID-LL - unsupported link-layer address length": {
+			duidValue:          hwtypes.NewDUIDValue("00:03:00:01:00:00:5e:00"),
+			expectedMACAddress: hwtypes.NewMACAddressNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"DUID ValueLinkLayerAddress Error",
+					"link-layer address must be 6, 8 or 20 octets to be represented as a MAC address, got 4",
+				),
+			},
+		},
+		"DUID-EN": {
+			duidValue:          hwtypes.NewDUIDValue("00:02:00:00:00:09:0c:c0:84:d3:03:00:09:12"),
+			expectedMACAddress: hwtypes.NewMACAddressNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"DUID ValueLinkLayerAddress Error",
+					"DUID type 2 does not contain a link-layer address, expected 1 (DUID-LLT) or 3 (DUID-LL)",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			macAddress, diags := testCase.duidValue.ValueLinkLayerAddress()
+
+			if !macAddress.Equal(testCase.expectedMACAddress) {
+				t.Errorf("Unexpected difference in MACAddress, got: %s, expected: %s", macAddress, testCase.expectedMACAddress)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package hwtypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = (*ESIType)(nil)
+
+// ESIType is an attribute type that represents a valid 10-octet EVPN Ethernet Segment Identifier (RFC 7432), where the
+// first octet is an ESI type between 0 and 5. Semantic equality logic is defined for ESIType, so that identifiers
+// expressed with varying case and notation are considered equal.
+//
+// All of the following are semantically equal:
+//   - 03:00:00:5e:00:53:01:00:00:01
+//   - 03:00:00:5E:00:53:01:00:00:01
+//   - 03-00-00-5e-00-53-01-00-00-01
+//   - 0300.005e.0053.0100.0001
+type ESIType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t ESIType) String() string {
+	return "hwtypes.ESIType"
+}
+
+// ValueType returns the Value type.
+func (t ESIType) ValueType(ctx context.Context) attr.Value {
+	return ESI{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t ESIType) Equal(o attr.Type) bool {
+	other, ok := o.(ESIType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t ESIType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return ESI{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t ESIType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
@@ -0,0 +1,267 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package hwtypes
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*ESI)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*ESI)(nil)
+	_ xattr.ValidateableAttribute                = (*ESI)(nil)
+	_ function.ValidateableParameter             = (*ESI)(nil)
+)
+
+const (
+	// esiLength is the length in octets of an Ethernet Segment Identifier.
+	esiLength = 10
+
+	// ESITypeArbitrary is an ESI with an operator configured value (RFC 7432 Section 5, Type 0).
+	ESITypeArbitrary = 0x00
+
+	// ESITypeLACP is an ESI auto-generated from the CE LACP system MAC address and port key (RFC 7432 Section 5, Type 1).
+	ESITypeLACP = 0x01
+
+	// ESITypeBridge is an ESI auto-generated from the root bridge MAC address and priority (RFC 7432 Section 5, Type 2).
+	ESITypeBridge = 0x02
+
+	// ESITypeMAC is an ESI auto-generated from a system MAC address and local discriminator (RFC 7432 Section 5, Type 3).
+	ESITypeMAC = 0x03
+
+	// ESITypeRouterID is an ESI auto-generated from a router ID and local discriminator (RFC 7432 Section 5, Type 4).
+	ESITypeRouterID = 0x04
+
+	// ESITypeAS is an ESI auto-generated from an autonomous system number and local discriminator (RFC 7432 Section 5, Type 5).
+	ESITypeAS = 0x05
+)
+
+// ESI represents a valid 10-octet EVPN Ethernet Segment Identifier (RFC 7432), where the first octet is an ESI type
+// between 0 and 5. The identifier may be written as colon- or hyphen-delimited octets, or as dot-delimited groups of
+// two octets. Semantic equality logic is defined for ESI, so that identifiers expressed with varying case and notation
+// are considered equal.
+//
+// All of the following are semantically equal:
+//   - 03:00:00:5e:00:53:01:00:00:01
+//   - 03:00:00:5E:00:53:01:00:00:01
+//   - 03-00-00-5e-00-53-01-00-00-01
+//   - 0300.005e.0053.0100.0001
+type ESI struct {
+	basetypes.StringValue
+}
+
+// Type returns an ESIType.
+func (v ESI) Type(_ context.Context) attr.Type {
+	return ESIType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v ESI) Equal(o attr.Value) bool {
+	other, ok := o.(ESI)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given ESI string value is semantically equal to the current ESI string value.
+// This comparison decodes both values into their octets, in the same way as MACAddress, so that identifiers expressed
+// with varying case and notation are considered equal.
+//
+// All of the following are semantically equal:
+//   - 03:00:00:5e:00:53:01:00:00:01
+//   - 03:00:00:5E:00:53:01:00:00:01
+//   - 03-00-00-5e-00-53-01-00-00-01
+//   - 0300.005e.0053.0100.0001
+func (v ESI) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(ESI)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// ESIs are already validated at this point, ignoring errors
+	newESI, _ := parseESI(newValue.ValueString())
+	currentESI, _ := parseESI(v.ValueString())
+
+	return bytes.Equal(currentESI, newESI), diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String
+// value that is a valid 10-octet ESI with an ESI type between 0 and 5.
+func (v ESI) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseESI(v.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid ESI String Value",
+			"A string value was provided that is not valid Ethernet Segment Identifier string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is a valid 10-octet ESI with an ESI type between 0 and 5.
+func (v ESI) ValidateParameter(_ context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseESI(v.ValueString())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid ESI String Value: "+
+				"A string value was provided that is not valid Ethernet Segment Identifier string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueESI decodes the ESI StringValue into its 10 octets, the first of which is the ESI type. A null or unknown value
+// will produce an error diagnostic.
+func (v ESI) ValueESI() ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("ESI ValueESI Error", "ESI string value is null"))
+		return nil, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("ESI ValueESI Error", "ESI string value is unknown"))
+		return nil, diags
+	}
+
+	esi, err := parseESI(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("ESI ValueESI Error", err.Error()))
+		return nil, diags
+	}
+
+	return esi, nil
+}
+
+// ValueMACAddressAndDiscriminator decodes a type 1 (LACP) or type 3 (MAC-based) ESI into its embedded MAC address
+// and discriminator. For a type 1 ESI, the MAC address is the CE LACP system MAC address and the discriminator is the
+// two octet CE LACP port key. For a type 3 ESI, the MAC address is the system MAC address and the discriminator is the
+// three octet local discriminator. Any other ESI type, or a null or unknown value, will produce an error diagnostic.
+func (v ESI) ValueMACAddressAndDiscriminator() (MACAddress, uint32, diag.Diagnostics) {
+	esi, diags := v.ValueESI()
+	if diags.HasError() {
+		return NewMACAddressNull(), 0, diags
+	}
+
+	macAddr := net.HardwareAddr(esi[1:7])
+
+	switch esi[0] {
+	case ESITypeLACP:
+		return NewMACAddressValue(macAddr.String()), uint32(esi[7])<<8 | uint32(esi[8]), nil
+	case ESITypeMAC:
+		return NewMACAddressValue(macAddr.String()), uint32(esi[7])<<16 | uint32(esi[8])<<8 | uint32(esi[9]), nil
+	}
+
+	diags.Append(diag.NewErrorDiagnostic(
+		"ESI ValueMACAddressAndDiscriminator Error",
+		fmt.Sprintf("ESI type %d does not embed a MAC address, expected type %d (LACP) or type %d (MAC-based)", esi[0], ESITypeLACP, ESITypeMAC),
+	))
+
+	return NewMACAddressNull(), 0, diags
+}
+
+// parseESI decodes an ESI in colon, hyphen or dot notation into its octets and validates the ESI type.
+func parseESI(s string) ([]byte, error) {
+	var groups []string
+	var groupLength int
+
+	switch {
+	case strings.Contains(s, ":"):
+		groups, groupLength = strings.Split(s, ":"), 2
+	case strings.Contains(s, "-"):
+		groups, groupLength = strings.Split(s, "-"), 2
+	case strings.Contains(s, "."):
+		groups, groupLength = strings.Split(s, "."), 4
+	}
+
+	if len(groups)*groupLength != esiLength*2 {
+		return nil, fmt.Errorf("ESI %q: must be 10 octets in colon, hyphen or dot notation", s)
+	}
+
+	for _, group := range groups {
+		if len(group) != groupLength {
+			return nil, fmt.Errorf("ESI %q: must be 10 octets in colon, hyphen or dot notation", s)
+		}
+	}
+
+	esi, err := hex.DecodeString(strings.Join(groups, ""))
+	if err != nil {
+		return nil, fmt.Errorf("ESI %q: invalid hexadecimal digits", s)
+	}
+
+	if esi[0] > ESITypeAS {
+		return nil, fmt.Errorf("ESI %q: ESI type must be between 0 and 5, got %d", s, esi[0])
+	}
+
+	return esi, nil
+}
+
+// NewESINull creates an ESI with a null value. Determine whether the value is null via IsNull method.
+func NewESINull() ESI {
+	return ESI{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewESIUnknown creates an ESI with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewESIUnknown() ESI {
+	return ESI{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewESIValue creates an ESI with a known value. Access the value via ValueString method.
+func NewESIValue(value string) ESI {
+	return ESI{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewESIPointerValue creates an ESI with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewESIPointerValue(value *string) ESI {
+	return ESI{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
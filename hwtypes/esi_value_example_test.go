@@ -0,0 +1,33 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package hwtypes_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/hwtypes"
+)
+
+type EthernetSegmentResourceModel struct {
+	ESI hwtypes.ESI `tfsdk:"esi"`
+}
+
+func ExampleESI_ValueMACAddressAndDiscriminator() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := EthernetSegmentResourceModel{
+		ESI: hwtypes.NewESIValue("0300.005e.0053.0100.0001"),
+	}
+
+	// Check that the ESI data is known and able to be decoded into its embedded MAC address
+	if !data.ESI.IsNull() && !data.ESI.IsUnknown() {
+		macAddress, discriminator, diags := data.ESI.ValueMACAddressAndDiscriminator()
+		if diags.HasError() {
+			return
+		}
+
+		// Output: 00:00:5e:00:53:01, 1
+		fmt.Printf("%s, %d\n", macAddress.ValueString(), discriminator)
+	}
+}
@@ -0,0 +1,339 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package hwtypes_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/hwtypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestESIStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentESI    hwtypes.ESI
+		givenESI      basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"semantically equal - colon-delimited byte-for-byte match": {
+			currentESI:    hwtypes.NewESIValue("03:00:00:5e:00:53:01:00:00:01"),
+			givenESI:      hwtypes.NewESIValue("03:00:00:5e:00:53:01:00:00:01"),
+			expectedMatch: true,
+		},
+		"semantically equal - colon-delimited case insensitive": {
+			currentESI:    hwtypes.NewESIValue("03:00:00:5e:00:53:01:00:00:01"),
+			givenESI:      hwtypes.NewESIValue("03:00:00:5E:00:53:01:00:00:01"),
+			expectedMatch: true,
+		},
+		"semantically equal - colon vs hyphen delimited": {
+			currentESI:    hwtypes.NewESIValue("03:00:00:5e:00:53:01:00:00:01"),
+			givenESI:      hwtypes.NewESIValue("03-00-00-5e-00-53-01-00-00-01"),
+			expectedMatch: true,
+		},
+		"semantically equal - colon vs dot delimited": {
+			currentESI:    hwtypes.NewESIValue("03:00:00:5e:00:53:01:00:00:01"),
+			givenESI:      hwtypes.NewESIValue("0300.005E.0053.0100.0001"),
+			expectedMatch: true,
+		},
+		"not equal - discriminator mismatch": {
+			currentESI:    hwtypes.NewESIValue("03:00:00:5e:00:53:01:00:00:01"),
+			givenESI:      hwtypes.NewESIValue("03:00:00:5e:00:53:01:00:00:02"),
+			expectedMatch: false,
+		},
+		"error - not given ESI value": {
+			currentESI:    hwtypes.NewESIValue("03:00:00:5e:00:53:01:00:00:01"),
+			givenESI:      basetypes.NewStringValue("03:00:00:5e:00:53:01:00:00:01"),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: hwtypes.ESI\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentESI.StringSemanticEquals(context.Background(), testCase.givenESI)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestESIValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		esiValue      hwtypes.ESI
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			esiValue: hwtypes.ESI{},
+		},
+		"null": {
+			esiValue: hwtypes.NewESINull(),
+		},
+		"unknown": {
+			esiValue: hwtypes.NewESIUnknown(),
+		},
+		"valid ESI - type 0 - single-homed": {
+			esiValue: hwtypes.NewESIValue("00:00:00:00:00:00:00:00:00:00"),
+		},
+		"valid ESI - type 1 - hyphen-delimited": {
+			esiValue: hwtypes.NewESIValue("01-00-00-5e-00-53-01-00-0a-00"),
+		},
+		"valid ESI - type 5 - dot-delimited": {
+			esiValue: hwtypes.NewESIValue("0500.00fd.e800.0000.0100"),
+		},
+		"invalid ESI - MAX-ESI": {
+			esiValue: hwtypes.NewESIValue("ff:ff:ff:ff:ff:ff:ff:ff:ff:ff"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ESI String Value",
+					"A string value was provided that is not valid Ethernet Segment Identifier string format.\n\n"+
+						"Given Value: ff:ff:ff:ff:ff:ff:ff:ff:ff:ff\n"+
+						"Error: ESI \"ff:ff:ff:ff:ff:ff:ff:ff:ff:ff\": ESI type must be between 0 and 5, got 255",
+				),
+			},
+		},
+		"invalid ESI - 9 octets": {
+			esiValue: hwtypes.NewESIValue("00:11:22:33:44:55:66:77:88"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ESI String Value",
+					"A string value was provided that is not valid Ethernet Segment Identifier string format.\n\n"+
+						"Given Value: 00:11:22:33:44:55:66:77:88\n"+
+						"Error: ESI \"00:11:22:33:44:55:66:77:88\": must be 10 octets in colon, hyphen or dot notation",
+				),
+			},
+		},
+		"invalid ESI - mixed delimiters": {
+			esiValue: hwtypes.NewESIValue("00:11:22:33:44-55-66-77-88-99"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ESI String Value",
+					"A string value was provided that is not valid Ethernet Segment Identifier string format.\n\n"+
+						"Given Value: 00:11:22:33:44-55-66-77-88-99\n"+
+						"Error: ESI \"00:11:22:33:44-55-66-77-88-99\": must be 10 octets in colon, hyphen or dot notation",
+				),
+			},
+		},
+		"invalid ESI - bogus digit": {
+			esiValue: hwtypes.NewESIValue("00:11:22:33:44:55:66:77:88:9g"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ESI String Value",
+					"A string value was provided that is not valid Ethernet Segment Identifier string format.\n\n"+
+						"Given Value: 00:11:22:33:44:55:66:77:88:9g\n"+
+						"Error: ESI \"00:11:22:33:44:55:66:77:88:9g\": invalid hexadecimal digits",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.esiValue.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{Path: path.Root("test")},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestESIValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		esiValue        hwtypes.ESI
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			esiValue: hwtypes.ESI{},
+		},
+		"null": {
+			esiValue: hwtypes.NewESINull(),
+		},
+		"unknown": {
+			esiValue: hwtypes.NewESIUnknown(),
+		},
+		"valid ESI": {
+			esiValue: hwtypes.NewESIValue("03:00:00:5e:00:53:01:00:00:01"),
+		},
+		"invalid ESI - type 6": {
+			esiValue: hwtypes.NewESIValue("06:00:00:5e:00:53:01:00:00:01"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid ESI String Value: "+
+					"A string value was provided that is not valid Ethernet Segment Identifier string format.\n\n"+
+					"Given Value: 06:00:00:5e:00:53:01:00:00:01\n"+
+					"Error: ESI \"06:00:00:5e:00:53:01:00:00:01\": ESI type must be between 0 and 5, got 6",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.esiValue.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestESIValueESI(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		esiValue      hwtypes.ESI
+		expectedESI   []byte
+		expectedDiags diag.Diagnostics
+	}{
+		"ESI value is null": {
+			esiValue: hwtypes.NewESINull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"ESI ValueESI Error",
+					"ESI string value is null",
+				),
+			},
+		},
+		"ESI value is unknown": {
+			esiValue: hwtypes.NewESIUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"ESI ValueESI Error",
+					"ESI string value is unknown",
+				),
+			},
+		},
+		"valid ESI": {
+			esiValue:    hwtypes.NewESIValue("0300.005e.0053.0100.0001"),
+			expectedESI: []byte{0x03, 0x00, 0x00, 0x5e, 0x00, 0x53, 0x01, 0x00, 0x00, 0x01},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			esi, diags := testCase.esiValue.ValueESI()
+
+			if !bytes.Equal(esi, testCase.expectedESI) {
+				t.Errorf("Unexpected difference in ESI, got: %x, expected: %x", esi, testCase.expectedESI)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestESIValueMACAddressAndDiscriminator(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		esiValue              hwtypes.ESI
+		expectedMACAddress    hwtypes.MACAddress
+		expectedDiscriminator uint32
+		expectedDiags         diag.Diagnostics
+	}{
+		"ESI value is null": {
+			esiValue:           hwtypes.NewESINull(),
+			expectedMACAddress: hwtypes.NewMACAddressNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"ESI ValueESI Error",
+					"ESI string value is null",
+				),
+			},
+		},
+		"type 0 ESI": {
+			esiValue:           hwtypes.NewESIValue("00:11:22:33:44:55:66:77:88:99"),
+			expectedMACAddress: hwtypes.NewMACAddressNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"ESI ValueMACAddressAndDiscriminator Error",
+					"ESI type 0 does not embed a MAC address, expected type 1 (LACP) or type 3 (MAC-based)",
+				),
+			},
+		},
+		"type 1 ESI": {
+			esiValue:              hwtypes.NewESIValue("01:00:00:5E:00:53:01:01:2c:00"),
+			expectedMACAddress:    hwtypes.NewMACAddressValue("00:00:5e:00:53:01"),
+			expectedDiscriminator: 300,
+		},
+		"type 3 ESI": {
+			esiValue:              hwtypes.NewESIValue("0300.005e.0053.0101.0000"),
+			expectedMACAddress:    hwtypes.NewMACAddressValue("00:00:5e:00:53:01"),
+			expectedDiscriminator: 65536,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			macAddress, discriminator, diags := testCase.esiValue.ValueMACAddressAndDiscriminator()
+
+			if !macAddress.Equal(testCase.expectedMACAddress) {
+				t.Errorf("Unexpected difference in MACAddress, got: %s, expected: %s", macAddress, testCase.expectedMACAddress)
+			}
+
+			if discriminator != testCase.expectedDiscriminator {
+				t.Errorf("Unexpected difference in discriminator, got: %d, expected: %d", discriminator, testCase.expectedDiscriminator)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package hwtypes
+
+import (
+	"encoding/hex"
+	"errors"
+	"net"
+	"strings"
+)
+
+var errInvalidHexOctets = errors.New("must be hexadecimal octets, optionally delimited by colons, hyphens or dots")
+
+// parseHexOctets decodes a variable length string of hexadecimal octets as commonly printed by DHCP servers and
+// network appliances. Octets may be colon- or hyphen-delimited, where leading zeroes may be omitted (00:0c:29 or
+// 0:c:29), dot-delimited in groups of two octets with an optional trailing single octet (000c.29), or undelimited.
+func parseHexOctets(s string) ([]byte, error) {
+	var digits strings.Builder
+
+	switch {
+	case strings.ContainsAny(s, ":-"):
+		separator := ":"
+		if !strings.Contains(s, ":") {
+			separator = "-"
+		}
+
+		for _, group := range strings.Split(s, separator) {
+			switch len(group) {
+			case 1:
+				digits.WriteString("0" + group)
+			case 2:
+				digits.WriteString(group)
+			default:
+				return nil, errInvalidHexOctets
+			}
+		}
+	case strings.Contains(s, "."):
+		groups := strings.Split(s, ".")
+
+		for i, group := range groups {
+			if len(group) != 4 && (i != len(groups)-1 || len(group) != 2) {
+				return nil, errInvalidHexOctets
+			}
+
+			digits.WriteString(group)
+		}
+	default:
+		digits.WriteString(s)
+	}
+
+	octets, err := hex.DecodeString(digits.String())
+	if err != nil || len(octets) == 0 {
+		return nil, errInvalidHexOctets
+	}
+
+	return octets, nil
+}
+
+// isMACAddressLength returns true if a link-layer address of the given length can be represented by MACAddress.
+func isMACAddressLength(length int) bool {
+	// net.ParseMAC supports IEEE 802 MAC-48, EUI-48, EUI-64 and 20-octet IP over InfiniBand link-layer addresses
+	return length == 6 || length == 8 || length == 20
+}
+
+// newMACAddressFromOctets creates a known MACAddress value in colon-delimited notation from the given octets.
+func newMACAddressFromOctets(octets []byte) MACAddress {
+	return NewMACAddressValue(net.HardwareAddr(octets).String())
+}
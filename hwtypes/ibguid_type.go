@@ -0,0 +1,80 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package hwtypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = (*IBGUIDType)(nil)
+
+// IBGUIDType is an attribute type that represents a valid 8-octet InfiniBand globally unique identifier (GUID), such
+// as a port, node or system image GUID. Semantic equality logic is defined for IBGUIDType, so that identifiers
+// expressed with varying case and notation are considered equal.
+//
+// All of the following are semantically equal:
+//   - 0002:c903:0003:1234
+//   - 0002:C903:0003:1234
+//   - 00:02:c9:03:00:03:12:34
+//   - 00-02-c9-03-00-03-12-34
+//   - 0x0002c90300031234
+//   - 0002c90300031234
+type IBGUIDType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t IBGUIDType) String() string {
+	return "hwtypes.IBGUIDType"
+}
+
+// ValueType returns the Value type.
+func (t IBGUIDType) ValueType(ctx context.Context) attr.Value {
+	return IBGUID{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t IBGUIDType) Equal(o attr.Type) bool {
+	other, ok := o.(IBGUIDType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t IBGUIDType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return IBGUID{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t IBGUIDType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
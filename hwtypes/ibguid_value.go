@@ -0,0 +1,220 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package hwtypes
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*IBGUID)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*IBGUID)(nil)
+	_ xattr.ValidateableAttribute                = (*IBGUID)(nil)
+	_ function.ValidateableParameter             = (*IBGUID)(nil)
+)
+
+// IBGUID represents a valid 8-octet InfiniBand globally unique identifier (GUID), such as a port, node or system image
+// GUID. The identifier may be written as colon-delimited groups of two octets, colon- or hyphen-delimited octets, or
+// as 16 hexadecimal digits with an optional 0x prefix. Semantic equality logic is defined for IBGUID, so that
+// identifiers expressed with varying case and notation are considered equal.
+//
+// All of the following are semantically equal:
+//   - 0002:c903:0003:1234
+//   - 0002:C903:0003:1234
+//   - 00:02:c9:03:00:03:12:34
+//   - 00-02-c9-03-00-03-12-34
+//   - 0x0002c90300031234
+//   - 0002c90300031234
+type IBGUID struct {
+	basetypes.StringValue
+}
+
+// Type returns an IBGUIDType.
+func (v IBGUID) Type(_ context.Context) attr.Type {
+	return IBGUIDType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v IBGUID) Equal(o attr.Value) bool {
+	other, ok := o.(IBGUID)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given GUID string value is semantically equal to the current GUID string
+// value. This comparison decodes both values into their 64-bit integer representations, so that identifiers expressed
+// with varying case and notation are considered equal.
+//
+// All of the following are semantically equal:
+//   - 0002:c903:0003:1234
+//   - 0002:C903:0003:1234
+//   - 00:02:c9:03:00:03:12:34
+//   - 00-02-c9-03-00-03-12-34
+//   - 0x0002c90300031234
+//   - 0002c90300031234
+func (v IBGUID) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(IBGUID)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// GUIDs are already validated at this point, ignoring errors
+	newGUID, _ := parseIBGUID(newValue.ValueString())
+	currentGUID, _ := parseIBGUID(v.ValueString())
+
+	return currentGUID == newGUID, diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String
+// value that is a valid 8-octet GUID in colon, hyphen or undelimited hexadecimal notation.
+func (v IBGUID) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseIBGUID(v.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid InfiniBand GUID String Value",
+			"A string value was provided that is not valid InfiniBand GUID string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is a valid 8-octet GUID in colon, hyphen or undelimited hexadecimal notation.
+func (v IBGUID) ValidateParameter(_ context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseIBGUID(v.ValueString())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid InfiniBand GUID String Value: "+
+				"A string value was provided that is not valid InfiniBand GUID string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueIBGUID decodes the IBGUID StringValue into its 64-bit integer representation. A null or unknown value will
+// produce an error diagnostic.
+func (v IBGUID) ValueIBGUID() (uint64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("IBGUID ValueIBGUID Error", "InfiniBand GUID string value is null"))
+		return 0, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("IBGUID ValueIBGUID Error", "InfiniBand GUID string value is unknown"))
+		return 0, diags
+	}
+
+	guid, err := parseIBGUID(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("IBGUID ValueIBGUID Error", err.Error()))
+		return 0, diags
+	}
+
+	return guid, nil
+}
+
+// parseIBGUID decodes a GUID in colon, hyphen or undelimited hexadecimal notation into its 64-bit integer representation.
+func parseIBGUID(s string) (uint64, error) {
+	var groups []string
+	var groupLength int
+
+	switch {
+	case strings.Contains(s, ":"):
+		groups = strings.Split(s, ":")
+		groupLength = 16 / len(groups)
+	case strings.Contains(s, "-"):
+		groups, groupLength = strings.Split(s, "-"), 2
+	default:
+		groups, groupLength = []string{s}, 16
+
+		if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+			groups[0] = s[2:]
+		}
+	}
+
+	if len(groups)*groupLength != 16 || (groupLength != 2 && groupLength != 4 && groupLength != 16) {
+		return 0, fmt.Errorf("InfiniBand GUID %q: must be 8 octets in colon, hyphen or hexadecimal notation", s)
+	}
+
+	for _, group := range groups {
+		if len(group) != groupLength {
+			return 0, fmt.Errorf("InfiniBand GUID %q: must be 8 octets in colon, hyphen or hexadecimal notation", s)
+		}
+	}
+
+	guid, err := strconv.ParseUint(strings.Join(groups, ""), 16, 64)
+	if err != nil {
+		return 0, fmt.Errorf("InfiniBand GUID %q: invalid hexadecimal digits", s)
+	}
+
+	return guid, nil
+}
+
+// NewIBGUIDNull creates an IBGUID with a null value. Determine whether the value is null via IsNull method.
+func NewIBGUIDNull() IBGUID {
+	return IBGUID{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewIBGUIDUnknown creates an IBGUID with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewIBGUIDUnknown() IBGUID {
+	return IBGUID{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewIBGUIDValue creates an IBGUID with a known value. Access the value via ValueString method.
+func NewIBGUIDValue(value string) IBGUID {
+	return IBGUID{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewIBGUIDPointerValue creates an IBGUID with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewIBGUIDPointerValue(value *string) IBGUID {
+	return IBGUID{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
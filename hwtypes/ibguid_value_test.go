@@ -0,0 +1,272 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package hwtypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/hwtypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestIBGUIDStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentGUID   hwtypes.IBGUID
+		givenGUID     basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"semantically equal - byte-for-byte match": {
+			currentGUID:   hwtypes.NewIBGUIDValue("0002:c903:0003:1234"),
+			givenGUID:     hwtypes.NewIBGUIDValue("0002:c903:0003:1234"),
+			expectedMatch: true,
+		},
+		"semantically equal - case insensitive": {
+			currentGUID:   hwtypes.NewIBGUIDValue("0002:c903:0003:1234"),
+			givenGUID:     hwtypes.NewIBGUIDValue("0002:C903:0003:1234"),
+			expectedMatch: true,
+		},
+		"semantically equal - two octet groups vs colon-delimited octets": {
+			currentGUID:   hwtypes.NewIBGUIDValue("0002:c903:0003:1234"),
+			givenGUID:     hwtypes.NewIBGUIDValue("00:02:c9:03:00:03:12:34"),
+			expectedMatch: true,
+		},
+		"semantically equal - two octet groups vs hyphen-delimited octets": {
+			currentGUID:   hwtypes.NewIBGUIDValue("0002:c903:0003:1234"),
+			givenGUID:     hwtypes.NewIBGUIDValue("00-02-c9-03-00-03-12-34"),
+			expectedMatch: true,
+		},
+		"semantically equal - two octet groups vs 0x prefix": {
+			currentGUID:   hwtypes.NewIBGUIDValue("0002:c903:0003:1234"),
+			givenGUID:     hwtypes.NewIBGUIDValue("0x0002c90300031234"),
+			expectedMatch: true,
+		},
+		"semantically equal - 0x prefix vs undelimited": {
+			currentGUID:   hwtypes.NewIBGUIDValue("0X0002C90300031234"),
+			givenGUID:     hwtypes.NewIBGUIDValue("0002c90300031234"),
+			expectedMatch: true,
+		},
+		"not equal - GUID mismatch": {
+			currentGUID:   hwtypes.NewIBGUIDValue("0002:c903:0003:1234"),
+			givenGUID:     hwtypes.NewIBGUIDValue("0002:c903:0003:1235"),
+			expectedMatch: false,
+		},
+		"error - not given IBGUID value": {
+			currentGUID:   hwtypes.NewIBGUIDValue("0002:c903:0003:1234"),
+			givenGUID:     basetypes.NewStringValue("0002:c903:0003:1234"),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: hwtypes.IBGUID\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentGUID.StringSemanticEquals(context.Background(), testCase.givenGUID)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestIBGUIDValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		guidValue     hwtypes.IBGUID
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			guidValue: hwtypes.IBGUID{},
+		},
+		"null": {
+			guidValue: hwtypes.NewIBGUIDNull(),
+		},
+		"unknown": {
+			guidValue: hwtypes.NewIBGUIDUnknown(),
+		},
+		"valid GUID": {
+			guidValue: hwtypes.NewIBGUIDValue("0002:c903:0003:1234"),
+		},
+		"valid GUID - 0x prefix": {
+			guidValue: hwtypes.NewIBGUIDValue("0xffffffffffffffff"),
+		},
+		"invalid GUID - MAC address": {
+			guidValue: hwtypes.NewIBGUIDValue("00:00:5e:00:53:01"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid InfiniBand GUID String Value",
+					"A string value was provided that is not valid InfiniBand GUID string format.\n\n"+
+						"Given Value: 00:00:5e:00:53:01\n"+
+						"Error: InfiniBand GUID \"00:00:5e:00:53:01\": must be 8 octets in colon, hyphen or hexadecimal notation",
+				),
+			},
+		},
+		"invalid GUID - mixed group lengths": {
+			guidValue: hwtypes.NewIBGUIDValue("0002:c903:00:03:1234"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid InfiniBand GUID String Value",
+					"A string value was provided that is not valid InfiniBand GUID string format.\n\n"+
+						"Given Value: 0002:c903:00:03:1234\n"+
+						"Error: InfiniBand GUID \"0002:c903:00:03:1234\": must be 8 octets in colon, hyphen or hexadecimal notation",
+				),
+			},
+		},
+		"invalid GUID - bogus digit": {
+			guidValue: hwtypes.NewIBGUIDValue("0002:c903:0003:123g"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid InfiniBand GUID String Value",
+					"A string value was provided that is not valid InfiniBand GUID string format.\n\n"+
+						"Given Value: 0002:c903:0003:123g\n"+
+						"Error: InfiniBand GUID \"0002:c903:0003:123g\": invalid hexadecimal digits",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.guidValue.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{Path: path.Root("test")},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestIBGUIDValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		guidValue       hwtypes.IBGUID
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			guidValue: hwtypes.IBGUID{},
+		},
+		"null": {
+			guidValue: hwtypes.NewIBGUIDNull(),
+		},
+		"unknown": {
+			guidValue: hwtypes.NewIBGUIDUnknown(),
+		},
+		"valid GUID": {
+			guidValue: hwtypes.NewIBGUIDValue("00-02-c9-03-00-03-12-34"),
+		},
+		"invalid GUID - too short": {
+			guidValue: hwtypes.NewIBGUIDValue("0x0002c903"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid InfiniBand GUID String Value: "+
+					"A string value was provided that is not valid InfiniBand GUID string format.\n\n"+
+					"Given Value: 0x0002c903\n"+
+					"Error: InfiniBand GUID \"0x0002c903\": must be 8 octets in colon, hyphen or hexadecimal notation",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.guidValue.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestIBGUIDValueIBGUID(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		guidValue     hwtypes.IBGUID
+		expectedGUID  uint64
+		expectedDiags diag.Diagnostics
+	}{
+		"GUID value is null": {
+			guidValue: hwtypes.NewIBGUIDNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"IBGUID ValueIBGUID Error",
+					"InfiniBand GUID string value is null",
+				),
+			},
+		},
+		"GUID value is unknown": {
+			guidValue: hwtypes.NewIBGUIDUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"IBGUID ValueIBGUID Error",
+					"InfiniBand GUID string value is unknown",
+				),
+			},
+		},
+		"valid GUID": {
+			guidValue:    hwtypes.NewIBGUIDValue("0002:c903:0003:1234"),
+			expectedGUID: 0x0002c90300031234,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			guid, diags := testCase.guidValue.ValueIBGUID()
+
+			if guid != testCase.expectedGUID {
+				t.Errorf("Unexpected difference in GUID, got: %#x, expected: %#x", guid, testCase.expectedGUID)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package hwtypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = (*ISCSINameType)(nil)
+
+// ISCSINameType is an attribute type that represents a valid iSCSI initiator or target name (RFC 3720) in iqn, eui or
+// naa format. Semantic equality logic is defined for ISCSINameType, so that names expressed with varying case are
+// considered equal, as iSCSI names are case-insensitive.
+//
+// Examples:
+//   - `iqn.2001-04.com.example:storage` is semantically equal to `IQN.2001-04.com.Example:Storage`
+//   - `eui.02004567A425678D` is semantically equal to `eui.02004567a425678d`
+type ISCSINameType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t ISCSINameType) String() string {
+	return "hwtypes.ISCSINameType"
+}
+
+// ValueType returns the Value type.
+func (t ISCSINameType) ValueType(ctx context.Context) attr.Value {
+	return ISCSIName{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t ISCSINameType) Equal(o attr.Type) bool {
+	other, ok := o.(ISCSINameType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t ISCSINameType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return ISCSIName{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t ISCSINameType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
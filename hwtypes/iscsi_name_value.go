@@ -0,0 +1,176 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package hwtypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*ISCSIName)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*ISCSIName)(nil)
+	_ xattr.ValidateableAttribute                = (*ISCSIName)(nil)
+	_ function.ValidateableParameter             = (*ISCSIName)(nil)
+)
+
+// ISCSIName represents a valid iSCSI initiator or target name (RFC 3720) in iqn, eui or naa format. Names are limited
+// to ASCII letters, digits, hyphens, dots and colons. Semantic equality logic is defined for ISCSIName, so that names
+// expressed with varying case are considered equal, as iSCSI names are case-insensitive.
+//
+// Examples:
+//   - `iqn.2001-04.com.example:storage` is semantically equal to `IQN.2001-04.com.Example:Storage`
+//   - `eui.02004567A425678D` is semantically equal to `eui.02004567a425678d`
+type ISCSIName struct {
+	basetypes.StringValue
+}
+
+// Type returns an ISCSINameType.
+func (v ISCSIName) Type(_ context.Context) attr.Type {
+	return ISCSINameType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v ISCSIName) Equal(o attr.Value) bool {
+	other, ok := o.(ISCSIName)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given iSCSI name string value is semantically equal to the current iSCSI
+// name string value. Both values are parsed and folded to a canonical case before comparison, so that names expressed
+// with varying case are considered equal.
+//
+// Examples:
+//   - `iqn.2001-04.com.example:storage` is semantically equal to `IQN.2001-04.com.Example:Storage`
+//   - `eui.02004567A425678D` is semantically equal to `eui.02004567a425678d`
+func (v ISCSIName) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(ISCSIName)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// iSCSI names are already validated at this point, ignoring errors
+	newName, _ := parseISCSIName(newValue.ValueString())
+	currentName, _ := parseISCSIName(v.ValueString())
+
+	return currentName == newName, diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String
+// value that is a valid iSCSI name of at most 223 bytes: an iqn name with a yyyy-mm date, reversed domain name and
+// optional suffix, an eui name with 16 hexadecimal digits or a naa name with 16 or 32 hexadecimal digits.
+func (v ISCSIName) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseISCSIName(v.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid iSCSI Name String Value",
+			"A string value was provided that is not valid iSCSI name string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is a valid iSCSI name of at most 223 bytes: an iqn name with a yyyy-mm date,
+// reversed domain name and optional suffix, an eui name with 16 hexadecimal digits or a naa name with 16 or 32
+// hexadecimal digits.
+func (v ISCSIName) ValidateParameter(_ context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseISCSIName(v.ValueString())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid iSCSI Name String Value: "+
+				"A string value was provided that is not valid iSCSI name string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueQualifiedName parses the ISCSIName StringValue into a QualifiedName, folded to lower case other than the
+// hexadecimal identifier of the eui and naa formats, which is folded to upper case. A null or unknown value will
+// produce an error diagnostic.
+func (v ISCSIName) ValueQualifiedName() (QualifiedName, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("ISCSIName ValueQualifiedName Error", "iSCSI name string value is null"))
+		return QualifiedName{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("ISCSIName ValueQualifiedName Error", "iSCSI name string value is unknown"))
+		return QualifiedName{}, diags
+	}
+
+	name, err := parseISCSIName(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("ISCSIName ValueQualifiedName Error", err.Error()))
+		return QualifiedName{}, diags
+	}
+
+	return name, nil
+}
+
+// NewISCSINameNull creates an ISCSIName with a null value. Determine whether the value is null via IsNull method.
+func NewISCSINameNull() ISCSIName {
+	return ISCSIName{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewISCSINameUnknown creates an ISCSIName with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewISCSINameUnknown() ISCSIName {
+	return ISCSIName{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewISCSINameValue creates an ISCSIName with a known value. Access the value via ValueString method.
+func NewISCSINameValue(value string) ISCSIName {
+	return ISCSIName{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewISCSINamePointerValue creates an ISCSIName with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewISCSINamePointerValue(value *string) ISCSIName {
+	return ISCSIName{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
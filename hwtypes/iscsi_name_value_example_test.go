@@ -0,0 +1,53 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package hwtypes_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/hwtypes"
+)
+
+type StorageTargetResourceModel struct {
+	ISCSIName hwtypes.ISCSIName `tfsdk:"iscsi_name"`
+	NQN       hwtypes.NQN       `tfsdk:"nqn"`
+}
+
+func ExampleISCSIName_ValueQualifiedName() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := StorageTargetResourceModel{
+		ISCSIName: hwtypes.NewISCSINameValue("IQN.2001-04.com.Example:Storage"),
+	}
+
+	// Check that the ISCSIName data is known and able to be parsed
+	if !data.ISCSIName.IsNull() && !data.ISCSIName.IsUnknown() {
+		name, diags := data.ISCSIName.ValueQualifiedName()
+		if diags.HasError() {
+			return
+		}
+
+		// Output: 2001-04 com.example storage
+		fmt.Println(name.Date, name.NamingAuthority, name.Suffix)
+	}
+}
+
+func ExampleNQN_ValueQualifiedName() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := StorageTargetResourceModel{
+		NQN: hwtypes.NewNQNValue("nqn.2014-08.org.nvmexpress:uuid:F81D4FAE-7DEC-11D0-A765-00A0C91E6BF6"),
+	}
+
+	// Check that the NQN data is known and able to be parsed
+	if !data.NQN.IsNull() && !data.NQN.IsUnknown() {
+		name, diags := data.NQN.ValueQualifiedName()
+		if diags.HasError() {
+			return
+		}
+
+		// Output: nqn.2014-08.org.nvmexpress:uuid:f81d4fae-7dec-11d0-a765-00a0c91e6bf6
+		fmt.Println(name)
+	}
+}
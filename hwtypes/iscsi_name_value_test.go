@@ -0,0 +1,344 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package hwtypes_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/hwtypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestISCSINameStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentName   hwtypes.ISCSIName
+		givenName     basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"semantically equal - byte-for-byte match": {
+			currentName:   hwtypes.NewISCSINameValue("iqn.2001-04.com.example:storage"),
+			givenName:     hwtypes.NewISCSINameValue("iqn.2001-04.com.example:storage"),
+			expectedMatch: true,
+		},
+		"semantically equal - iqn case insensitive": {
+			currentName:   hwtypes.NewISCSINameValue("iqn.2001-04.com.example:storage"),
+			givenName:     hwtypes.NewISCSINameValue("IQN.2001-04.com.Example:Storage"),
+			expectedMatch: true,
+		},
+		"semantically equal - eui case insensitive": {
+			currentName:   hwtypes.NewISCSINameValue("eui.02004567A425678D"),
+			givenName:     hwtypes.NewISCSINameValue("eui.02004567a425678d"),
+			expectedMatch: true,
+		},
+		"semantically equal - naa case insensitive": {
+			currentName:   hwtypes.NewISCSINameValue("naa.52004567BA64678D"),
+			givenName:     hwtypes.NewISCSINameValue("NAA.52004567ba64678d"),
+			expectedMatch: true,
+		},
+		"not equal - suffix mismatch": {
+			currentName:   hwtypes.NewISCSINameValue("iqn.2001-04.com.example:storage"),
+			givenName:     hwtypes.NewISCSINameValue("iqn.2001-04.com.example:storage.disk1"),
+			expectedMatch: false,
+		},
+		"not equal - date mismatch": {
+			currentName:   hwtypes.NewISCSINameValue("iqn.2001-04.com.example:storage"),
+			givenName:     hwtypes.NewISCSINameValue("iqn.2001-05.com.example:storage"),
+			expectedMatch: false,
+		},
+		"error - not given ISCSIName value": {
+			currentName:   hwtypes.NewISCSINameValue("iqn.2001-04.com.example:storage"),
+			givenName:     basetypes.NewStringValue("iqn.2001-04.com.example:storage"),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: hwtypes.ISCSIName\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentName.StringSemanticEquals(context.Background(), testCase.givenName)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestISCSINameValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	longName := "iqn.2001-04.com.example:" + strings.Repeat("a", 200)
+
+	testCases := map[string]struct {
+		nameValue     hwtypes.ISCSIName
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			nameValue: hwtypes.ISCSIName{},
+		},
+		"null": {
+			nameValue: hwtypes.NewISCSINameNull(),
+		},
+		"unknown": {
+			nameValue: hwtypes.NewISCSINameUnknown(),
+		},
+		"valid iqn": {
+			nameValue: hwtypes.NewISCSINameValue("iqn.1991-05.com.microsoft:host1.example.com"),
+		},
+		"valid iqn - without suffix": {
+			nameValue: hwtypes.NewISCSINameValue("iqn.2001-04.com.example"),
+		},
+		"valid eui": {
+			nameValue: hwtypes.NewISCSINameValue("eui.02004567A425678D"),
+		},
+		"valid naa - 32 digits": {
+			nameValue: hwtypes.NewISCSINameValue("naa.62004567BA64678D0123456789ABCDEF"),
+		},
+		"invalid iSCSI name - unknown type designator": {
+			nameValue: hwtypes.NewISCSINameValue("nqn.2014-08.com.example:storage"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid iSCSI Name String Value",
+					"A string value was provided that is not valid iSCSI name string format.\n\n"+
+						"Given Value: nqn.2014-08.com.example:storage\n"+
+						"Error: iSCSI name \"nqn.2014-08.com.example:storage\": must begin with a type designator of iqn., eui. or naa.",
+				),
+			},
+		},
+		"invalid iSCSI name - invalid month": {
+			nameValue: hwtypes.NewISCSINameValue("iqn.2001-13.com.example:storage"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid iSCSI Name String Value",
+					"A string value was provided that is not valid iSCSI name string format.\n\n"+
+						"Given Value: iqn.2001-13.com.example:storage\n"+
+						"Error: iSCSI name \"iqn.2001-13.com.example:storage\": iqn format must be followed by a date in yyyy-mm format and a dot",
+				),
+			},
+		},
+		"invalid iSCSI name - empty naming authority label": {
+			nameValue: hwtypes.NewISCSINameValue("iqn.2001-04.com..example:storage"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid iSCSI Name String Value",
+					"A string value was provided that is not valid iSCSI name string format.\n\n"+
+						"Given Value: iqn.2001-04.com..example:storage\n"+
+						"Error: iSCSI name \"iqn.2001-04.com..example:storage\": naming authority must be a reversed domain name",
+				),
+			},
+		},
+		"invalid iSCSI name - empty suffix": {
+			nameValue: hwtypes.NewISCSINameValue("iqn.2001-04.com.example:"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid iSCSI Name String Value",
+					"A string value was provided that is not valid iSCSI name string format.\n\n"+
+						"Given Value: iqn.2001-04.com.example:\n"+
+						"Error: iSCSI name \"iqn.2001-04.com.example:\": suffix must not be empty when the naming authority is followed by a colon",
+				),
+			},
+		},
+		"invalid iSCSI name - whitespace": {
+			nameValue: hwtypes.NewISCSINameValue("iqn.2001-04.com.example:my storage"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid iSCSI Name String Value",
+					"A string value was provided that is not valid iSCSI name string format.\n\n"+
+						"Given Value: iqn.2001-04.com.example:my storage\n"+
+						"Error: iSCSI name \"iqn.2001-04.com.example:my storage\": must only contain ASCII letters, digits, hyphens, dots and colons",
+				),
+			},
+		},
+		"invalid iSCSI name - eui length": {
+			nameValue: hwtypes.NewISCSINameValue("eui.02004567A425"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid iSCSI Name String Value",
+					"A string value was provided that is not valid iSCSI name string format.\n\n"+
+						"Given Value: eui.02004567A425\n"+
+						"Error: iSCSI name \"eui.02004567A425\": eui format must be followed by 16 hexadecimal digits",
+				),
+			},
+		},
+		"invalid iSCSI name - too long": {
+			nameValue: hwtypes.NewISCSINameValue(longName),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid iSCSI Name String Value",
+					"A string value was provided that is not valid iSCSI name string format.\n\n"+
+						"Given Value: "+longName+"\n"+
+						"Error: iSCSI name \""+longName+"\": must be at most 223 bytes, got 224",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.nameValue.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{Path: path.Root("test")},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestISCSINameValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		nameValue       hwtypes.ISCSIName
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			nameValue: hwtypes.ISCSIName{},
+		},
+		"null": {
+			nameValue: hwtypes.NewISCSINameNull(),
+		},
+		"unknown": {
+			nameValue: hwtypes.NewISCSINameUnknown(),
+		},
+		"valid iqn": {
+			nameValue: hwtypes.NewISCSINameValue("iqn.2001-04.com.example:storage"),
+		},
+		"invalid iSCSI name - naa length": {
+			nameValue: hwtypes.NewISCSINameValue("naa.52004567BA64678D01"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid iSCSI Name String Value: "+
+					"A string value was provided that is not valid iSCSI name string format.\n\n"+
+					"Given Value: naa.52004567BA64678D01\n"+
+					"Error: iSCSI name \"naa.52004567BA64678D01\": naa format must be followed by 16 or 32 hexadecimal digits",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.nameValue.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestISCSINameValueQualifiedName(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		nameValue     hwtypes.ISCSIName
+		expectedName  hwtypes.QualifiedName
+		expectedDiags diag.Diagnostics
+	}{
+		"iSCSI name value is null": {
+			nameValue: hwtypes.NewISCSINameNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"ISCSIName ValueQualifiedName Error",
+					"iSCSI name string value is null",
+				),
+			},
+		},
+		"iSCSI name value is unknown": {
+			nameValue: hwtypes.NewISCSINameUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"ISCSIName ValueQualifiedName Error",
+					"iSCSI name string value is unknown",
+				),
+			},
+		},
+		"iqn": {
+			nameValue: hwtypes.NewISCSINameValue("IQN.2001-04.com.Example:Storage:Disk1"),
+			expectedName: hwtypes.QualifiedName{
+				Format:          hwtypes.QualifiedNameFormatIQN,
+				Date:            "2001-04",
+				NamingAuthority: "com.example",
+				Suffix:          "storage:disk1",
+			},
+		},
+		"iqn - without suffix": {
+			nameValue: hwtypes.NewISCSINameValue("iqn.2001-04.com.example"),
+			expectedName: hwtypes.QualifiedName{
+				Format:          hwtypes.QualifiedNameFormatIQN,
+				Date:            "2001-04",
+				NamingAuthority: "com.example",
+			},
+		},
+		"eui": {
+			nameValue: hwtypes.NewISCSINameValue("eui.02004567a425678d"),
+			expectedName: hwtypes.QualifiedName{
+				Format: hwtypes.QualifiedNameFormatEUI,
+				Suffix: "02004567A425678D",
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			qualifiedName, diags := testCase.nameValue.ValueQualifiedName()
+
+			if diff := cmp.Diff(qualifiedName, testCase.expectedName); diff != "" {
+				t.Errorf("Unexpected difference in QualifiedName (-got, +expected): %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
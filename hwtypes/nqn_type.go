@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package hwtypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = (*NQNType)(nil)
+
+// NQNType is an attribute type that represents a valid NVMe qualified name (NQN), either in the
+// nqn.yyyy-mm.naming-authority:suffix format or generated from a UUID. Semantic equality logic is defined for NQNType,
+// so that names with a naming authority or UUID expressed with varying case are considered equal. The remainder of
+// the name is case-sensitive.
+//
+// Examples:
+//   - `nqn.2014-08.com.Example:Storage` is semantically equal to `nqn.2014-08.com.example:Storage`
+//   - `nqn.2014-08.org.nvmexpress:uuid:F81D4FAE-7DEC-11D0-A765-00A0C91E6BF6` is semantically equal to
+//     `nqn.2014-08.org.nvmexpress:uuid:f81d4fae-7dec-11d0-a765-00a0c91e6bf6`
+type NQNType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t NQNType) String() string {
+	return "hwtypes.NQNType"
+}
+
+// ValueType returns the Value type.
+func (t NQNType) ValueType(ctx context.Context) attr.Value {
+	return NQN{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t NQNType) Equal(o attr.Type) bool {
+	other, ok := o.(NQNType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t NQNType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return NQN{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t NQNType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
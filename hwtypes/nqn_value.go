@@ -0,0 +1,175 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package hwtypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*NQN)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*NQN)(nil)
+	_ xattr.ValidateableAttribute                = (*NQN)(nil)
+	_ function.ValidateableParameter             = (*NQN)(nil)
+)
+
+// NQN represents a valid NVMe qualified name (NQN), either in the nqn.yyyy-mm.naming-authority:suffix format or
+// generated from a UUID. Semantic equality logic is defined for NQN, so that names with a naming authority or UUID
+// expressed with varying case are considered equal. The remainder of the name is case-sensitive.
+//
+// Examples:
+//   - `nqn.2014-08.com.Example:Storage` is semantically equal to `nqn.2014-08.com.example:Storage`
+//   - `nqn.2014-08.org.nvmexpress:uuid:F81D4FAE-7DEC-11D0-A765-00A0C91E6BF6` is semantically equal to
+//     `nqn.2014-08.org.nvmexpress:uuid:f81d4fae-7dec-11d0-a765-00a0c91e6bf6`
+type NQN struct {
+	basetypes.StringValue
+}
+
+// Type returns an NQNType.
+func (v NQN) Type(_ context.Context) attr.Type {
+	return NQNType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v NQN) Equal(o attr.Value) bool {
+	other, ok := o.(NQN)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given NQN string value is semantically equal to the current NQN string
+// value. Both values are parsed and the naming authority and UUID are folded to lower case before comparison.
+//
+// Examples:
+//   - `nqn.2014-08.com.Example:Storage` is semantically equal to `nqn.2014-08.com.example:Storage`
+//   - `nqn.2014-08.org.nvmexpress:uuid:F81D4FAE-7DEC-11D0-A765-00A0C91E6BF6` is semantically equal to
+//     `nqn.2014-08.org.nvmexpress:uuid:f81d4fae-7dec-11d0-a765-00a0c91e6bf6`
+func (v NQN) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(NQN)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// NQNs are already validated at this point, ignoring errors
+	newName, _ := parseNQN(newValue.ValueString())
+	currentName, _ := parseNQN(v.ValueString())
+
+	return currentName == newName, diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String
+// value that is a valid UTF-8 NQN of at most 223 bytes, with a yyyy-mm date, reversed domain name and optional suffix.
+// A UUID-based NQN must end with a UUID in 8-4-4-4-12 hexadecimal format.
+func (v NQN) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseNQN(v.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid NQN String Value",
+			"A string value was provided that is not valid NVMe qualified name string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is a valid UTF-8 NQN of at most 223 bytes, with a yyyy-mm date, reversed domain
+// name and optional suffix. A UUID-based NQN must end with a UUID in 8-4-4-4-12 hexadecimal format.
+func (v NQN) ValidateParameter(_ context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseNQN(v.ValueString())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid NQN String Value: "+
+				"A string value was provided that is not valid NVMe qualified name string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueQualifiedName parses the NQN StringValue into a QualifiedName, with the naming authority and any UUID folded to
+// lower case. A null or unknown value will produce an error diagnostic.
+func (v NQN) ValueQualifiedName() (QualifiedName, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("NQN ValueQualifiedName Error", "NQN string value is null"))
+		return QualifiedName{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("NQN ValueQualifiedName Error", "NQN string value is unknown"))
+		return QualifiedName{}, diags
+	}
+
+	name, err := parseNQN(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("NQN ValueQualifiedName Error", err.Error()))
+		return QualifiedName{}, diags
+	}
+
+	return name, nil
+}
+
+// NewNQNNull creates an NQN with a null value. Determine whether the value is null via IsNull method.
+func NewNQNNull() NQN {
+	return NQN{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewNQNUnknown creates an NQN with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewNQNUnknown() NQN {
+	return NQN{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewNQNValue creates an NQN with a known value. Access the value via ValueString method.
+func NewNQNValue(value string) NQN {
+	return NQN{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewNQNPointerValue creates an NQN with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewNQNPointerValue(value *string) NQN {
+	return NQN{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
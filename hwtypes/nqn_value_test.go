@@ -0,0 +1,274 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package hwtypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/hwtypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestNQNStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentNQN    hwtypes.NQN
+		givenNQN      basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"semantically equal - byte-for-byte match": {
+			currentNQN:    hwtypes.NewNQNValue("nqn.2014-08.com.example:Storage"),
+			givenNQN:      hwtypes.NewNQNValue("nqn.2014-08.com.example:Storage"),
+			expectedMatch: true,
+		},
+		"semantically equal - naming authority case insensitive": {
+			currentNQN:    hwtypes.NewNQNValue("nqn.2014-08.com.example:Storage"),
+			givenNQN:      hwtypes.NewNQNValue("nqn.2014-08.com.Example:Storage"),
+			expectedMatch: true,
+		},
+		"semantically equal - UUID case insensitive": {
+			currentNQN:    hwtypes.NewNQNValue("nqn.2014-08.org.nvmexpress:uuid:f81d4fae-7dec-11d0-a765-00a0c91e6bf6"),
+			givenNQN:      hwtypes.NewNQNValue("nqn.2014-08.org.nvmexpress:uuid:F81D4FAE-7DEC-11D0-A765-00A0C91E6BF6"),
+			expectedMatch: true,
+		},
+		"not equal - suffix case sensitive": {
+			currentNQN:    hwtypes.NewNQNValue("nqn.2014-08.com.example:Storage"),
+			givenNQN:      hwtypes.NewNQNValue("nqn.2014-08.com.example:storage"),
+			expectedMatch: false,
+		},
+		"error - not given NQN value": {
+			currentNQN:    hwtypes.NewNQNValue("nqn.2014-08.com.example:Storage"),
+			givenNQN:      basetypes.NewStringValue("nqn.2014-08.com.example:Storage"),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: hwtypes.NQN\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentNQN.StringSemanticEquals(context.Background(), testCase.givenNQN)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNQNValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		nqnValue      hwtypes.NQN
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			nqnValue: hwtypes.NQN{},
+		},
+		"null": {
+			nqnValue: hwtypes.NewNQNNull(),
+		},
+		"unknown": {
+			nqnValue: hwtypes.NewNQNUnknown(),
+		},
+		"valid NQN": {
+			nqnValue: hwtypes.NewNQNValue("nqn.2014-08.com.example:nvme:nvm-subsystem-sn-d78432"),
+		},
+		"valid NQN - discovery": {
+			nqnValue: hwtypes.NewNQNValue("nqn.2014-08.org.nvmexpress.discovery"),
+		},
+		"valid NQN - UUID": {
+			nqnValue: hwtypes.NewNQNValue("nqn.2014-08.org.nvmexpress:uuid:f81d4fae-7dec-11d0-a765-00a0c91e6bf6"),
+		},
+		"invalid NQN - upper case type designator": {
+			nqnValue: hwtypes.NewNQNValue("NQN.2014-08.com.example:storage"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid NQN String Value",
+					"A string value was provided that is not valid NVMe qualified name string format.\n\n"+
+						"Given Value: NQN.2014-08.com.example:storage\n"+
+						"Error: NQN \"NQN.2014-08.com.example:storage\": must begin with a type designator of nqn.",
+				),
+			},
+		},
+		"invalid NQN - missing date": {
+			nqnValue: hwtypes.NewNQNValue("nqn.com.example:storage"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid NQN String Value",
+					"A string value was provided that is not valid NVMe qualified name string format.\n\n"+
+						"Given Value: nqn.com.example:storage\n"+
+						"Error: NQN \"nqn.com.example:storage\": nqn format must be followed by a date in yyyy-mm format and a dot",
+				),
+			},
+		},
+		"invalid NQN - malformed UUID": {
+			nqnValue: hwtypes.NewNQNValue("nqn.2014-08.org.nvmexpress:uuid:f81d4fae7dec11d0a76500a0c91e6bf6"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid NQN String Value",
+					"A string value was provided that is not valid NVMe qualified name string format.\n\n"+
+						"Given Value: nqn.2014-08.org.nvmexpress:uuid:f81d4fae7dec11d0a76500a0c91e6bf6\n"+
+						"Error: NQN \"nqn.2014-08.org.nvmexpress:uuid:f81d4fae7dec11d0a76500a0c91e6bf6\": UUID-based NQN must end with a UUID in 8-4-4-4-12 hexadecimal format",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.nqnValue.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{Path: path.Root("test")},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNQNValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		nqnValue        hwtypes.NQN
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			nqnValue: hwtypes.NQN{},
+		},
+		"null": {
+			nqnValue: hwtypes.NewNQNNull(),
+		},
+		"unknown": {
+			nqnValue: hwtypes.NewNQNUnknown(),
+		},
+		"valid NQN": {
+			nqnValue: hwtypes.NewNQNValue("nqn.2014-08.com.example:storage"),
+		},
+		"invalid NQN - invalid UTF-8": {
+			nqnValue: hwtypes.NewNQNValue("nqn.2014-08.com.example:\xff"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid NQN String Value: "+
+					"A string value was provided that is not valid NVMe qualified name string format.\n\n"+
+					"Given Value: nqn.2014-08.com.example:\xff\n"+
+					"Error: NQN \"nqn.2014-08.com.example:\\xff\": must be valid UTF-8",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.nqnValue.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNQNValueQualifiedName(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		nqnValue      hwtypes.NQN
+		expectedName  hwtypes.QualifiedName
+		expectedDiags diag.Diagnostics
+	}{
+		"NQN value is null": {
+			nqnValue: hwtypes.NewNQNNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"NQN ValueQualifiedName Error",
+					"NQN string value is null",
+				),
+			},
+		},
+		"NQN value is unknown": {
+			nqnValue: hwtypes.NewNQNUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"NQN ValueQualifiedName Error",
+					"NQN string value is unknown",
+				),
+			},
+		},
+		"NQN": {
+			nqnValue: hwtypes.NewNQNValue("nqn.2014-08.com.Example:nvme:Subsystem1"),
+			expectedName: hwtypes.QualifiedName{
+				Format:          hwtypes.QualifiedNameFormatNQN,
+				Date:            "2014-08",
+				NamingAuthority: "com.example",
+				Suffix:          "nvme:Subsystem1",
+			},
+		},
+		"NQN - UUID": {
+			nqnValue: hwtypes.NewNQNValue("nqn.2014-08.org.nvmexpress:uuid:F81D4FAE-7DEC-11D0-A765-00A0C91E6BF6"),
+			expectedName: hwtypes.QualifiedName{
+				Format:          hwtypes.QualifiedNameFormatNQN,
+				Date:            "2014-08",
+				NamingAuthority: "org.nvmexpress",
+				Suffix:          "uuid:f81d4fae-7dec-11d0-a765-00a0c91e6bf6",
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			qualifiedName, diags := testCase.nqnValue.ValueQualifiedName()
+
+			if diff := cmp.Diff(qualifiedName, testCase.expectedName); diff != "" {
+				t.Errorf("Unexpected difference in QualifiedName (-got, +expected): %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
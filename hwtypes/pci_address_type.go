@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package hwtypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = (*PCIAddressType)(nil)
+
+// PCIAddressType is an attribute type that represents a valid PCI device address in domain:bus:device.function
+// notation, as used by Linux sysfs and SR-IOV virtual function configuration. The domain is optional and defaults to
+// 0000. Semantic equality logic is defined for PCIAddressType, so that addresses expressed with varying case and with
+// or without the default domain are considered equal.
+//
+// All of the following are semantically equal:
+//   - 0000:3b:00.1
+//   - 0000:3B:00.1
+//   - 3b:00.1
+type PCIAddressType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t PCIAddressType) String() string {
+	return "hwtypes.PCIAddressType"
+}
+
+// ValueType returns the Value type.
+func (t PCIAddressType) ValueType(ctx context.Context) attr.Value {
+	return PCIAddress{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t PCIAddressType) Equal(o attr.Type) bool {
+	other, ok := o.(PCIAddressType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t PCIAddressType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return PCIAddress{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t PCIAddressType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
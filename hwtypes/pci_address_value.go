@@ -0,0 +1,267 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package hwtypes
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*PCIAddress)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*PCIAddress)(nil)
+	_ xattr.ValidateableAttribute                = (*PCIAddress)(nil)
+	_ function.ValidateableParameter             = (*PCIAddress)(nil)
+)
+
+// PCILocation is the decoded location of a PCI device function.
+type PCILocation struct {
+	// Domain is the PCI segment group, which is 0 on most systems.
+	Domain uint32
+
+	// Bus is the bus number, between 0 and 255.
+	Bus uint8
+
+	// Device is the device number, between 0 and 31.
+	Device uint8
+
+	// Function is the function number, between 0 and 7.
+	Function uint8
+}
+
+// String returns the PCI location in the domain:bus:device.function notation used by Linux sysfs, for example 0000:3b:00.1.
+func (l PCILocation) String() string {
+	return fmt.Sprintf("%04x:%02x:%02x.%x", l.Domain, l.Bus, l.Device, l.Function)
+}
+
+// PCIAddress represents a valid PCI device address in domain:bus:device.function notation, as used by Linux sysfs and
+// SR-IOV virtual function configuration. The domain is optional and defaults to 0000. Semantic equality logic is
+// defined for PCIAddress, so that addresses expressed with varying case and with or without the default domain are
+// considered equal.
+//
+// All of the following are semantically equal:
+//   - 0000:3b:00.1
+//   - 0000:3B:00.1
+//   - 3b:00.1
+type PCIAddress struct {
+	basetypes.StringValue
+}
+
+// Type returns a PCIAddressType.
+func (v PCIAddress) Type(_ context.Context) attr.Type {
+	return PCIAddressType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v PCIAddress) Equal(o attr.Value) bool {
+	other, ok := o.(PCIAddress)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given PCI address string value is semantically equal to the current PCI
+// address string value. This comparison decodes both values into their domain, bus, device and function numbers, so
+// that addresses expressed with varying case and with or without the default domain are considered equal.
+//
+// All of the following are semantically equal:
+//   - 0000:3b:00.1
+//   - 0000:3B:00.1
+//   - 3b:00.1
+func (v PCIAddress) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(PCIAddress)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// PCI addresses are already validated at this point, ignoring errors
+	newLocation, _ := parsePCIAddress(newValue.ValueString())
+	currentLocation, _ := parsePCIAddress(v.ValueString())
+
+	return currentLocation == newLocation, diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String
+// value that is a valid PCI address, with an optional domain of 4 to 8 hexadecimal digits, a 2 digit bus, a 2 digit
+// device between 00 and 1f and a single digit function between 0 and 7.
+func (v PCIAddress) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parsePCIAddress(v.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid PCI Address String Value",
+			"A string value was provided that is not valid PCI address string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is a valid PCI address, with an optional domain of 4 to 8 hexadecimal digits, a
+// 2 digit bus, a 2 digit device between 00 and 1f and a single digit function between 0 and 7.
+func (v PCIAddress) ValidateParameter(_ context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parsePCIAddress(v.ValueString())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid PCI Address String Value: "+
+				"A string value was provided that is not valid PCI address string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValuePCILocation decodes the PCIAddress StringValue into a PCILocation, with a domain of 0 if one was not given. A
+// null or unknown value will produce an error diagnostic.
+func (v PCIAddress) ValuePCILocation() (PCILocation, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("PCIAddress ValuePCILocation Error", "PCI address string value is null"))
+		return PCILocation{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("PCIAddress ValuePCILocation Error", "PCI address string value is unknown"))
+		return PCILocation{}, diags
+	}
+
+	location, err := parsePCIAddress(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("PCIAddress ValuePCILocation Error", err.Error()))
+		return PCILocation{}, diags
+	}
+
+	return location, nil
+}
+
+// parsePCIAddress decodes a PCI address in [domain:]bus:device.function notation into a PCILocation.
+func parsePCIAddress(s string) (PCILocation, error) {
+	var location PCILocation
+
+	slot, fnField, ok := strings.Cut(s, ".")
+	if !ok {
+		return location, fmt.Errorf("PCI address %q: must be in [domain:]bus:device.function notation", s)
+	}
+
+	groups := strings.Split(slot, ":")
+
+	switch len(groups) {
+	case 2:
+		// domain defaults to 0000
+	case 3:
+		if len(groups[0]) < 4 || len(groups[0]) > 8 {
+			return location, fmt.Errorf("PCI address %q: domain must be 4 to 8 hexadecimal digits", s)
+		}
+
+		domain, err := strconv.ParseUint(groups[0], 16, 32)
+		if err != nil {
+			return location, fmt.Errorf("PCI address %q: domain must be 4 to 8 hexadecimal digits", s)
+		}
+
+		location.Domain = uint32(domain)
+		groups = groups[1:]
+	default:
+		return location, fmt.Errorf("PCI address %q: must be in [domain:]bus:device.function notation", s)
+	}
+
+	bus, err := parsePCIAddressField(groups[0], 2, 0xff)
+	if err != nil {
+		return location, fmt.Errorf("PCI address %q: bus must be 2 hexadecimal digits", s)
+	}
+
+	device, err := parsePCIAddressField(groups[1], 2, 0x1f)
+	if err != nil {
+		return location, fmt.Errorf("PCI address %q: device must be 2 hexadecimal digits between 00 and 1f", s)
+	}
+
+	fnNumber, err := parsePCIAddressField(fnField, 1, 0x7)
+	if err != nil {
+		return location, fmt.Errorf("PCI address %q: function must be a single digit between 0 and 7", s)
+	}
+
+	location.Bus, location.Device, location.Function = bus, device, fnNumber
+
+	return location, nil
+}
+
+// parsePCIAddressField decodes a fixed width hexadecimal bus, device or function number no greater than maxValue.
+func parsePCIAddressField(s string, width int, maxValue uint64) (uint8, error) {
+	if len(s) != width {
+		return 0, strconv.ErrSyntax
+	}
+
+	value, err := strconv.ParseUint(s, 16, 8)
+	if err != nil {
+		return 0, err
+	}
+
+	if value > maxValue {
+		return 0, strconv.ErrRange
+	}
+
+	return uint8(value), nil
+}
+
+// NewPCIAddressNull creates a PCIAddress with a null value. Determine whether the value is null via IsNull method.
+func NewPCIAddressNull() PCIAddress {
+	return PCIAddress{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewPCIAddressUnknown creates a PCIAddress with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewPCIAddressUnknown() PCIAddress {
+	return PCIAddress{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewPCIAddressValue creates a PCIAddress with a known value. Access the value via ValueString method.
+func NewPCIAddressValue(value string) PCIAddress {
+	return PCIAddress{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewPCIAddressPointerValue creates a PCIAddress with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewPCIAddressPointerValue(value *string) PCIAddress {
+	return PCIAddress{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
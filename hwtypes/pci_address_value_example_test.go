@@ -0,0 +1,53 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package hwtypes_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/hwtypes"
+)
+
+type VirtualFunctionResourceModel struct {
+	PCIAddress hwtypes.PCIAddress `tfsdk:"pci_address"`
+	PortGUID   hwtypes.IBGUID     `tfsdk:"port_guid"`
+}
+
+func ExamplePCIAddress_ValuePCILocation() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := VirtualFunctionResourceModel{
+		PCIAddress: hwtypes.NewPCIAddressValue("3b:00.1"),
+	}
+
+	// Check that the PCIAddress data is known and able to be decoded
+	if !data.PCIAddress.IsNull() && !data.PCIAddress.IsUnknown() {
+		location, diags := data.PCIAddress.ValuePCILocation()
+		if diags.HasError() {
+			return
+		}
+
+		// Output: 0000:3b:00.1, function 1
+		fmt.Printf("%s, function %d\n", location, location.Function)
+	}
+}
+
+func ExampleIBGUID_ValueIBGUID() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := VirtualFunctionResourceModel{
+		PortGUID: hwtypes.NewIBGUIDValue("0002:c903:0003:1234"),
+	}
+
+	// Check that the IBGUID data is known and able to be decoded
+	if !data.PortGUID.IsNull() && !data.PortGUID.IsUnknown() {
+		guid, diags := data.PortGUID.ValueIBGUID()
+		if diags.HasError() {
+			return
+		}
+
+		// Output: 0x0002c90300031234
+		fmt.Printf("%#016x\n", guid)
+	}
+}
@@ -0,0 +1,326 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package hwtypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/hwtypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestPCIAddressStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentPCIAddress hwtypes.PCIAddress
+		givenPCIAddress   basetypes.StringValuable
+		expectedMatch     bool
+		expectedDiags     diag.Diagnostics
+	}{
+		"semantically equal - byte-for-byte match": {
+			currentPCIAddress: hwtypes.NewPCIAddressValue("0000:3b:00.1"),
+			givenPCIAddress:   hwtypes.NewPCIAddressValue("0000:3b:00.1"),
+			expectedMatch:     true,
+		},
+		"semantically equal - case insensitive": {
+			currentPCIAddress: hwtypes.NewPCIAddressValue("0000:3b:00.1"),
+			givenPCIAddress:   hwtypes.NewPCIAddressValue("0000:3B:00.1"),
+			expectedMatch:     true,
+		},
+		"semantically equal - default domain omitted": {
+			currentPCIAddress: hwtypes.NewPCIAddressValue("0000:3b:00.1"),
+			givenPCIAddress:   hwtypes.NewPCIAddressValue("3b:00.1"),
+			expectedMatch:     true,
+		},
+		"semantically equal - 8 digit domain": {
+			currentPCIAddress: hwtypes.NewPCIAddressValue("10000:e1:00.0"),
+			givenPCIAddress:   hwtypes.NewPCIAddressValue("00010000:E1:00.0"),
+			expectedMatch:     true,
+		},
+		"not equal - non-default domain omitted": {
+			currentPCIAddress: hwtypes.NewPCIAddressValue("0001:3b:00.1"),
+			givenPCIAddress:   hwtypes.NewPCIAddressValue("3b:00.1"),
+			expectedMatch:     false,
+		},
+		"not equal - function mismatch": {
+			currentPCIAddress: hwtypes.NewPCIAddressValue("0000:3b:00.1"),
+			givenPCIAddress:   hwtypes.NewPCIAddressValue("0000:3b:00.2"),
+			expectedMatch:     false,
+		},
+		"error - not given PCIAddress value": {
+			currentPCIAddress: hwtypes.NewPCIAddressValue("0000:3b:00.1"),
+			givenPCIAddress:   basetypes.NewStringValue("0000:3b:00.1"),
+			expectedMatch:     false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: hwtypes.PCIAddress\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentPCIAddress.StringSemanticEquals(context.Background(), testCase.givenPCIAddress)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestPCIAddressValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		pciAddress    hwtypes.PCIAddress
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			pciAddress: hwtypes.PCIAddress{},
+		},
+		"null": {
+			pciAddress: hwtypes.NewPCIAddressNull(),
+		},
+		"unknown": {
+			pciAddress: hwtypes.NewPCIAddressUnknown(),
+		},
+		"valid PCI address": {
+			pciAddress: hwtypes.NewPCIAddressValue("0000:3b:00.1"),
+		},
+		"valid PCI address - without domain": {
+			pciAddress: hwtypes.NewPCIAddressValue("ff:1f.7"),
+		},
+		"invalid PCI address - missing function": {
+			pciAddress: hwtypes.NewPCIAddressValue("0000:3b:00"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid PCI Address String Value",
+					"A string value was provided that is not valid PCI address string format.\n\n"+
+						"Given Value: 0000:3b:00\n"+
+						"Error: PCI address \"0000:3b:00\": must be in [domain:]bus:device.function notation",
+				),
+			},
+		},
+		"invalid PCI address - short domain": {
+			pciAddress: hwtypes.NewPCIAddressValue("0:3b:00.1"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid PCI Address String Value",
+					"A string value was provided that is not valid PCI address string format.\n\n"+
+						"Given Value: 0:3b:00.1\n"+
+						"Error: PCI address \"0:3b:00.1\": domain must be 4 to 8 hexadecimal digits",
+				),
+			},
+		},
+		"invalid PCI address - single digit bus": {
+			pciAddress: hwtypes.NewPCIAddressValue("0000:3:00.1"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid PCI Address String Value",
+					"A string value was provided that is not valid PCI address string format.\n\n"+
+						"Given Value: 0000:3:00.1\n"+
+						"Error: PCI address \"0000:3:00.1\": bus must be 2 hexadecimal digits",
+				),
+			},
+		},
+		"invalid PCI address - device out of range": {
+			pciAddress: hwtypes.NewPCIAddressValue("0000:3b:20.1"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid PCI Address String Value",
+					"A string value was provided that is not valid PCI address string format.\n\n"+
+						"Given Value: 0000:3b:20.1\n"+
+						"Error: PCI address \"0000:3b:20.1\": device must be 2 hexadecimal digits between 00 and 1f",
+				),
+			},
+		},
+		"invalid PCI address - function out of range": {
+			pciAddress: hwtypes.NewPCIAddressValue("0000:3b:00.8"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid PCI Address String Value",
+					"A string value was provided that is not valid PCI address string format.\n\n"+
+						"Given Value: 0000:3b:00.8\n"+
+						"Error: PCI address \"0000:3b:00.8\": function must be a single digit between 0 and 7",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.pciAddress.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{Path: path.Root("test")},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestPCIAddressValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		pciAddress      hwtypes.PCIAddress
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			pciAddress: hwtypes.PCIAddress{},
+		},
+		"null": {
+			pciAddress: hwtypes.NewPCIAddressNull(),
+		},
+		"unknown": {
+			pciAddress: hwtypes.NewPCIAddressUnknown(),
+		},
+		"valid PCI address": {
+			pciAddress: hwtypes.NewPCIAddressValue("0000:3b:00.1"),
+		},
+		"invalid PCI address - bogus domain": {
+			pciAddress: hwtypes.NewPCIAddressValue("000g:3b:00.1"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid PCI Address String Value: "+
+					"A string value was provided that is not valid PCI address string format.\n\n"+
+					"Given Value: 000g:3b:00.1\n"+
+					"Error: PCI address \"000g:3b:00.1\": domain must be 4 to 8 hexadecimal digits",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.pciAddress.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestPCIAddressValuePCILocation(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		pciAddress       hwtypes.PCIAddress
+		expectedLocation hwtypes.PCILocation
+		expectedDiags    diag.Diagnostics
+	}{
+		"PCI address value is null": {
+			pciAddress: hwtypes.NewPCIAddressNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"PCIAddress ValuePCILocation Error",
+					"PCI address string value is null",
+				),
+			},
+		},
+		"PCI address value is unknown": {
+			pciAddress: hwtypes.NewPCIAddressUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"PCIAddress ValuePCILocation Error",
+					"PCI address string value is unknown",
+				),
+			},
+		},
+		"valid PCI address": {
+			pciAddress:       hwtypes.NewPCIAddressValue("0001:3B:1f.7"),
+			expectedLocation: hwtypes.PCILocation{Domain: 1, Bus: 0x3b, Device: 0x1f, Function: 7},
+		},
+		"valid PCI address - without domain": {
+			pciAddress:       hwtypes.NewPCIAddressValue("3b:00.1"),
+			expectedLocation: hwtypes.PCILocation{Domain: 0, Bus: 0x3b, Device: 0, Function: 1},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			location, diags := testCase.pciAddress.ValuePCILocation()
+
+			if diff := cmp.Diff(location, testCase.expectedLocation); diff != "" {
+				t.Errorf("Unexpected difference in PCILocation (-got, +expected): %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestPCILocationString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		location hwtypes.PCILocation
+		expected string
+	}{
+		"zero": {
+			location: hwtypes.PCILocation{},
+			expected: "0000:00:00.0",
+		},
+		"default domain": {
+			location: hwtypes.PCILocation{Bus: 0x3b, Device: 0x1f, Function: 7},
+			expected: "0000:3b:1f.7",
+		},
+		"wide domain": {
+			location: hwtypes.PCILocation{Domain: 0x10000, Bus: 0xe1},
+			expected: "10000:e1:00.0",
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := testCase.location.String(); got != testCase.expected {
+				t.Errorf("Expected %q, got %q", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,210 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package hwtypes
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// QualifiedNameFormatIQN is an iSCSI qualified name (RFC 3720 Section 3.2.6.3.1).
+	QualifiedNameFormatIQN = "iqn"
+
+	// QualifiedNameFormatEUI is an iSCSI name based on an IEEE EUI-64 identifier (RFC 3720 Section 3.2.6.3.2).
+	QualifiedNameFormatEUI = "eui"
+
+	// QualifiedNameFormatNAA is an iSCSI name based on a T11 Network Address Authority identifier (RFC 3980).
+	QualifiedNameFormatNAA = "naa"
+
+	// QualifiedNameFormatNQN is an NVMe qualified name (NVM Express Base Specification).
+	QualifiedNameFormatNQN = "nqn"
+
+	// qualifiedNameMaxLength is the maximum length in bytes of both iSCSI names and NVMe qualified names.
+	qualifiedNameMaxLength = 223
+
+	// nqnUUIDPrefix is the prefix of an NVMe qualified name generated from a UUID.
+	nqnUUIDPrefix = "nqn.2014-08.org.nvmexpress:uuid:"
+)
+
+// QualifiedName is a parsed iSCSI or NVMe qualified name, with each component folded to the case mandated by its format.
+type QualifiedName struct {
+	// Format is the type designator of the name, such as QualifiedNameFormatIQN.
+	Format string
+
+	// Date is the year and month, in yyyy-mm format, that the naming authority owned its domain name. Date is only set
+	// for the iqn and nqn formats.
+	Date string
+
+	// NamingAuthority is the reversed domain name of the naming authority, such as com.example. NamingAuthority is only
+	// set for the iqn and nqn formats.
+	NamingAuthority string
+
+	// Suffix is the string following the naming authority and colon for the iqn and nqn formats, which may be empty. For
+	// the eui and naa formats, Suffix is the identifier as upper case hexadecimal digits.
+	Suffix string
+}
+
+// String returns the qualified name in its canonical form, such as iqn.2001-04.com.example:storage.
+func (n QualifiedName) String() string {
+	switch n.Format {
+	case QualifiedNameFormatEUI, QualifiedNameFormatNAA:
+		return n.Format + "." + n.Suffix
+	}
+
+	name := n.Format + "." + n.Date + "." + n.NamingAuthority
+
+	if n.Suffix != "" {
+		name += ":" + n.Suffix
+	}
+
+	return name
+}
+
+// parseISCSIName parses an iSCSI name in iqn, eui or naa format. iSCSI names are case-insensitive (RFC 3722), so the
+// name is folded to lower case, other than the hexadecimal identifier of the eui and naa formats, which is written in
+// upper case by convention.
+func parseISCSIName(s string) (QualifiedName, error) {
+	if len(s) > qualifiedNameMaxLength {
+		return QualifiedName{}, fmt.Errorf("iSCSI name %q: must be at most %d bytes, got %d", s, qualifiedNameMaxLength, len(s))
+	}
+
+	for _, r := range s {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') && (r < '0' || r > '9') && r != '-' && r != '.' && r != ':' {
+			return QualifiedName{}, fmt.Errorf("iSCSI name %q: must only contain ASCII letters, digits, hyphens, dots and colons", s)
+		}
+	}
+
+	format, rest, _ := strings.Cut(strings.ToLower(s), ".")
+
+	switch format {
+	case QualifiedNameFormatIQN:
+		name, err := parseDatedQualifiedName(format, rest)
+		if err != nil {
+			return QualifiedName{}, fmt.Errorf("iSCSI name %q: %w", s, err)
+		}
+
+		return name, nil
+	case QualifiedNameFormatEUI:
+		if len(rest) != 16 || !isHex(rest) {
+			return QualifiedName{}, fmt.Errorf("iSCSI name %q: eui format must be followed by 16 hexadecimal digits", s)
+		}
+	case QualifiedNameFormatNAA:
+		if (len(rest) != 16 && len(rest) != 32) || !isHex(rest) {
+			return QualifiedName{}, fmt.Errorf("iSCSI name %q: naa format must be followed by 16 or 32 hexadecimal digits", s)
+		}
+	default:
+		return QualifiedName{}, fmt.Errorf("iSCSI name %q: must begin with a type designator of iqn., eui. or naa.", s)
+	}
+
+	return QualifiedName{
+		Format: format,
+		Suffix: strings.ToUpper(rest),
+	}, nil
+}
+
+// parseNQN parses an NVMe qualified name. The nqn type designator and date are fixed case, the naming authority is a
+// case-insensitive domain name that is folded to lower case and the user-defined suffix is case-sensitive, other than
+// the hexadecimal digits of a UUID-based NQN, which are folded to lower case.
+func parseNQN(s string) (QualifiedName, error) {
+	if len(s) > qualifiedNameMaxLength {
+		return QualifiedName{}, fmt.Errorf("NQN %q: must be at most %d bytes, got %d", s, qualifiedNameMaxLength, len(s))
+	}
+
+	if !utf8.ValidString(s) {
+		return QualifiedName{}, fmt.Errorf("NQN %q: must be valid UTF-8", s)
+	}
+
+	rest, ok := strings.CutPrefix(s, QualifiedNameFormatNQN+".")
+	if !ok {
+		return QualifiedName{}, fmt.Errorf("NQN %q: must begin with a type designator of nqn.", s)
+	}
+
+	name, err := parseDatedQualifiedName(QualifiedNameFormatNQN, rest)
+	if err != nil {
+		return QualifiedName{}, fmt.Errorf("NQN %q: %w", s, err)
+	}
+
+	name.NamingAuthority = strings.ToLower(name.NamingAuthority)
+
+	if uuid, ok := strings.CutPrefix(name.String(), nqnUUIDPrefix); ok {
+		if !isUUID(uuid) {
+			return QualifiedName{}, fmt.Errorf("NQN %q: UUID-based NQN must end with a UUID in 8-4-4-4-12 hexadecimal format", s)
+		}
+
+		name.Suffix = strings.ToLower(name.Suffix)
+	}
+
+	return name, nil
+}
+
+// parseDatedQualifiedName parses the yyyy-mm.naming-authority[:suffix] portion of an iqn or nqn format name.
+func parseDatedQualifiedName(format string, s string) (QualifiedName, error) {
+	if len(s) < 8 || s[4] != '-' || s[7] != '.' || !isDigits(s[0:4]) || !isDigits(s[5:7]) || s[5:7] < "01" || s[5:7] > "12" {
+		return QualifiedName{}, fmt.Errorf("%s format must be followed by a date in yyyy-mm format and a dot", format)
+	}
+
+	namingAuthority, suffix, hasSuffix := strings.Cut(s[8:], ":")
+
+	for _, label := range strings.Split(namingAuthority, ".") {
+		if label == "" || strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") {
+			return QualifiedName{}, errors.New("naming authority must be a reversed domain name")
+		}
+
+		for _, r := range label {
+			if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') && (r < '0' || r > '9') && r != '-' {
+				return QualifiedName{}, errors.New("naming authority must be a reversed domain name")
+			}
+		}
+	}
+
+	if hasSuffix && suffix == "" {
+		return QualifiedName{}, errors.New("suffix must not be empty when the naming authority is followed by a colon")
+	}
+
+	return QualifiedName{
+		Format:          format,
+		Date:            s[0:7],
+		NamingAuthority: namingAuthority,
+		Suffix:          suffix,
+	}, nil
+}
+
+// isDigits returns true if s only contains ASCII decimal digits.
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isHex returns true if s is a non-empty string of an even number of hexadecimal digits.
+func isHex(s string) bool {
+	_, err := hex.DecodeString(s)
+
+	return s != "" && err == nil
+}
+
+// isUUID returns true if s is a UUID in the 8-4-4-4-12 hexadecimal format.
+func isUUID(s string) bool {
+	groups := strings.Split(s, "-")
+
+	if len(groups) != 5 {
+		return false
+	}
+
+	for i, length := range []int{8, 4, 4, 4, 12} {
+		if len(groups[i]) != length || !isHex(groups[i]) {
+			return false
+		}
+	}
+
+	return true
+}
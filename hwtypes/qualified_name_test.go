@@ -0,0 +1,53 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package hwtypes_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/hwtypes"
+)
+
+func TestQualifiedNameString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		name     hwtypes.QualifiedName
+		expected string
+	}{
+		"iqn": {
+			name: hwtypes.QualifiedName{
+				Format:          hwtypes.QualifiedNameFormatIQN,
+				Date:            "2001-04",
+				NamingAuthority: "com.example",
+				Suffix:          "storage",
+			},
+			expected: "iqn.2001-04.com.example:storage",
+		},
+		"nqn - without suffix": {
+			name: hwtypes.QualifiedName{
+				Format:          hwtypes.QualifiedNameFormatNQN,
+				Date:            "2014-08",
+				NamingAuthority: "org.nvmexpress.discovery",
+			},
+			expected: "nqn.2014-08.org.nvmexpress.discovery",
+		},
+		"eui": {
+			name: hwtypes.QualifiedName{
+				Format: hwtypes.QualifiedNameFormatEUI,
+				Suffix: "02004567A425678D",
+			},
+			expected: "eui.02004567A425678D",
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := testCase.name.String(); got != testCase.expected {
+				t.Errorf("Expected %q, got %q", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,285 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package iptypes
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*InterfaceAddress)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*InterfaceAddress)(nil)
+	_ xattr.ValidateableAttribute                = (*InterfaceAddress)(nil)
+	_ function.ValidateableParameter             = (*InterfaceAddress)(nil)
+)
+
+// InterfaceAddress represents a valid IPv4 or IPv6 interface address string, which is an address followed by the prefix
+// length of its network, such as `10.0.0.5/24` or `2001:db8::5/64`. Unlike a CIDR network, the host bits of the address
+// are meaningful and are preserved. IPv4 addresses must not be the network or broadcast address of a prefix shorter
+// than /31 (RFC 3021). Semantic equality logic is defined for InterfaceAddress such that an address string with the
+// zero bits `compressed` will be considered equivalent to the `non-compressed` string.
+//
+// Examples:
+//   - `2001:DB8:0:0:0:0:0:5/64` is semantically equal to `2001:db8::5/64`
+//   - `0:0:0:0:0:0:0:1/128` is semantically equal to `::1/128`
+type InterfaceAddress struct {
+	basetypes.StringValue
+}
+
+// Type returns an InterfaceAddressType.
+func (v InterfaceAddress) Type(_ context.Context) attr.Type {
+	return InterfaceAddressType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v InterfaceAddress) Equal(o attr.Value) bool {
+	other, ok := o.(InterfaceAddress)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given interface address string value is semantically equal to the current interface address
+// string value. This comparison utilizes netip.ParsePrefix and then compares the resulting addresses and prefix lengths.
+// This means `compressed` IPv6 addresses are considered semantically equal to `non-compressed` IPv6 addresses, while
+// host bits are significant.
+//
+// Examples:
+//   - `2001:DB8:0:0:0:0:0:5/64` is semantically equal to `2001:db8::5/64`
+//   - `0:0:0:0:0:0:0:1/128` is semantically equal to `::1/128`
+func (v InterfaceAddress) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(InterfaceAddress)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// Interface addresses are already validated at this point, ignoring errors
+	newIPPrefix, _ := netip.ParsePrefix(newValue.ValueString())
+	currentIPPrefix, _ := netip.ParsePrefix(v.ValueString())
+
+	return currentIPPrefix.Addr() == newIPPrefix.Addr() && currentIPPrefix.Bits() == newIPPrefix.Bits(), diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String value
+// that is a valid IPv4 or IPv6 address with a prefix length, which is not the network or broadcast address of an IPv4
+// prefix shorter than /31.
+func (v InterfaceAddress) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	ipPrefix, err := netip.ParsePrefix(v.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Interface Address String Value",
+			"A string value was provided that is not valid IPv4 or IPv6 interface address string format (address/prefix length).\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if !ipPrefix.IsValid() {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Interface Address String Value",
+			"A string value was provided that is not valid IPv4 or IPv6 interface address string format (address/prefix length).\n\n"+
+				"Given Value: "+v.ValueString()+"\n",
+		)
+
+		return
+	}
+
+	err = validateIPv4InterfaceAddress(ipPrefix)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Interface Address String Value",
+			"A string value was provided that is not a valid interface address, as it cannot be assigned to an interface.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is a valid IPv4 or IPv6 address with a prefix length, which is not the network or
+// broadcast address of an IPv4 prefix shorter than /31.
+func (v InterfaceAddress) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	ipPrefix, err := netip.ParsePrefix(v.ValueString())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid Interface Address String Value: "+
+				"A string value was provided that is not valid IPv4 or IPv6 interface address string format (address/prefix length).\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if !ipPrefix.IsValid() {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid Interface Address String Value: "+
+				"A string value was provided that is not valid IPv4 or IPv6 interface address string format (address/prefix length).\n\n"+
+				"Given Value: "+v.ValueString()+"\n",
+		)
+
+		return
+	}
+
+	err = validateIPv4InterfaceAddress(ipPrefix)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid Interface Address String Value: "+
+				"A string value was provided that is not a valid interface address, as it cannot be assigned to an interface.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueInterfaceAddress calls netip.ParsePrefix with the InterfaceAddress StringValue, keeping the host bits of the
+// address. A null or unknown value will produce an error diagnostic.
+func (v InterfaceAddress) ValueInterfaceAddress() (netip.Prefix, diag.Diagnostics) {
+	return v.valueInterfaceAddress("ValueInterfaceAddress")
+}
+
+// ValueAddr returns the address of the InterfaceAddress StringValue, such as `10.0.0.5` for `10.0.0.5/24`. A null or
+// unknown value will produce an error diagnostic.
+func (v InterfaceAddress) ValueAddr() (netip.Addr, diag.Diagnostics) {
+	ipPrefix, diags := v.valueInterfaceAddress("ValueAddr")
+	if diags.HasError() {
+		return netip.Addr{}, diags
+	}
+
+	return ipPrefix.Addr(), nil
+}
+
+// ValuePrefix returns the network of the InterfaceAddress StringValue with the host bits masked, such as `10.0.0.0/24`
+// for `10.0.0.5/24`. A null or unknown value will produce an error diagnostic.
+func (v InterfaceAddress) ValuePrefix() (netip.Prefix, diag.Diagnostics) {
+	ipPrefix, diags := v.valueInterfaceAddress("ValuePrefix")
+	if diags.HasError() {
+		return netip.Prefix{}, diags
+	}
+
+	return ipPrefix.Masked(), nil
+}
+
+// valueInterfaceAddress parses the InterfaceAddress StringValue, producing error diagnostics attributed to the given
+// accessor method.
+func (v InterfaceAddress) valueInterfaceAddress(method string) (netip.Prefix, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("InterfaceAddress "+method+" Error", "interface address string value is null"))
+		return netip.Prefix{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("InterfaceAddress "+method+" Error", "interface address string value is unknown"))
+		return netip.Prefix{}, diags
+	}
+
+	ipPrefix, err := netip.ParsePrefix(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("InterfaceAddress "+method+" Error", err.Error()))
+		return netip.Prefix{}, diags
+	}
+
+	return ipPrefix, nil
+}
+
+// validateIPv4InterfaceAddress returns an error if an IPv4 interface address is the network or broadcast address of
+// its prefix, which cannot be assigned to an interface unless the prefix is a /31 point-to-point link (RFC 3021) or a
+// /32 host route.
+func validateIPv4InterfaceAddress(ipPrefix netip.Prefix) error {
+	if !ipPrefix.Addr().Is4() || ipPrefix.Bits() >= 31 {
+		return nil
+	}
+
+	network := ipPrefix.Masked()
+
+	if ipPrefix.Addr() == network.Addr() {
+		return fmt.Errorf("address %s is the network address of %s", ipPrefix.Addr(), network)
+	}
+
+	if ipPrefix.Addr() == ipv4BroadcastAddress(network) {
+		return fmt.Errorf("address %s is the broadcast address of %s", ipPrefix.Addr(), network)
+	}
+
+	return nil
+}
+
+// ipv4BroadcastAddress returns the last address of an IPv4 prefix.
+func ipv4BroadcastAddress(network netip.Prefix) netip.Addr {
+	octets := network.Addr().As4()
+	hostBits := uint32(1)<<(32-network.Bits()) - 1
+	address := uint32(octets[0])<<24 | uint32(octets[1])<<16 | uint32(octets[2])<<8 | uint32(octets[3]) | hostBits
+
+	return netip.AddrFrom4([4]byte{byte(address >> 24), byte(address >> 16), byte(address >> 8), byte(address)})
+}
+
+// NewInterfaceAddressNull creates an InterfaceAddress with a null value. Determine whether the value is null via IsNull method.
+func NewInterfaceAddressNull() InterfaceAddress {
+	return InterfaceAddress{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewInterfaceAddressUnknown creates an InterfaceAddress with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewInterfaceAddressUnknown() InterfaceAddress {
+	return InterfaceAddress{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewInterfaceAddressValue creates an InterfaceAddress with a known value. Access the value via ValueString method.
+func NewInterfaceAddressValue(value string) InterfaceAddress {
+	return InterfaceAddress{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewInterfaceAddressPointerValue creates an InterfaceAddress with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewInterfaceAddressPointerValue(value *string) InterfaceAddress {
+	return InterfaceAddress{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
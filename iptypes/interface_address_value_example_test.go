@@ -0,0 +1,38 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package iptypes_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/iptypes"
+)
+
+type InterfaceAddressResourceModel struct {
+	InterfaceAddress iptypes.InterfaceAddress `tfsdk:"interface_address"`
+}
+
+func ExampleInterfaceAddress_ValuePrefix() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := InterfaceAddressResourceModel{
+		InterfaceAddress: iptypes.NewInterfaceAddressValue("10.0.0.5/24"),
+	}
+
+	// Check that the InterfaceAddress data is known and split it into the host address and its connected network
+	if !data.InterfaceAddress.IsNull() && !data.InterfaceAddress.IsUnknown() {
+		ipAddr, diags := data.InterfaceAddress.ValueAddr()
+		if diags.HasError() {
+			return
+		}
+
+		ipPrefix, diags := data.InterfaceAddress.ValuePrefix()
+		if diags.HasError() {
+			return
+		}
+
+		// Output: 10.0.0.5, 10.0.0.0/24
+		fmt.Printf("%s, %s\n", ipAddr, ipPrefix)
+	}
+}
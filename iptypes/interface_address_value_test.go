@@ -0,0 +1,559 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package iptypes_test
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/iptypes"
+)
+
+func TestInterfaceAddressStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentAddress iptypes.InterfaceAddress
+		givenAddress   basetypes.StringValuable
+		expectedMatch  bool
+		expectedDiags  diag.Diagnostics
+	}{
+		"not equal - IPv4 address mismatch": {
+			currentAddress: iptypes.NewInterfaceAddressValue("10.0.0.5/24"),
+			givenAddress:   iptypes.NewInterfaceAddressValue("10.0.0.6/24"),
+			expectedMatch:  false,
+		},
+		"not equal - address mismatch": {
+			currentAddress: iptypes.NewInterfaceAddressValue("2001:db8::5/64"),
+			givenAddress:   iptypes.NewInterfaceAddressValue("2001:db8::6/64"),
+			expectedMatch:  false,
+		},
+		"not equal - prefix length mismatch": {
+			currentAddress: iptypes.NewInterfaceAddressValue("2001:db8::5/64"),
+			givenAddress:   iptypes.NewInterfaceAddressValue("2001:db8::5/48"),
+			expectedMatch:  false,
+		},
+		"not equal - host bits are significant": {
+			currentAddress: iptypes.NewInterfaceAddressValue("2001:db8::5/64"),
+			givenAddress:   iptypes.NewInterfaceAddressValue("2001:db8::/64"),
+			expectedMatch:  false,
+		},
+		"semantically equal - byte-for-byte match": {
+			currentAddress: iptypes.NewInterfaceAddressValue("2001:db8::5/64"),
+			givenAddress:   iptypes.NewInterfaceAddressValue("2001:db8::5/64"),
+			expectedMatch:  true,
+		},
+		"semantically equal - case insensitive": {
+			currentAddress: iptypes.NewInterfaceAddressValue("2001:DB8::5/64"),
+			givenAddress:   iptypes.NewInterfaceAddressValue("2001:db8::5/64"),
+			expectedMatch:  true,
+		},
+		"semantically equal - compressed match": {
+			currentAddress: iptypes.NewInterfaceAddressValue("2001:DB8:0:0:0:0:0:5/64"),
+			givenAddress:   iptypes.NewInterfaceAddressValue("2001:db8::5/64"),
+			expectedMatch:  true,
+		},
+		"semantically equal - compressed loopback match": {
+			currentAddress: iptypes.NewInterfaceAddressValue("0:0:0:0:0:0:0:1/128"),
+			givenAddress:   iptypes.NewInterfaceAddressValue("::1/128"),
+			expectedMatch:  true,
+		},
+		"semantically equal - IPv4 byte-for-byte match": {
+			currentAddress: iptypes.NewInterfaceAddressValue("10.0.0.5/24"),
+			givenAddress:   iptypes.NewInterfaceAddressValue("10.0.0.5/24"),
+			expectedMatch:  true,
+		},
+		"error - not given InterfaceAddress value": {
+			currentAddress: iptypes.NewInterfaceAddressValue("2001:db8::5/64"),
+			givenAddress:   basetypes.NewStringValue("2001:db8::5/64"),
+			expectedMatch:  false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: iptypes.InterfaceAddress\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentAddress.StringSemanticEquals(context.Background(), testCase.givenAddress)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestInterfaceAddressValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		addressValue  iptypes.InterfaceAddress
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			addressValue: iptypes.InterfaceAddress{},
+		},
+		"null": {
+			addressValue: iptypes.NewInterfaceAddressNull(),
+		},
+		"unknown": {
+			addressValue: iptypes.NewInterfaceAddressUnknown(),
+		},
+		"valid IPv4 interface address": {
+			addressValue: iptypes.NewInterfaceAddressValue("10.0.0.5/24"),
+		},
+		"valid IPv4 interface address - /31 network address": {
+			addressValue: iptypes.NewInterfaceAddressValue("192.0.2.0/31"),
+		},
+		"valid IPv4 interface address - /31 last address": {
+			addressValue: iptypes.NewInterfaceAddressValue("192.0.2.1/31"),
+		},
+		"valid IPv4 interface address - /32": {
+			addressValue: iptypes.NewInterfaceAddressValue("192.0.2.255/32"),
+		},
+		"valid IPv4 interface address - /30 host": {
+			addressValue: iptypes.NewInterfaceAddressValue("192.0.2.1/30"),
+		},
+		"valid IPv6 interface address": {
+			addressValue: iptypes.NewInterfaceAddressValue("2001:db8::5/64"),
+		},
+		"valid IPv6 interface address - subnet-router anycast": {
+			addressValue: iptypes.NewInterfaceAddressValue("2001:db8::/64"),
+		},
+		"valid IPv6 interface address - uppercase": {
+			addressValue: iptypes.NewInterfaceAddressValue("2001:DB8::5/64"),
+		},
+		"valid IPv6 interface address - /128": {
+			addressValue: iptypes.NewInterfaceAddressValue("::1/128"),
+		},
+		"invalid IPv4 interface address - network address": {
+			addressValue: iptypes.NewInterfaceAddressValue("10.0.0.0/24"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Interface Address String Value",
+					"A string value was provided that is not a valid interface address, as it cannot be assigned to an interface.\n\n"+
+						"Given Value: 10.0.0.0/24\n"+
+						"Error: address 10.0.0.0 is the network address of 10.0.0.0/24",
+				),
+			},
+		},
+		"invalid IPv4 interface address - broadcast address": {
+			addressValue: iptypes.NewInterfaceAddressValue("10.0.0.255/24"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Interface Address String Value",
+					"A string value was provided that is not a valid interface address, as it cannot be assigned to an interface.\n\n"+
+						"Given Value: 10.0.0.255/24\n"+
+						"Error: address 10.0.0.255 is the broadcast address of 10.0.0.0/24",
+				),
+			},
+		},
+		"invalid IPv4 interface address - /30 broadcast address": {
+			addressValue: iptypes.NewInterfaceAddressValue("192.0.2.3/30"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Interface Address String Value",
+					"A string value was provided that is not a valid interface address, as it cannot be assigned to an interface.\n\n"+
+						"Given Value: 192.0.2.3/30\n"+
+						"Error: address 192.0.2.3 is the broadcast address of 192.0.2.0/30",
+				),
+			},
+		},
+		"invalid IPv4 interface address - missing prefix length": {
+			addressValue: iptypes.NewInterfaceAddressValue("10.0.0.5"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Interface Address String Value",
+					"A string value was provided that is not valid IPv4 or IPv6 interface address string format (address/prefix length).\n\n"+
+						"Given Value: 10.0.0.5\n"+
+						"Error: "+"netip.ParsePrefix(\"10.0.0.5\"): no '/'",
+				),
+			},
+		},
+		"invalid IPv4 interface address - prefix length out of range": {
+			addressValue: iptypes.NewInterfaceAddressValue("10.0.0.5/33"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Interface Address String Value",
+					"A string value was provided that is not valid IPv4 or IPv6 interface address string format (address/prefix length).\n\n"+
+						"Given Value: 10.0.0.5/33\n"+
+						"Error: "+"netip.ParsePrefix(\"10.0.0.5/33\"): prefix length out of range",
+				),
+			},
+		},
+		"invalid IPv4 interface address - leading zeroes": {
+			addressValue: iptypes.NewInterfaceAddressValue("10.0.0.5/024"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Interface Address String Value",
+					"A string value was provided that is not valid IPv4 or IPv6 interface address string format (address/prefix length).\n\n"+
+						"Given Value: 10.0.0.5/024\n"+
+						"Error: "+"netip.ParsePrefix(\"10.0.0.5/024\"): bad bits after slash: \"024\"",
+				),
+			},
+		},
+		"invalid IPv6 interface address - missing prefix length": {
+			addressValue: iptypes.NewInterfaceAddressValue("2001:db8::5"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Interface Address String Value",
+					"A string value was provided that is not valid IPv4 or IPv6 interface address string format (address/prefix length).\n\n"+
+						"Given Value: 2001:db8::5\n"+
+						"Error: "+"netip.ParsePrefix(\"2001:db8::5\"): no '/'",
+				),
+			},
+		},
+		"invalid IPv6 interface address - zone": {
+			addressValue: iptypes.NewInterfaceAddressValue("fe80::1%eth0/64"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Interface Address String Value",
+					"A string value was provided that is not valid IPv4 or IPv6 interface address string format (address/prefix length).\n\n"+
+						"Given Value: fe80::1%eth0/64\n"+
+						"Error: "+"netip.ParsePrefix(\"fe80::1%eth0/64\"): IPv6 zones cannot be present in a prefix",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.addressValue.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{Path: path.Root("test")},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestInterfaceAddressValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		addressValue    iptypes.InterfaceAddress
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			addressValue: iptypes.InterfaceAddress{},
+		},
+		"null": {
+			addressValue: iptypes.NewInterfaceAddressNull(),
+		},
+		"unknown": {
+			addressValue: iptypes.NewInterfaceAddressUnknown(),
+		},
+		"valid IPv4 interface address": {
+			addressValue: iptypes.NewInterfaceAddressValue("10.0.0.5/24"),
+		},
+		"valid IPv4 interface address - /31 network address": {
+			addressValue: iptypes.NewInterfaceAddressValue("192.0.2.0/31"),
+		},
+		"valid IPv4 interface address - /31 last address": {
+			addressValue: iptypes.NewInterfaceAddressValue("192.0.2.1/31"),
+		},
+		"valid IPv4 interface address - /32": {
+			addressValue: iptypes.NewInterfaceAddressValue("192.0.2.255/32"),
+		},
+		"valid IPv4 interface address - /30 host": {
+			addressValue: iptypes.NewInterfaceAddressValue("192.0.2.1/30"),
+		},
+		"valid IPv6 interface address": {
+			addressValue: iptypes.NewInterfaceAddressValue("2001:db8::5/64"),
+		},
+		"valid IPv6 interface address - subnet-router anycast": {
+			addressValue: iptypes.NewInterfaceAddressValue("2001:db8::/64"),
+		},
+		"valid IPv6 interface address - uppercase": {
+			addressValue: iptypes.NewInterfaceAddressValue("2001:DB8::5/64"),
+		},
+		"valid IPv6 interface address - /128": {
+			addressValue: iptypes.NewInterfaceAddressValue("::1/128"),
+		},
+		"invalid IPv4 interface address - network address": {
+			addressValue: iptypes.NewInterfaceAddressValue("10.0.0.0/24"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid Interface Address String Value: "+
+					"A string value was provided that is not a valid interface address, as it cannot be assigned to an interface.\n\n"+
+					"Given Value: 10.0.0.0/24\n"+
+					"Error: address 10.0.0.0 is the network address of 10.0.0.0/24",
+			),
+		},
+		"invalid IPv4 interface address - broadcast address": {
+			addressValue: iptypes.NewInterfaceAddressValue("10.0.0.255/24"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid Interface Address String Value: "+
+					"A string value was provided that is not a valid interface address, as it cannot be assigned to an interface.\n\n"+
+					"Given Value: 10.0.0.255/24\n"+
+					"Error: address 10.0.0.255 is the broadcast address of 10.0.0.0/24",
+			),
+		},
+		"invalid IPv4 interface address - /30 broadcast address": {
+			addressValue: iptypes.NewInterfaceAddressValue("192.0.2.3/30"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid Interface Address String Value: "+
+					"A string value was provided that is not a valid interface address, as it cannot be assigned to an interface.\n\n"+
+					"Given Value: 192.0.2.3/30\n"+
+					"Error: address 192.0.2.3 is the broadcast address of 192.0.2.0/30",
+			),
+		},
+		"invalid IPv4 interface address - missing prefix length": {
+			addressValue: iptypes.NewInterfaceAddressValue("10.0.0.5"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid Interface Address String Value: "+
+					"A string value was provided that is not valid IPv4 or IPv6 interface address string format (address/prefix length).\n\n"+
+					"Given Value: 10.0.0.5\n"+
+					"Error: "+"netip.ParsePrefix(\"10.0.0.5\"): no '/'",
+			),
+		},
+		"invalid IPv4 interface address - prefix length out of range": {
+			addressValue: iptypes.NewInterfaceAddressValue("10.0.0.5/33"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid Interface Address String Value: "+
+					"A string value was provided that is not valid IPv4 or IPv6 interface address string format (address/prefix length).\n\n"+
+					"Given Value: 10.0.0.5/33\n"+
+					"Error: "+"netip.ParsePrefix(\"10.0.0.5/33\"): prefix length out of range",
+			),
+		},
+		"invalid IPv4 interface address - leading zeroes": {
+			addressValue: iptypes.NewInterfaceAddressValue("10.0.0.5/024"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid Interface Address String Value: "+
+					"A string value was provided that is not valid IPv4 or IPv6 interface address string format (address/prefix length).\n\n"+
+					"Given Value: 10.0.0.5/024\n"+
+					"Error: "+"netip.ParsePrefix(\"10.0.0.5/024\"): bad bits after slash: \"024\"",
+			),
+		},
+		"invalid IPv6 interface address - missing prefix length": {
+			addressValue: iptypes.NewInterfaceAddressValue("2001:db8::5"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid Interface Address String Value: "+
+					"A string value was provided that is not valid IPv4 or IPv6 interface address string format (address/prefix length).\n\n"+
+					"Given Value: 2001:db8::5\n"+
+					"Error: "+"netip.ParsePrefix(\"2001:db8::5\"): no '/'",
+			),
+		},
+		"invalid IPv6 interface address - zone": {
+			addressValue: iptypes.NewInterfaceAddressValue("fe80::1%eth0/64"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid Interface Address String Value: "+
+					"A string value was provided that is not valid IPv4 or IPv6 interface address string format (address/prefix length).\n\n"+
+					"Given Value: fe80::1%eth0/64\n"+
+					"Error: "+"netip.ParsePrefix(\"fe80::1%eth0/64\"): IPv6 zones cannot be present in a prefix",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.addressValue.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestInterfaceAddressValueInterfaceAddress(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		addressValue     iptypes.InterfaceAddress
+		expectedIPPrefix netip.Prefix
+		expectedDiags    diag.Diagnostics
+	}{
+		"interface address value is null": {
+			addressValue: iptypes.NewInterfaceAddressNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"InterfaceAddress ValueInterfaceAddress Error",
+					"interface address string value is null",
+				),
+			},
+		},
+		"interface address value is unknown": {
+			addressValue: iptypes.NewInterfaceAddressUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"InterfaceAddress ValueInterfaceAddress Error",
+					"interface address string value is unknown",
+				),
+			},
+		},
+		"valid interface address": {
+			addressValue:     iptypes.NewInterfaceAddressValue("10.0.0.5/24"),
+			expectedIPPrefix: netip.MustParsePrefix("10.0.0.5/24"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ipPrefix, diags := testCase.addressValue.ValueInterfaceAddress()
+
+			if ipPrefix != testCase.expectedIPPrefix {
+				t.Errorf("Unexpected difference in netip.Prefix, got: %s, expected: %s", ipPrefix, testCase.expectedIPPrefix)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestInterfaceAddressValueAddr(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		addressValue   iptypes.InterfaceAddress
+		expectedIPAddr netip.Addr
+		expectedDiags  diag.Diagnostics
+	}{
+		"interface address value is null": {
+			addressValue: iptypes.NewInterfaceAddressNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"InterfaceAddress ValueAddr Error",
+					"interface address string value is null",
+				),
+			},
+		},
+		"interface address value is unknown": {
+			addressValue: iptypes.NewInterfaceAddressUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"InterfaceAddress ValueAddr Error",
+					"interface address string value is unknown",
+				),
+			},
+		},
+		"valid interface address": {
+			addressValue:   iptypes.NewInterfaceAddressValue("10.0.0.5/24"),
+			expectedIPAddr: netip.MustParseAddr("10.0.0.5"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ipAddr, diags := testCase.addressValue.ValueAddr()
+
+			if ipAddr != testCase.expectedIPAddr {
+				t.Errorf("Unexpected difference in netip.Addr, got: %s, expected: %s", ipAddr, testCase.expectedIPAddr)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestInterfaceAddressValuePrefix(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		addressValue     iptypes.InterfaceAddress
+		expectedIPPrefix netip.Prefix
+		expectedDiags    diag.Diagnostics
+	}{
+		"interface address value is null": {
+			addressValue: iptypes.NewInterfaceAddressNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"InterfaceAddress ValuePrefix Error",
+					"interface address string value is null",
+				),
+			},
+		},
+		"interface address value is unknown": {
+			addressValue: iptypes.NewInterfaceAddressUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"InterfaceAddress ValuePrefix Error",
+					"interface address string value is unknown",
+				),
+			},
+		},
+		"valid interface address": {
+			addressValue:     iptypes.NewInterfaceAddressValue("10.0.0.5/24"),
+			expectedIPPrefix: netip.MustParsePrefix("10.0.0.0/24"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ipPrefix, diags := testCase.addressValue.ValuePrefix()
+
+			if ipPrefix != testCase.expectedIPPrefix {
+				t.Errorf("Unexpected difference in netip.Prefix, got: %s, expected: %s", ipPrefix, testCase.expectedIPPrefix)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
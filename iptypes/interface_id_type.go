@@ -0,0 +1,83 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package iptypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable = (*InterfaceIDType)(nil)
+)
+
+// InterfaceIDType is an attribute type that represents a valid IPv6 interface identifier string (RFC 4291), which is
+// the 64-bit suffix of an IPv6 address written as an IPv6 address with the upper 64 bits set to zero, such as `::1:2:3:4`
+// for tokenized SLAAC (RFC 7217) or static suffix assignment. Semantic equality logic is defined for InterfaceIDType
+// such that an interface identifier string with the zero bits `compressed` will be considered equivalent to the
+// `non-compressed` string.
+//
+// Examples:
+//   - `0:0:0:0:0:1:2:3` is semantically equal to `::1:2:3`
+//   - `::A:B:C:D` is semantically equal to `::a:b:c:d`
+type InterfaceIDType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t InterfaceIDType) String() string {
+	return "iptypes.InterfaceIDType"
+}
+
+// ValueType returns the Value type.
+func (t InterfaceIDType) ValueType(ctx context.Context) attr.Value {
+	return InterfaceID{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t InterfaceIDType) Equal(o attr.Type) bool {
+	other, ok := o.(InterfaceIDType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t InterfaceIDType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return InterfaceID{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t InterfaceIDType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
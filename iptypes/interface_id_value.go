@@ -0,0 +1,265 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package iptypes
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/cidrtypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*InterfaceID)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*InterfaceID)(nil)
+	_ xattr.ValidateableAttribute                = (*InterfaceID)(nil)
+	_ function.ValidateableParameter             = (*InterfaceID)(nil)
+)
+
+// interfaceIDBits is the length of an IPv6 interface identifier (RFC 4291 Section 2.5.1).
+const interfaceIDBits = 64
+
+// InterfaceID represents a valid IPv6 interface identifier string (RFC 4291), which is the 64-bit suffix of an IPv6
+// address written as an IPv6 address with the upper 64 bits set to zero, such as `::1:2:3:4` for tokenized SLAAC
+// (RFC 7217) or static suffix assignment. Semantic equality logic is defined for InterfaceID such that an interface
+// identifier string with the zero bits `compressed` will be considered equivalent to the `non-compressed` string.
+//
+// Examples:
+//   - `0:0:0:0:0:1:2:3` is semantically equal to `::1:2:3`
+//   - `::A:B:C:D` is semantically equal to `::a:b:c:d`
+type InterfaceID struct {
+	basetypes.StringValue
+}
+
+// Type returns an InterfaceIDType.
+func (v InterfaceID) Type(_ context.Context) attr.Type {
+	return InterfaceIDType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v InterfaceID) Equal(o attr.Value) bool {
+	other, ok := o.(InterfaceID)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given interface identifier string value is semantically equal to the current
+// interface identifier string value. This comparison utilizes netip.ParseAddr and then compares the resulting netip.Addr
+// representations. This means `compressed` interface identifiers are considered semantically equal to `non-compressed`
+// interface identifiers.
+//
+// Examples:
+//   - `0:0:0:0:0:1:2:3` is semantically equal to `::1:2:3`
+//   - `::A:B:C:D` is semantically equal to `::a:b:c:d`
+func (v InterfaceID) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(InterfaceID)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// Interface identifiers are already validated at this point, ignoring errors
+	newIPAddr, _ := netip.ParseAddr(newValue.ValueString())
+	currentIPAddr, _ := netip.ParseAddr(v.ValueString())
+
+	return currentIPAddr == newIPAddr, diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String value
+// that is a valid IPv6 address with the upper 64 bits set to zero.
+func (v InterfaceID) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseInterfaceID(v.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Interface ID String Value",
+			"A string value was provided that is not valid IPv6 interface identifier string format (RFC 4291).\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is a valid IPv6 address with the upper 64 bits set to zero.
+func (v InterfaceID) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseInterfaceID(v.ValueString())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid Interface ID String Value: "+
+				"A string value was provided that is not valid IPv6 interface identifier string format (RFC 4291).\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueInterfaceID calls netip.ParseAddr with the InterfaceID StringValue. A null or unknown value will produce an
+// error diagnostic.
+func (v InterfaceID) ValueInterfaceID() (netip.Addr, diag.Diagnostics) {
+	return v.valueInterfaceID("ValueInterfaceID")
+}
+
+// ValueIPv6Address combines the InterfaceID StringValue with the network bits of the given IPv6 prefix, which is
+// typically a /64, into a full IPv6Address, such as `2001:db8::1:2:3:4` for `::1:2:3:4` and `2001:db8::/64`. The
+// prefix length must be at most 64 so that the network and interface identifier do not overlap. A null or unknown
+// interface identifier or prefix will produce an error diagnostic.
+func (v InterfaceID) ValueIPv6Address(prefix cidrtypes.IPv6Prefix) (IPv6Address, diag.Diagnostics) {
+	interfaceID, diags := v.valueInterfaceID("ValueIPv6Address")
+	if diags.HasError() {
+		return IPv6Address{}, diags
+	}
+
+	if prefix.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("InterfaceID ValueIPv6Address Error", "IPv6 prefix string value is null"))
+		return IPv6Address{}, diags
+	}
+
+	if prefix.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("InterfaceID ValueIPv6Address Error", "IPv6 prefix string value is unknown"))
+		return IPv6Address{}, diags
+	}
+
+	ipPrefix, err := netip.ParsePrefix(prefix.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("InterfaceID ValueIPv6Address Error", err.Error()))
+		return IPv6Address{}, diags
+	}
+
+	ipAddr, err := combineInterfaceID(ipPrefix, interfaceID)
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("InterfaceID ValueIPv6Address Error", err.Error()))
+		return IPv6Address{}, diags
+	}
+
+	return NewIPv6AddressValue(ipAddr.String()), nil
+}
+
+// valueInterfaceID parses the InterfaceID StringValue, with diagnostics for the given accessor method name.
+func (v InterfaceID) valueInterfaceID(method string) (netip.Addr, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("InterfaceID "+method+" Error", "interface identifier string value is null"))
+		return netip.Addr{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("InterfaceID "+method+" Error", "interface identifier string value is unknown"))
+		return netip.Addr{}, diags
+	}
+
+	ipAddr, err := parseInterfaceID(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("InterfaceID "+method+" Error", err.Error()))
+		return netip.Addr{}, diags
+	}
+
+	return ipAddr, nil
+}
+
+// parseInterfaceID parses an interface identifier, which must be an IPv6 address without a zone that has the upper 64
+// bits set to zero.
+func parseInterfaceID(value string) (netip.Addr, error) {
+	ipAddr, err := netip.ParseAddr(value)
+	if err != nil {
+		return netip.Addr{}, err
+	}
+
+	if !ipAddr.Is6() {
+		return netip.Addr{}, fmt.Errorf("interface identifier %s must be in IPv6 string format", value)
+	}
+
+	if ipAddr.Zone() != "" {
+		return netip.Addr{}, fmt.Errorf("interface identifier %s must not have a zone", value)
+	}
+
+	if netip.PrefixFrom(ipAddr, interfaceIDBits).Masked().Addr() != netip.IPv6Unspecified() {
+		return netip.Addr{}, fmt.Errorf("interface identifier %s must have the upper %d bits set to zero", value, interfaceIDBits)
+	}
+
+	return ipAddr, nil
+}
+
+// combineInterfaceID returns the address formed by the network bits of an IPv6 prefix followed by an interface
+// identifier.
+func combineInterfaceID(prefix netip.Prefix, interfaceID netip.Addr) (netip.Addr, error) {
+	if !prefix.Addr().Is6() {
+		return netip.Addr{}, fmt.Errorf("prefix %s must be an IPv6 prefix", prefix)
+	}
+
+	if prefix.Bits() > interfaceIDBits {
+		return netip.Addr{}, fmt.Errorf("prefix %s must have a prefix length of at most %d to be combined with an interface identifier", prefix, interfaceIDBits)
+	}
+
+	network := prefix.Masked().Addr().As16()
+	suffix := interfaceID.As16()
+
+	for i := 8; i < 16; i++ {
+		network[i] = suffix[i]
+	}
+
+	return netip.AddrFrom16(network), nil
+}
+
+// NewInterfaceIDNull creates an InterfaceID with a null value. Determine whether the value is null via IsNull method.
+func NewInterfaceIDNull() InterfaceID {
+	return InterfaceID{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewInterfaceIDUnknown creates an InterfaceID with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewInterfaceIDUnknown() InterfaceID {
+	return InterfaceID{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewInterfaceIDValue creates an InterfaceID with a known value. Access the value via ValueString method.
+func NewInterfaceIDValue(value string) InterfaceID {
+	return InterfaceID{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewInterfaceIDPointerValue creates an InterfaceID with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewInterfaceIDPointerValue(value *string) InterfaceID {
+	return InterfaceID{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
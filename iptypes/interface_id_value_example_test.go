@@ -0,0 +1,37 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package iptypes_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/cidrtypes"
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/iptypes"
+)
+
+type InterfaceIDResourceModel struct {
+	Prefix      cidrtypes.IPv6Prefix `tfsdk:"prefix"`
+	InterfaceID iptypes.InterfaceID  `tfsdk:"interface_id"`
+	Address     iptypes.IPv6Address  `tfsdk:"address"`
+}
+
+func ExampleInterfaceID_ValueIPv6Address() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := InterfaceIDResourceModel{
+		Prefix:      cidrtypes.NewIPv6PrefixValue("2001:db8:0:5::/64"),
+		InterfaceID: iptypes.NewInterfaceIDValue("::1:2:3:4"),
+	}
+
+	// Populate the computed address from the delegated prefix and the tokenized interface identifier
+	address, diags := data.InterfaceID.ValueIPv6Address(data.Prefix)
+	if diags.HasError() {
+		return
+	}
+
+	data.Address = address
+
+	// Output: 2001:db8:0:5:1:2:3:4
+	fmt.Println(data.Address.ValueString())
+}
@@ -0,0 +1,435 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package iptypes_test
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/cidrtypes"
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/iptypes"
+)
+
+func TestInterfaceIDStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentInterfaceID iptypes.InterfaceID
+		givenInterfaceID   basetypes.StringValuable
+		expectedMatch      bool
+		expectedDiags      diag.Diagnostics
+	}{
+		"not equal - interface identifier mismatch": {
+			currentInterfaceID: iptypes.NewInterfaceIDValue("::1:2:3:4"),
+			givenInterfaceID:   iptypes.NewInterfaceIDValue("::1:2:3:5"),
+			expectedMatch:      false,
+		},
+		"semantically equal - byte-for-byte match": {
+			currentInterfaceID: iptypes.NewInterfaceIDValue("::1:2:3:4"),
+			givenInterfaceID:   iptypes.NewInterfaceIDValue("::1:2:3:4"),
+			expectedMatch:      true,
+		},
+		"semantically equal - case insensitive": {
+			currentInterfaceID: iptypes.NewInterfaceIDValue("::A:B:C:D"),
+			givenInterfaceID:   iptypes.NewInterfaceIDValue("::a:b:c:d"),
+			expectedMatch:      true,
+		},
+		"semantically equal - compressed match": {
+			currentInterfaceID: iptypes.NewInterfaceIDValue("0:0:0:0:0:1:2:3"),
+			givenInterfaceID:   iptypes.NewInterfaceIDValue("::1:2:3"),
+			expectedMatch:      true,
+		},
+		"semantically equal - leading zeros match": {
+			currentInterfaceID: iptypes.NewInterfaceIDValue("::0001:0002:0003:0004"),
+			givenInterfaceID:   iptypes.NewInterfaceIDValue("::1:2:3:4"),
+			expectedMatch:      true,
+		},
+		"error - not given InterfaceID value": {
+			currentInterfaceID: iptypes.NewInterfaceIDValue("::1:2:3:4"),
+			givenInterfaceID:   basetypes.NewStringValue("::1:2:3:4"),
+			expectedMatch:      false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: iptypes.InterfaceID\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentInterfaceID.StringSemanticEquals(context.Background(), testCase.givenInterfaceID)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestInterfaceIDValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		interfaceID   iptypes.InterfaceID
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			interfaceID: iptypes.InterfaceID{},
+		},
+		"null": {
+			interfaceID: iptypes.NewInterfaceIDNull(),
+		},
+		"unknown": {
+			interfaceID: iptypes.NewInterfaceIDUnknown(),
+		},
+		"valid interface identifier": {
+			interfaceID: iptypes.NewInterfaceIDValue("::1:2:3:4"),
+		},
+		"valid interface identifier - all bits set": {
+			interfaceID: iptypes.NewInterfaceIDValue("::ffff:ffff:ffff:ffff"),
+		},
+		"valid interface identifier - zero": {
+			interfaceID: iptypes.NewInterfaceIDValue("::"),
+		},
+		"invalid interface identifier - not an address": {
+			interfaceID: iptypes.NewInterfaceIDValue("not-an-address"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Interface ID String Value",
+					"A string value was provided that is not valid IPv6 interface identifier string format (RFC 4291).\n\n"+
+						"Given Value: not-an-address\n"+
+						"Error: "+"ParseAddr(\"not-an-address\"): unable to parse IP",
+				),
+			},
+		},
+		"invalid interface identifier - upper 64 bits set": {
+			interfaceID: iptypes.NewInterfaceIDValue("2001:db8::1"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Interface ID String Value",
+					"A string value was provided that is not valid IPv6 interface identifier string format (RFC 4291).\n\n"+
+						"Given Value: 2001:db8::1\n"+
+						"Error: "+"interface identifier 2001:db8::1 must have the upper 64 bits set to zero",
+				),
+			},
+		},
+		"invalid interface identifier - lowest upper bit set": {
+			interfaceID: iptypes.NewInterfaceIDValue("0:0:0:1::1"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Interface ID String Value",
+					"A string value was provided that is not valid IPv6 interface identifier string format (RFC 4291).\n\n"+
+						"Given Value: 0:0:0:1::1\n"+
+						"Error: "+"interface identifier 0:0:0:1::1 must have the upper 64 bits set to zero",
+				),
+			},
+		},
+		"invalid interface identifier - zone": {
+			interfaceID: iptypes.NewInterfaceIDValue("::1%eth0"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Interface ID String Value",
+					"A string value was provided that is not valid IPv6 interface identifier string format (RFC 4291).\n\n"+
+						"Given Value: ::1%eth0\n"+
+						"Error: "+"interface identifier ::1%eth0 must not have a zone",
+				),
+			},
+		},
+		"invalid interface identifier - IPv4 address": {
+			interfaceID: iptypes.NewInterfaceIDValue("0.0.0.1"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Interface ID String Value",
+					"A string value was provided that is not valid IPv6 interface identifier string format (RFC 4291).\n\n"+
+						"Given Value: 0.0.0.1\n"+
+						"Error: "+"interface identifier 0.0.0.1 must be in IPv6 string format",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.interfaceID.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{Path: path.Root("test")},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestInterfaceIDValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		interfaceID     iptypes.InterfaceID
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			interfaceID: iptypes.InterfaceID{},
+		},
+		"null": {
+			interfaceID: iptypes.NewInterfaceIDNull(),
+		},
+		"unknown": {
+			interfaceID: iptypes.NewInterfaceIDUnknown(),
+		},
+		"valid interface identifier": {
+			interfaceID: iptypes.NewInterfaceIDValue("::1:2:3:4"),
+		},
+		"invalid interface identifier - not an address": {
+			interfaceID: iptypes.NewInterfaceIDValue("not-an-address"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid Interface ID String Value: "+
+					"A string value was provided that is not valid IPv6 interface identifier string format (RFC 4291).\n\n"+
+					"Given Value: not-an-address\n"+
+					"Error: "+"ParseAddr(\"not-an-address\"): unable to parse IP",
+			),
+		},
+		"invalid interface identifier - upper 64 bits set": {
+			interfaceID: iptypes.NewInterfaceIDValue("2001:db8::1"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid Interface ID String Value: "+
+					"A string value was provided that is not valid IPv6 interface identifier string format (RFC 4291).\n\n"+
+					"Given Value: 2001:db8::1\n"+
+					"Error: "+"interface identifier 2001:db8::1 must have the upper 64 bits set to zero",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.interfaceID.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestInterfaceIDValueInterfaceID(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		interfaceID    iptypes.InterfaceID
+		expectedIPAddr netip.Addr
+		expectedDiags  diag.Diagnostics
+	}{
+		"interface identifier value is null": {
+			interfaceID: iptypes.NewInterfaceIDNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"InterfaceID ValueInterfaceID Error",
+					"interface identifier string value is null",
+				),
+			},
+		},
+		"interface identifier value is unknown": {
+			interfaceID: iptypes.NewInterfaceIDUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"InterfaceID ValueInterfaceID Error",
+					"interface identifier string value is unknown",
+				),
+			},
+		},
+		"valid interface identifier": {
+			interfaceID:    iptypes.NewInterfaceIDValue("::1:2:3:4"),
+			expectedIPAddr: netip.MustParseAddr("::1:2:3:4"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ipAddr, diags := testCase.interfaceID.ValueInterfaceID()
+
+			if ipAddr != testCase.expectedIPAddr {
+				t.Errorf("Unexpected difference in netip.Addr, got: %s, expected: %s", ipAddr, testCase.expectedIPAddr)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestInterfaceIDValueIPv6Address(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		interfaceID   iptypes.InterfaceID
+		prefix        cidrtypes.IPv6Prefix
+		expected      iptypes.IPv6Address
+		expectedDiags diag.Diagnostics
+	}{
+		"interface identifier value is null": {
+			interfaceID: iptypes.NewInterfaceIDNull(),
+			prefix:      cidrtypes.NewIPv6PrefixValue("2001:db8::/64"),
+			expected:    iptypes.IPv6Address{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"InterfaceID ValueIPv6Address Error",
+					"interface identifier string value is null",
+				),
+			},
+		},
+		"interface identifier value is unknown": {
+			interfaceID: iptypes.NewInterfaceIDUnknown(),
+			prefix:      cidrtypes.NewIPv6PrefixValue("2001:db8::/64"),
+			expected:    iptypes.IPv6Address{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"InterfaceID ValueIPv6Address Error",
+					"interface identifier string value is unknown",
+				),
+			},
+		},
+		"prefix value is null": {
+			interfaceID: iptypes.NewInterfaceIDValue("::1:2:3:4"),
+			prefix:      cidrtypes.NewIPv6PrefixNull(),
+			expected:    iptypes.IPv6Address{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"InterfaceID ValueIPv6Address Error",
+					"IPv6 prefix string value is null",
+				),
+			},
+		},
+		"prefix value is unknown": {
+			interfaceID: iptypes.NewInterfaceIDValue("::1:2:3:4"),
+			prefix:      cidrtypes.NewIPv6PrefixUnknown(),
+			expected:    iptypes.IPv6Address{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"InterfaceID ValueIPv6Address Error",
+					"IPv6 prefix string value is unknown",
+				),
+			},
+		},
+		"/64 prefix": {
+			interfaceID: iptypes.NewInterfaceIDValue("::1:2:3:4"),
+			prefix:      cidrtypes.NewIPv6PrefixValue("2001:db8:0:5::/64"),
+			expected:    iptypes.NewIPv6AddressValue("2001:db8:0:5:1:2:3:4"),
+		},
+		"/64 prefix - host bits are replaced": {
+			interfaceID: iptypes.NewInterfaceIDValue("::1"),
+			prefix:      cidrtypes.NewIPv6PrefixValue("2001:db8::ffff/64"),
+			expected:    iptypes.NewIPv6AddressValue("2001:db8::1"),
+		},
+		"/48 prefix - subnet bits are zero": {
+			interfaceID: iptypes.NewInterfaceIDValue("::a:b:c:d"),
+			prefix:      cidrtypes.NewIPv6PrefixValue("2001:db8:1::/48"),
+			expected:    iptypes.NewIPv6AddressValue("2001:db8:1:0:a:b:c:d"),
+		},
+		"invalid interface identifier": {
+			interfaceID: iptypes.NewInterfaceIDValue("2001:db8::1"),
+			prefix:      cidrtypes.NewIPv6PrefixValue("2001:db8::/64"),
+			expected:    iptypes.IPv6Address{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"InterfaceID ValueIPv6Address Error",
+					"interface identifier 2001:db8::1 must have the upper 64 bits set to zero",
+				),
+			},
+		},
+		"invalid prefix": {
+			interfaceID: iptypes.NewInterfaceIDValue("::1:2:3:4"),
+			prefix:      cidrtypes.NewIPv6PrefixValue("2001:db8::"),
+			expected:    iptypes.IPv6Address{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"InterfaceID ValueIPv6Address Error",
+					"netip.ParsePrefix(\"2001:db8::\"): no '/'",
+				),
+			},
+		},
+		"invalid prefix - IPv4": {
+			interfaceID: iptypes.NewInterfaceIDValue("::1:2:3:4"),
+			prefix:      cidrtypes.NewIPv6PrefixValue("192.168.0.0/24"),
+			expected:    iptypes.IPv6Address{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"InterfaceID ValueIPv6Address Error",
+					"prefix 192.168.0.0/24 must be an IPv6 prefix",
+				),
+			},
+		},
+		"invalid prefix - longer than /64": {
+			interfaceID: iptypes.NewInterfaceIDValue("::1:2:3:4"),
+			prefix:      cidrtypes.NewIPv6PrefixValue("2001:db8::/80"),
+			expected:    iptypes.IPv6Address{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"InterfaceID ValueIPv6Address Error",
+					"prefix 2001:db8::/80 must have a prefix length of at most 64 to be combined with an interface identifier",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.interfaceID.ValueIPv6Address(testCase.prefix)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("Unexpected difference in IPv6Address (-got, +expected): %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package iptypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = (*IPv4InterfaceAddressType)(nil)
+
+// IPv4InterfaceAddressType is an attribute type that represents a valid IPv4 interface address string, which is an
+// address followed by the prefix length of its network, such as `10.0.0.5/24`. Unlike a CIDR network, the host bits of
+// the address are meaningful and are preserved. The address must not be the network or broadcast address of a prefix
+// shorter than /31 (RFC 3021). No semantic equality logic is defined for IPv4InterfaceAddressType, so it will follow
+// Terraform's data-consistency rules for strings, which must match byte-for-byte.
+type IPv4InterfaceAddressType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t IPv4InterfaceAddressType) String() string {
+	return "iptypes.IPv4InterfaceAddressType"
+}
+
+// ValueType returns the Value type.
+func (t IPv4InterfaceAddressType) ValueType(ctx context.Context) attr.Value {
+	return IPv4InterfaceAddress{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t IPv4InterfaceAddressType) Equal(o attr.Type) bool {
+	other, ok := o.(IPv4InterfaceAddressType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t IPv4InterfaceAddressType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return IPv4InterfaceAddress{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t IPv4InterfaceAddressType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
@@ -0,0 +1,240 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package iptypes
+
+import (
+	"context"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable       = (*IPv4InterfaceAddress)(nil)
+	_ xattr.ValidateableAttribute    = (*IPv4InterfaceAddress)(nil)
+	_ function.ValidateableParameter = (*IPv4InterfaceAddress)(nil)
+)
+
+// IPv4InterfaceAddress represents a valid IPv4 interface address string, which is an address followed by the prefix
+// length of its network, such as `10.0.0.5/24`. Unlike a CIDR network, the host bits of the address are meaningful and
+// are preserved. The address must not be the network or broadcast address of a prefix shorter than /31 (RFC 3021). No
+// semantic equality logic is defined for IPv4InterfaceAddress, so it will follow Terraform's data-consistency rules for
+// strings, which must match byte-for-byte.
+type IPv4InterfaceAddress struct {
+	basetypes.StringValue
+}
+
+// Type returns an IPv4InterfaceAddressType.
+func (v IPv4InterfaceAddress) Type(_ context.Context) attr.Type {
+	return IPv4InterfaceAddressType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v IPv4InterfaceAddress) Equal(o attr.Value) bool {
+	other, ok := o.(IPv4InterfaceAddress)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String value
+// that is a valid IPv4 address with a prefix length, which is not the network or broadcast address of an IPv4 prefix
+// shorter than /31.
+func (v IPv4InterfaceAddress) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	ipPrefix, err := netip.ParsePrefix(v.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IPv4 Interface Address String Value",
+			"A string value was provided that is not valid IPv4 interface address string format (address/prefix length).\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if ipPrefix.Addr().Is6() {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IPv4 Interface Address String Value",
+			"An IPv6 interface address string format was provided, string value must be IPv4 interface address string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n",
+		)
+
+		return
+	}
+
+	if !ipPrefix.IsValid() || !ipPrefix.Addr().Is4() {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IPv4 Interface Address String Value",
+			"A string value was provided that is not valid IPv4 interface address string format (address/prefix length).\n\n"+
+				"Given Value: "+v.ValueString()+"\n",
+		)
+
+		return
+	}
+
+	err = validateIPv4InterfaceAddress(ipPrefix)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IPv4 Interface Address String Value",
+			"A string value was provided that is not a valid IPv4 interface address, as it cannot be assigned to an interface.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is a valid IPv4 address with a prefix length, which is not the network or
+// broadcast address of an IPv4 prefix shorter than /31.
+func (v IPv4InterfaceAddress) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	ipPrefix, err := netip.ParsePrefix(v.ValueString())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid IPv4 Interface Address String Value: "+
+				"A string value was provided that is not valid IPv4 interface address string format (address/prefix length).\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if ipPrefix.Addr().Is6() {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid IPv4 Interface Address String Value: "+
+				"An IPv6 interface address string format was provided, string value must be IPv4 interface address string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n",
+		)
+
+		return
+	}
+
+	if !ipPrefix.IsValid() || !ipPrefix.Addr().Is4() {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid IPv4 Interface Address String Value: "+
+				"A string value was provided that is not valid IPv4 interface address string format (address/prefix length).\n\n"+
+				"Given Value: "+v.ValueString()+"\n",
+		)
+
+		return
+	}
+
+	err = validateIPv4InterfaceAddress(ipPrefix)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid IPv4 Interface Address String Value: "+
+				"A string value was provided that is not a valid IPv4 interface address, as it cannot be assigned to an interface.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueIPv4InterfaceAddress calls netip.ParsePrefix with the IPv4InterfaceAddress StringValue, keeping the host bits of
+// the address. A null or unknown value will produce an error diagnostic.
+func (v IPv4InterfaceAddress) ValueIPv4InterfaceAddress() (netip.Prefix, diag.Diagnostics) {
+	return v.valueIPv4InterfaceAddress("ValueIPv4InterfaceAddress")
+}
+
+// ValueAddr returns the address of the IPv4InterfaceAddress StringValue, such as `10.0.0.5` for `10.0.0.5/24`. A null
+// or unknown value will produce an error diagnostic.
+func (v IPv4InterfaceAddress) ValueAddr() (netip.Addr, diag.Diagnostics) {
+	ipPrefix, diags := v.valueIPv4InterfaceAddress("ValueAddr")
+	if diags.HasError() {
+		return netip.Addr{}, diags
+	}
+
+	return ipPrefix.Addr(), nil
+}
+
+// ValuePrefix returns the network of the IPv4InterfaceAddress StringValue with the host bits masked, such as
+// `10.0.0.0/24` for `10.0.0.5/24`. A null or unknown value will produce an error diagnostic.
+func (v IPv4InterfaceAddress) ValuePrefix() (netip.Prefix, diag.Diagnostics) {
+	ipPrefix, diags := v.valueIPv4InterfaceAddress("ValuePrefix")
+	if diags.HasError() {
+		return netip.Prefix{}, diags
+	}
+
+	return ipPrefix.Masked(), nil
+}
+
+// valueIPv4InterfaceAddress parses the IPv4InterfaceAddress StringValue, producing error diagnostics attributed to the
+// given accessor method.
+func (v IPv4InterfaceAddress) valueIPv4InterfaceAddress(method string) (netip.Prefix, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("IPv4InterfaceAddress "+method+" Error", "IPv4 interface address string value is null"))
+		return netip.Prefix{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("IPv4InterfaceAddress "+method+" Error", "IPv4 interface address string value is unknown"))
+		return netip.Prefix{}, diags
+	}
+
+	ipPrefix, err := netip.ParsePrefix(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("IPv4InterfaceAddress "+method+" Error", err.Error()))
+		return netip.Prefix{}, diags
+	}
+
+	return ipPrefix, nil
+}
+
+// NewIPv4InterfaceAddressNull creates an IPv4InterfaceAddress with a null value. Determine whether the value is null via IsNull method.
+func NewIPv4InterfaceAddressNull() IPv4InterfaceAddress {
+	return IPv4InterfaceAddress{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewIPv4InterfaceAddressUnknown creates an IPv4InterfaceAddress with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewIPv4InterfaceAddressUnknown() IPv4InterfaceAddress {
+	return IPv4InterfaceAddress{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewIPv4InterfaceAddressValue creates an IPv4InterfaceAddress with a known value. Access the value via ValueString method.
+func NewIPv4InterfaceAddressValue(value string) IPv4InterfaceAddress {
+	return IPv4InterfaceAddress{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewIPv4InterfaceAddressPointerValue creates an IPv4InterfaceAddress with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewIPv4InterfaceAddressPointerValue(value *string) IPv4InterfaceAddress {
+	return IPv4InterfaceAddress{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
@@ -0,0 +1,423 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package iptypes_test
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/iptypes"
+)
+
+func TestIPv4InterfaceAddressValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		addressValue  iptypes.IPv4InterfaceAddress
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			addressValue: iptypes.IPv4InterfaceAddress{},
+		},
+		"null": {
+			addressValue: iptypes.NewIPv4InterfaceAddressNull(),
+		},
+		"unknown": {
+			addressValue: iptypes.NewIPv4InterfaceAddressUnknown(),
+		},
+		"valid IPv4 interface address": {
+			addressValue: iptypes.NewIPv4InterfaceAddressValue("10.0.0.5/24"),
+		},
+		"valid IPv4 interface address - /31 network address": {
+			addressValue: iptypes.NewIPv4InterfaceAddressValue("192.0.2.0/31"),
+		},
+		"valid IPv4 interface address - /31 last address": {
+			addressValue: iptypes.NewIPv4InterfaceAddressValue("192.0.2.1/31"),
+		},
+		"valid IPv4 interface address - /32": {
+			addressValue: iptypes.NewIPv4InterfaceAddressValue("192.0.2.255/32"),
+		},
+		"valid IPv4 interface address - /30 host": {
+			addressValue: iptypes.NewIPv4InterfaceAddressValue("192.0.2.1/30"),
+		},
+		"invalid IPv4 interface address - network address": {
+			addressValue: iptypes.NewIPv4InterfaceAddressValue("10.0.0.0/24"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid IPv4 Interface Address String Value",
+					"A string value was provided that is not a valid IPv4 interface address, as it cannot be assigned to an interface.\n\n"+
+						"Given Value: 10.0.0.0/24\n"+
+						"Error: address 10.0.0.0 is the network address of 10.0.0.0/24",
+				),
+			},
+		},
+		"invalid IPv4 interface address - broadcast address": {
+			addressValue: iptypes.NewIPv4InterfaceAddressValue("10.0.0.255/24"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid IPv4 Interface Address String Value",
+					"A string value was provided that is not a valid IPv4 interface address, as it cannot be assigned to an interface.\n\n"+
+						"Given Value: 10.0.0.255/24\n"+
+						"Error: address 10.0.0.255 is the broadcast address of 10.0.0.0/24",
+				),
+			},
+		},
+		"invalid IPv4 interface address - /30 broadcast address": {
+			addressValue: iptypes.NewIPv4InterfaceAddressValue("192.0.2.3/30"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid IPv4 Interface Address String Value",
+					"A string value was provided that is not a valid IPv4 interface address, as it cannot be assigned to an interface.\n\n"+
+						"Given Value: 192.0.2.3/30\n"+
+						"Error: address 192.0.2.3 is the broadcast address of 192.0.2.0/30",
+				),
+			},
+		},
+		"invalid IPv4 interface address - missing prefix length": {
+			addressValue: iptypes.NewIPv4InterfaceAddressValue("10.0.0.5"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid IPv4 Interface Address String Value",
+					"A string value was provided that is not valid IPv4 interface address string format (address/prefix length).\n\n"+
+						"Given Value: 10.0.0.5\n"+
+						"Error: "+"netip.ParsePrefix(\"10.0.0.5\"): no '/'",
+				),
+			},
+		},
+		"invalid IPv4 interface address - prefix length out of range": {
+			addressValue: iptypes.NewIPv4InterfaceAddressValue("10.0.0.5/33"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid IPv4 Interface Address String Value",
+					"A string value was provided that is not valid IPv4 interface address string format (address/prefix length).\n\n"+
+						"Given Value: 10.0.0.5/33\n"+
+						"Error: "+"netip.ParsePrefix(\"10.0.0.5/33\"): prefix length out of range",
+				),
+			},
+		},
+		"invalid IPv4 interface address - leading zeroes": {
+			addressValue: iptypes.NewIPv4InterfaceAddressValue("10.0.0.5/024"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid IPv4 Interface Address String Value",
+					"A string value was provided that is not valid IPv4 interface address string format (address/prefix length).\n\n"+
+						"Given Value: 10.0.0.5/024\n"+
+						"Error: "+"netip.ParsePrefix(\"10.0.0.5/024\"): bad bits after slash: \"024\"",
+				),
+			},
+		},
+		"invalid IPv4 interface address - IPv6 address": {
+			addressValue: iptypes.NewIPv4InterfaceAddressValue("2001:db8::5/64"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid IPv4 Interface Address String Value",
+					"An IPv6 interface address string format was provided, string value must be IPv4 interface address string format.\n\n"+
+						"Given Value: 2001:db8::5/64\n",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.addressValue.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{Path: path.Root("test")},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestIPv4InterfaceAddressValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		addressValue    iptypes.IPv4InterfaceAddress
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			addressValue: iptypes.IPv4InterfaceAddress{},
+		},
+		"null": {
+			addressValue: iptypes.NewIPv4InterfaceAddressNull(),
+		},
+		"unknown": {
+			addressValue: iptypes.NewIPv4InterfaceAddressUnknown(),
+		},
+		"valid IPv4 interface address": {
+			addressValue: iptypes.NewIPv4InterfaceAddressValue("10.0.0.5/24"),
+		},
+		"valid IPv4 interface address - /31 network address": {
+			addressValue: iptypes.NewIPv4InterfaceAddressValue("192.0.2.0/31"),
+		},
+		"valid IPv4 interface address - /31 last address": {
+			addressValue: iptypes.NewIPv4InterfaceAddressValue("192.0.2.1/31"),
+		},
+		"valid IPv4 interface address - /32": {
+			addressValue: iptypes.NewIPv4InterfaceAddressValue("192.0.2.255/32"),
+		},
+		"valid IPv4 interface address - /30 host": {
+			addressValue: iptypes.NewIPv4InterfaceAddressValue("192.0.2.1/30"),
+		},
+		"invalid IPv4 interface address - network address": {
+			addressValue: iptypes.NewIPv4InterfaceAddressValue("10.0.0.0/24"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid IPv4 Interface Address String Value: "+
+					"A string value was provided that is not a valid IPv4 interface address, as it cannot be assigned to an interface.\n\n"+
+					"Given Value: 10.0.0.0/24\n"+
+					"Error: address 10.0.0.0 is the network address of 10.0.0.0/24",
+			),
+		},
+		"invalid IPv4 interface address - broadcast address": {
+			addressValue: iptypes.NewIPv4InterfaceAddressValue("10.0.0.255/24"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid IPv4 Interface Address String Value: "+
+					"A string value was provided that is not a valid IPv4 interface address, as it cannot be assigned to an interface.\n\n"+
+					"Given Value: 10.0.0.255/24\n"+
+					"Error: address 10.0.0.255 is the broadcast address of 10.0.0.0/24",
+			),
+		},
+		"invalid IPv4 interface address - /30 broadcast address": {
+			addressValue: iptypes.NewIPv4InterfaceAddressValue("192.0.2.3/30"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid IPv4 Interface Address String Value: "+
+					"A string value was provided that is not a valid IPv4 interface address, as it cannot be assigned to an interface.\n\n"+
+					"Given Value: 192.0.2.3/30\n"+
+					"Error: address 192.0.2.3 is the broadcast address of 192.0.2.0/30",
+			),
+		},
+		"invalid IPv4 interface address - missing prefix length": {
+			addressValue: iptypes.NewIPv4InterfaceAddressValue("10.0.0.5"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid IPv4 Interface Address String Value: "+
+					"A string value was provided that is not valid IPv4 interface address string format (address/prefix length).\n\n"+
+					"Given Value: 10.0.0.5\n"+
+					"Error: "+"netip.ParsePrefix(\"10.0.0.5\"): no '/'",
+			),
+		},
+		"invalid IPv4 interface address - prefix length out of range": {
+			addressValue: iptypes.NewIPv4InterfaceAddressValue("10.0.0.5/33"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid IPv4 Interface Address String Value: "+
+					"A string value was provided that is not valid IPv4 interface address string format (address/prefix length).\n\n"+
+					"Given Value: 10.0.0.5/33\n"+
+					"Error: "+"netip.ParsePrefix(\"10.0.0.5/33\"): prefix length out of range",
+			),
+		},
+		"invalid IPv4 interface address - leading zeroes": {
+			addressValue: iptypes.NewIPv4InterfaceAddressValue("10.0.0.5/024"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid IPv4 Interface Address String Value: "+
+					"A string value was provided that is not valid IPv4 interface address string format (address/prefix length).\n\n"+
+					"Given Value: 10.0.0.5/024\n"+
+					"Error: "+"netip.ParsePrefix(\"10.0.0.5/024\"): bad bits after slash: \"024\"",
+			),
+		},
+		"invalid IPv4 interface address - IPv6 address": {
+			addressValue: iptypes.NewIPv4InterfaceAddressValue("2001:db8::5/64"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid IPv4 Interface Address String Value: "+
+					"An IPv6 interface address string format was provided, string value must be IPv4 interface address string format.\n\n"+
+					"Given Value: 2001:db8::5/64\n",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.addressValue.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestIPv4InterfaceAddressValueIPv4InterfaceAddress(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		addressValue     iptypes.IPv4InterfaceAddress
+		expectedIPPrefix netip.Prefix
+		expectedDiags    diag.Diagnostics
+	}{
+		"IPv4 interface address value is null": {
+			addressValue: iptypes.NewIPv4InterfaceAddressNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"IPv4InterfaceAddress ValueIPv4InterfaceAddress Error",
+					"IPv4 interface address string value is null",
+				),
+			},
+		},
+		"IPv4 interface address value is unknown": {
+			addressValue: iptypes.NewIPv4InterfaceAddressUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"IPv4InterfaceAddress ValueIPv4InterfaceAddress Error",
+					"IPv4 interface address string value is unknown",
+				),
+			},
+		},
+		"valid IPv4 interface address": {
+			addressValue:     iptypes.NewIPv4InterfaceAddressValue("10.0.0.5/24"),
+			expectedIPPrefix: netip.MustParsePrefix("10.0.0.5/24"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ipPrefix, diags := testCase.addressValue.ValueIPv4InterfaceAddress()
+
+			if ipPrefix != testCase.expectedIPPrefix {
+				t.Errorf("Unexpected difference in netip.Prefix, got: %s, expected: %s", ipPrefix, testCase.expectedIPPrefix)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestIPv4InterfaceAddressValueAddr(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		addressValue   iptypes.IPv4InterfaceAddress
+		expectedIPAddr netip.Addr
+		expectedDiags  diag.Diagnostics
+	}{
+		"IPv4 interface address value is null": {
+			addressValue: iptypes.NewIPv4InterfaceAddressNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"IPv4InterfaceAddress ValueAddr Error",
+					"IPv4 interface address string value is null",
+				),
+			},
+		},
+		"IPv4 interface address value is unknown": {
+			addressValue: iptypes.NewIPv4InterfaceAddressUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"IPv4InterfaceAddress ValueAddr Error",
+					"IPv4 interface address string value is unknown",
+				),
+			},
+		},
+		"valid IPv4 interface address": {
+			addressValue:   iptypes.NewIPv4InterfaceAddressValue("10.0.0.5/24"),
+			expectedIPAddr: netip.MustParseAddr("10.0.0.5"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ipAddr, diags := testCase.addressValue.ValueAddr()
+
+			if ipAddr != testCase.expectedIPAddr {
+				t.Errorf("Unexpected difference in netip.Addr, got: %s, expected: %s", ipAddr, testCase.expectedIPAddr)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestIPv4InterfaceAddressValuePrefix(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		addressValue     iptypes.IPv4InterfaceAddress
+		expectedIPPrefix netip.Prefix
+		expectedDiags    diag.Diagnostics
+	}{
+		"IPv4 interface address value is null": {
+			addressValue: iptypes.NewIPv4InterfaceAddressNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"IPv4InterfaceAddress ValuePrefix Error",
+					"IPv4 interface address string value is null",
+				),
+			},
+		},
+		"IPv4 interface address value is unknown": {
+			addressValue: iptypes.NewIPv4InterfaceAddressUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"IPv4InterfaceAddress ValuePrefix Error",
+					"IPv4 interface address string value is unknown",
+				),
+			},
+		},
+		"valid IPv4 interface address": {
+			addressValue:     iptypes.NewIPv4InterfaceAddressValue("10.0.0.5/24"),
+			expectedIPPrefix: netip.MustParsePrefix("10.0.0.0/24"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ipPrefix, diags := testCase.addressValue.ValuePrefix()
+
+			if ipPrefix != testCase.expectedIPPrefix {
+				t.Errorf("Unexpected difference in netip.Prefix, got: %s, expected: %s", ipPrefix, testCase.expectedIPPrefix)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package iptypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable = (*IPv4MulticastAddressType)(nil)
+)
+
+// IPv4MulticastAddressType is an attribute type that represents a valid IPv4 multicast address string in 224.0.0.0/4
+// (RFC 5771), such as `239.1.1.1`. The IPv4MulticastAddressType fields optionally restrict valid values by any-source
+// or source-specific multicast range. No semantic equality logic is defined for IPv4MulticastAddressType, so it will
+// follow Terraform's data-consistency rules for strings, which must match byte-for-byte.
+type IPv4MulticastAddressType struct {
+	basetypes.StringType
+
+	// Mode restricts multicast addresses to any-source multicast (MulticastModeASM) or source-specific multicast
+	// (MulticastModeSSM). Both are permitted if zero.
+	Mode MulticastMode
+}
+
+// String returns a human readable string of the type name.
+func (t IPv4MulticastAddressType) String() string {
+	return "iptypes.IPv4MulticastAddressType"
+}
+
+// ValueType returns the Value type.
+func (t IPv4MulticastAddressType) ValueType(ctx context.Context) attr.Value {
+	return IPv4MulticastAddress{
+		restrictions: multicastRestrictions{mode: t.Mode},
+	}
+}
+
+// Equal returns true if the given type is equivalent. The restrictions of the types are not compared, as they only
+// apply to validation, so that values created by provider logic are compatible with any restricted type.
+func (t IPv4MulticastAddressType) Equal(o attr.Type) bool {
+	other, ok := o.(IPv4MulticastAddressType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t IPv4MulticastAddressType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return IPv4MulticastAddress{
+		StringValue:  in,
+		restrictions: multicastRestrictions{mode: t.Mode},
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t IPv4MulticastAddressType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
@@ -0,0 +1,224 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package iptypes
+
+import (
+	"context"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/hwtypes"
+)
+
+var (
+	_ basetypes.StringValuable       = (*IPv4MulticastAddress)(nil)
+	_ xattr.ValidateableAttribute    = (*IPv4MulticastAddress)(nil)
+	_ function.ValidateableParameter = (*IPv4MulticastAddress)(nil)
+)
+
+// IPv4MulticastAddress represents a valid IPv4 multicast address string in 224.0.0.0/4 (RFC 5771), such as `239.1.1.1`.
+// The IPv4MulticastAddressType fields optionally restrict valid values by any-source or source-specific multicast
+// range. No semantic equality logic is defined for IPv4MulticastAddress, so it will follow Terraform's data-consistency
+// rules for strings, which must match byte-for-byte.
+type IPv4MulticastAddress struct {
+	basetypes.StringValue
+
+	// restrictions are copied from the IPv4MulticastAddressType the value was created by.
+	restrictions multicastRestrictions
+}
+
+// Type returns an IPv4MulticastAddressType.
+func (v IPv4MulticastAddress) Type(_ context.Context) attr.Type {
+	return IPv4MulticastAddressType{
+		Mode: v.restrictions.mode,
+	}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v IPv4MulticastAddress) Equal(o attr.Value) bool {
+	other, ok := o.(IPv4MulticastAddress)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String value
+// that is a valid IPv4 multicast address that satisfies the restrictions of its type.
+func (v IPv4MulticastAddress) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	ipAddr, err := netip.ParseAddr(v.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IPv4 Multicast Address String Value",
+			"A string value was provided that is not valid IPv4 multicast address string format (RFC 5771).\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if ipAddr.Is6() {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IPv4 Multicast Address String Value",
+			"An IPv6 string format was provided, string value must be IPv4 multicast address string format (RFC 5771).\n\n"+
+				"Given Value: "+v.ValueString()+"\n",
+		)
+
+		return
+	}
+
+	err = v.restrictions.validate(ipAddr)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IPv4 Multicast Address String Value",
+			"A string value was provided that is not a valid IPv4 multicast address (RFC 5771).\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is a valid IPv4 multicast address that satisfies the restrictions of its type.
+func (v IPv4MulticastAddress) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	ipAddr, err := netip.ParseAddr(v.ValueString())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid IPv4 Multicast Address String Value: "+
+				"A string value was provided that is not valid IPv4 multicast address string format (RFC 5771).\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if ipAddr.Is6() {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid IPv4 Multicast Address String Value: "+
+				"An IPv6 string format was provided, string value must be IPv4 multicast address string format (RFC 5771).\n\n"+
+				"Given Value: "+v.ValueString()+"\n",
+		)
+
+		return
+	}
+
+	err = v.restrictions.validate(ipAddr)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid IPv4 Multicast Address String Value: "+
+				"A string value was provided that is not a valid IPv4 multicast address (RFC 5771).\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueIPv4MulticastAddress calls netip.ParseAddr with the IPv4MulticastAddress StringValue. A null or unknown value
+// will produce an error diagnostic.
+func (v IPv4MulticastAddress) ValueIPv4MulticastAddress() (netip.Addr, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("IPv4MulticastAddress ValueIPv4MulticastAddress Error", "IPv4 multicast address string value is null"))
+		return netip.Addr{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("IPv4MulticastAddress ValueIPv4MulticastAddress Error", "IPv4 multicast address string value is unknown"))
+		return netip.Addr{}, diags
+	}
+
+	ipAddr, err := netip.ParseAddr(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("IPv4MulticastAddress ValueIPv4MulticastAddress Error", err.Error()))
+		return netip.Addr{}, diags
+	}
+
+	return ipAddr, nil
+}
+
+// ValueMACAddress returns the Ethernet multicast MAC address that the IPv4MulticastAddress StringValue maps to as an
+// hwtypes.MACAddress, which is 01:00:5e followed by the low 23 bits of the address (RFC 1112), such as
+// `01:00:5e:01:01:01` for `239.1.1.1`. As 5 bits are not mapped, 32 IPv4 multicast addresses share each MAC address. A
+// null or unknown value will produce a null or unknown MACAddress respectively.
+func (v IPv4MulticastAddress) ValueMACAddress() (hwtypes.MACAddress, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		return hwtypes.NewMACAddressNull(), nil
+	}
+
+	if v.IsUnknown() {
+		return hwtypes.NewMACAddressUnknown(), nil
+	}
+
+	ipAddr, err := netip.ParseAddr(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("IPv4MulticastAddress ValueMACAddress Error", err.Error()))
+		return hwtypes.NewMACAddressUnknown(), diags
+	}
+
+	err = v.restrictions.validate(ipAddr)
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("IPv4MulticastAddress ValueMACAddress Error", err.Error()))
+		return hwtypes.NewMACAddressUnknown(), diags
+	}
+
+	return hwtypes.NewMACAddressValue(multicastMACAddress(ipAddr).String()), nil
+}
+
+// NewIPv4MulticastAddressNull creates an IPv4MulticastAddress with a null value. Determine whether the value is null via IsNull method.
+func NewIPv4MulticastAddressNull() IPv4MulticastAddress {
+	return IPv4MulticastAddress{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewIPv4MulticastAddressUnknown creates an IPv4MulticastAddress with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewIPv4MulticastAddressUnknown() IPv4MulticastAddress {
+	return IPv4MulticastAddress{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewIPv4MulticastAddressValue creates an IPv4MulticastAddress with a known value. Access the value via ValueString method.
+func NewIPv4MulticastAddressValue(value string) IPv4MulticastAddress {
+	return IPv4MulticastAddress{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewIPv4MulticastAddressPointerValue creates an IPv4MulticastAddress with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewIPv4MulticastAddressPointerValue(value *string) IPv4MulticastAddress {
+	return IPv4MulticastAddress{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
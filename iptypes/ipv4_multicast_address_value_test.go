@@ -0,0 +1,321 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package iptypes_test
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/hwtypes"
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/iptypes"
+)
+
+// newRestrictedIPv4MulticastAddressValue creates a IPv4MulticastAddress with the restrictions of the given type, as Plugin
+// Framework would when populating a data model.
+func newRestrictedIPv4MulticastAddressValue(addressType iptypes.IPv4MulticastAddressType, value string) iptypes.IPv4MulticastAddress {
+	v, _ := addressType.ValueFromString(context.Background(), basetypes.NewStringValue(value))
+
+	return v.(iptypes.IPv4MulticastAddress)
+}
+
+func TestIPv4MulticastAddressValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		addressValue  iptypes.IPv4MulticastAddress
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			addressValue: iptypes.IPv4MulticastAddress{},
+		},
+		"null": {
+			addressValue: iptypes.NewIPv4MulticastAddressNull(),
+		},
+		"unknown": {
+			addressValue: iptypes.NewIPv4MulticastAddressUnknown(),
+		},
+		"valid IPv4 multicast address": {
+			addressValue: iptypes.NewIPv4MulticastAddressValue("239.1.1.1"),
+		},
+		"valid IPv4 multicast address - lowest": {
+			addressValue: iptypes.NewIPv4MulticastAddressValue("224.0.0.0"),
+		},
+		"valid IPv4 multicast address - highest": {
+			addressValue: iptypes.NewIPv4MulticastAddressValue("239.255.255.255"),
+		},
+		"valid IPv4 multicast address - ASM restriction": {
+			addressValue: newRestrictedIPv4MulticastAddressValue(
+				iptypes.IPv4MulticastAddressType{Mode: iptypes.MulticastModeASM},
+				"239.1.1.1",
+			),
+		},
+		"valid IPv4 multicast address - SSM restriction": {
+			addressValue: newRestrictedIPv4MulticastAddressValue(
+				iptypes.IPv4MulticastAddressType{Mode: iptypes.MulticastModeSSM},
+				"232.1.1.1",
+			),
+		},
+		"invalid - not an address": {
+			addressValue: iptypes.NewIPv4MulticastAddressValue("not-an-address"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid IPv4 Multicast Address String Value",
+					"A string value was provided that is not valid IPv4 multicast address string format (RFC 5771).\n\n"+
+						"Given Value: not-an-address\n"+
+						"Error: "+"ParseAddr(\"not-an-address\"): unable to parse IP",
+				),
+			},
+		},
+		"invalid - IPv4 unicast address": {
+			addressValue: iptypes.NewIPv4MulticastAddressValue("192.168.0.1"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid IPv4 Multicast Address String Value",
+					"A string value was provided that is not a valid IPv4 multicast address (RFC 5771).\n\n"+
+						"Given Value: 192.168.0.1\n"+
+						"Error: "+"address 192.168.0.1 is not in the IPv4 multicast range 224.0.0.0/4",
+				),
+			},
+		},
+		"invalid - IPv6 multicast address": {
+			addressValue: iptypes.NewIPv4MulticastAddressValue("ff15::1"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid IPv4 Multicast Address String Value",
+					"An IPv6 string format was provided, string value must be IPv4 multicast address string format (RFC 5771).\n\n"+
+						"Given Value: ff15::1\n",
+				),
+			},
+		},
+		"invalid - IPv4 reserved address": {
+			addressValue: iptypes.NewIPv4MulticastAddressValue("240.0.0.1"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid IPv4 Multicast Address String Value",
+					"A string value was provided that is not a valid IPv4 multicast address (RFC 5771).\n\n"+
+						"Given Value: 240.0.0.1\n"+
+						"Error: "+"address 240.0.0.1 is not in the IPv4 multicast range 224.0.0.0/4",
+				),
+			},
+		},
+		"invalid - ASM restriction": {
+			addressValue: newRestrictedIPv4MulticastAddressValue(
+				iptypes.IPv4MulticastAddressType{Mode: iptypes.MulticastModeASM},
+				"232.1.1.1",
+			),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid IPv4 Multicast Address String Value",
+					"A string value was provided that is not a valid IPv4 multicast address (RFC 5771).\n\n"+
+						"Given Value: 232.1.1.1\n"+
+						"Error: "+"address 232.1.1.1 is a source-specific multicast address, expected an any-source multicast address",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.addressValue.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{Path: path.Root("test")},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestIPv4MulticastAddressValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		addressValue    iptypes.IPv4MulticastAddress
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			addressValue: iptypes.IPv4MulticastAddress{},
+		},
+		"null": {
+			addressValue: iptypes.NewIPv4MulticastAddressNull(),
+		},
+		"unknown": {
+			addressValue: iptypes.NewIPv4MulticastAddressUnknown(),
+		},
+		"valid IPv4 multicast address": {
+			addressValue: iptypes.NewIPv4MulticastAddressValue("239.1.1.1"),
+		},
+		"invalid - not an address": {
+			addressValue: iptypes.NewIPv4MulticastAddressValue("not-an-address"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid IPv4 Multicast Address String Value: "+
+					"A string value was provided that is not valid IPv4 multicast address string format (RFC 5771).\n\n"+
+					"Given Value: not-an-address\n"+
+					"Error: "+"ParseAddr(\"not-an-address\"): unable to parse IP",
+			),
+		},
+		"invalid - IPv6 multicast address": {
+			addressValue: iptypes.NewIPv4MulticastAddressValue("ff15::1"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid IPv4 Multicast Address String Value: "+
+					"An IPv6 string format was provided, string value must be IPv4 multicast address string format (RFC 5771).\n\n"+
+					"Given Value: ff15::1\n",
+			),
+		},
+		"invalid - IPv4 unicast address": {
+			addressValue: iptypes.NewIPv4MulticastAddressValue("192.168.0.1"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid IPv4 Multicast Address String Value: "+
+					"A string value was provided that is not a valid IPv4 multicast address (RFC 5771).\n\n"+
+					"Given Value: 192.168.0.1\n"+
+					"Error: "+"address 192.168.0.1 is not in the IPv4 multicast range 224.0.0.0/4",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.addressValue.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestIPv4MulticastAddressValueIPv4MulticastAddress(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		addressValue   iptypes.IPv4MulticastAddress
+		expectedIPAddr netip.Addr
+		expectedDiags  diag.Diagnostics
+	}{
+		"IPv4 multicast address value is null": {
+			addressValue: iptypes.NewIPv4MulticastAddressNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"IPv4MulticastAddress ValueIPv4MulticastAddress Error",
+					"IPv4 multicast address string value is null",
+				),
+			},
+		},
+		"IPv4 multicast address value is unknown": {
+			addressValue: iptypes.NewIPv4MulticastAddressUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"IPv4MulticastAddress ValueIPv4MulticastAddress Error",
+					"IPv4 multicast address string value is unknown",
+				),
+			},
+		},
+		"valid IPv4 multicast address": {
+			addressValue:   iptypes.NewIPv4MulticastAddressValue("239.1.1.1"),
+			expectedIPAddr: netip.MustParseAddr("239.1.1.1"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ipAddr, diags := testCase.addressValue.ValueIPv4MulticastAddress()
+
+			if ipAddr != testCase.expectedIPAddr {
+				t.Errorf("Unexpected difference in netip.Addr, got: %s, expected: %s", ipAddr, testCase.expectedIPAddr)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestIPv4MulticastAddressValueMACAddress(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		addressValue       iptypes.IPv4MulticastAddress
+		expectedMACAddress hwtypes.MACAddress
+		expectedDiags      diag.Diagnostics
+	}{
+		"IPv4 multicast address value is null": {
+			addressValue:       iptypes.NewIPv4MulticastAddressNull(),
+			expectedMACAddress: hwtypes.NewMACAddressNull(),
+		},
+		"IPv4 multicast address value is unknown": {
+			addressValue:       iptypes.NewIPv4MulticastAddressUnknown(),
+			expectedMACAddress: hwtypes.NewMACAddressUnknown(),
+		},
+		"IPv4 all hosts": {
+			addressValue:       iptypes.NewIPv4MulticastAddressValue("224.0.0.1"),
+			expectedMACAddress: hwtypes.NewMACAddressValue("01:00:5e:00:00:01"),
+		},
+		"IPv4 high bit of second octet is not mapped": {
+			addressValue:       iptypes.NewIPv4MulticastAddressValue("239.129.1.1"),
+			expectedMACAddress: hwtypes.NewMACAddressValue("01:00:5e:01:01:01"),
+		},
+		"invalid - unicast address": {
+			addressValue:       iptypes.NewIPv4MulticastAddressValue("192.168.0.1"),
+			expectedMACAddress: hwtypes.NewMACAddressUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"IPv4MulticastAddress ValueMACAddress Error",
+					"address 192.168.0.1 is not in the IPv4 multicast range 224.0.0.0/4",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			macAddress, diags := testCase.addressValue.ValueMACAddress()
+
+			if !macAddress.Equal(testCase.expectedMACAddress) {
+				t.Errorf("Unexpected difference in MACAddress, got: %s, expected: %s", macAddress, testCase.expectedMACAddress)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
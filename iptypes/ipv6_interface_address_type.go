@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package iptypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = (*IPv6InterfaceAddressType)(nil)
+
+// IPv6InterfaceAddressType is an attribute type that represents a valid IPv6 interface address string (RFC 4291), which
+// is an address followed by the prefix length of its network, such as `2001:db8::5/64`. Unlike a CIDR network, the host
+// bits of the address are meaningful and are preserved. Semantic equality logic is defined for IPv6InterfaceAddressType
+// such that an address string with the zero bits `compressed` will be considered equivalent to the `non-compressed`
+// string.
+//
+// Examples:
+//   - `2001:DB8:0:0:0:0:0:5/64` is semantically equal to `2001:db8::5/64`
+//   - `0:0:0:0:0:0:0:1/128` is semantically equal to `::1/128`
+type IPv6InterfaceAddressType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t IPv6InterfaceAddressType) String() string {
+	return "iptypes.IPv6InterfaceAddressType"
+}
+
+// ValueType returns the Value type.
+func (t IPv6InterfaceAddressType) ValueType(ctx context.Context) attr.Value {
+	return IPv6InterfaceAddress{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t IPv6InterfaceAddressType) Equal(o attr.Type) bool {
+	other, ok := o.(IPv6InterfaceAddressType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t IPv6InterfaceAddressType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return IPv6InterfaceAddress{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t IPv6InterfaceAddressType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
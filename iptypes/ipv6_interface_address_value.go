@@ -0,0 +1,248 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package iptypes
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*IPv6InterfaceAddress)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*IPv6InterfaceAddress)(nil)
+	_ xattr.ValidateableAttribute                = (*IPv6InterfaceAddress)(nil)
+	_ function.ValidateableParameter             = (*IPv6InterfaceAddress)(nil)
+)
+
+// IPv6InterfaceAddress represents a valid IPv6 interface address string (RFC 4291), which is an address followed by the
+// prefix length of its network, such as `2001:db8::5/64`. Unlike a CIDR network, the host bits of the address are
+// meaningful and are preserved. Semantic equality logic is defined for IPv6InterfaceAddress such that an address string
+// with the zero bits `compressed` will be considered equivalent to the `non-compressed` string.
+//
+// Examples:
+//   - `2001:DB8:0:0:0:0:0:5/64` is semantically equal to `2001:db8::5/64`
+//   - `0:0:0:0:0:0:0:1/128` is semantically equal to `::1/128`
+type IPv6InterfaceAddress struct {
+	basetypes.StringValue
+}
+
+// Type returns an IPv6InterfaceAddressType.
+func (v IPv6InterfaceAddress) Type(_ context.Context) attr.Type {
+	return IPv6InterfaceAddressType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v IPv6InterfaceAddress) Equal(o attr.Value) bool {
+	other, ok := o.(IPv6InterfaceAddress)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given IPv6 interface address string value is semantically equal to the current IPv6 interface address
+// string value. This comparison utilizes netip.ParsePrefix and then compares the resulting addresses and prefix lengths.
+// This means `compressed` IPv6 addresses are considered semantically equal to `non-compressed` IPv6 addresses, while
+// host bits are significant.
+//
+// Examples:
+//   - `2001:DB8:0:0:0:0:0:5/64` is semantically equal to `2001:db8::5/64`
+//   - `0:0:0:0:0:0:0:1/128` is semantically equal to `::1/128`
+func (v IPv6InterfaceAddress) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(IPv6InterfaceAddress)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// IPv6 interface addresses are already validated at this point, ignoring errors
+	newIPPrefix, _ := netip.ParsePrefix(newValue.ValueString())
+	currentIPPrefix, _ := netip.ParsePrefix(v.ValueString())
+
+	return currentIPPrefix.Addr() == newIPPrefix.Addr() && currentIPPrefix.Bits() == newIPPrefix.Bits(), diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String value
+// that is a valid IPv6 address with a prefix length.
+func (v IPv6InterfaceAddress) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	ipPrefix, err := netip.ParsePrefix(v.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IPv6 Interface Address String Value",
+			"A string value was provided that is not valid IPv6 interface address string format (address/prefix length).\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if ipPrefix.Addr().Is4() {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IPv6 Interface Address String Value",
+			"An IPv4 interface address string format was provided, string value must be IPv6 interface address string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n",
+		)
+
+		return
+	}
+
+	if !ipPrefix.IsValid() || !ipPrefix.Addr().Is6() {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IPv6 Interface Address String Value",
+			"A string value was provided that is not valid IPv6 interface address string format (address/prefix length).\n\n"+
+				"Given Value: "+v.ValueString()+"\n",
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is a valid IPv6 address with a prefix length.
+func (v IPv6InterfaceAddress) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	ipPrefix, err := netip.ParsePrefix(v.ValueString())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid IPv6 Interface Address String Value: "+
+				"A string value was provided that is not valid IPv6 interface address string format (address/prefix length).\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if ipPrefix.Addr().Is4() {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid IPv6 Interface Address String Value: "+
+				"An IPv4 interface address string format was provided, string value must be IPv6 interface address string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n",
+		)
+
+		return
+	}
+
+	if !ipPrefix.IsValid() || !ipPrefix.Addr().Is6() {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid IPv6 Interface Address String Value: "+
+				"A string value was provided that is not valid IPv6 interface address string format (address/prefix length).\n\n"+
+				"Given Value: "+v.ValueString()+"\n",
+		)
+
+		return
+	}
+}
+
+// ValueIPv6InterfaceAddress calls netip.ParsePrefix with the IPv6InterfaceAddress StringValue, keeping the host bits of
+// the address. A null or unknown value will produce an error diagnostic.
+func (v IPv6InterfaceAddress) ValueIPv6InterfaceAddress() (netip.Prefix, diag.Diagnostics) {
+	return v.valueIPv6InterfaceAddress("ValueIPv6InterfaceAddress")
+}
+
+// ValueAddr returns the address of the IPv6InterfaceAddress StringValue, such as `2001:db8::5` for `2001:db8::5/64`. A
+// null or unknown value will produce an error diagnostic.
+func (v IPv6InterfaceAddress) ValueAddr() (netip.Addr, diag.Diagnostics) {
+	ipPrefix, diags := v.valueIPv6InterfaceAddress("ValueAddr")
+	if diags.HasError() {
+		return netip.Addr{}, diags
+	}
+
+	return ipPrefix.Addr(), nil
+}
+
+// ValuePrefix returns the network of the IPv6InterfaceAddress StringValue with the host bits masked, such as
+// `2001:db8::/64` for `2001:db8::5/64`. A null or unknown value will produce an error diagnostic.
+func (v IPv6InterfaceAddress) ValuePrefix() (netip.Prefix, diag.Diagnostics) {
+	ipPrefix, diags := v.valueIPv6InterfaceAddress("ValuePrefix")
+	if diags.HasError() {
+		return netip.Prefix{}, diags
+	}
+
+	return ipPrefix.Masked(), nil
+}
+
+// valueIPv6InterfaceAddress parses the IPv6InterfaceAddress StringValue, producing error diagnostics attributed to the
+// given accessor method.
+func (v IPv6InterfaceAddress) valueIPv6InterfaceAddress(method string) (netip.Prefix, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("IPv6InterfaceAddress "+method+" Error", "IPv6 interface address string value is null"))
+		return netip.Prefix{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("IPv6InterfaceAddress "+method+" Error", "IPv6 interface address string value is unknown"))
+		return netip.Prefix{}, diags
+	}
+
+	ipPrefix, err := netip.ParsePrefix(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("IPv6InterfaceAddress "+method+" Error", err.Error()))
+		return netip.Prefix{}, diags
+	}
+
+	return ipPrefix, nil
+}
+
+// NewIPv6InterfaceAddressNull creates an IPv6InterfaceAddress with a null value. Determine whether the value is null via IsNull method.
+func NewIPv6InterfaceAddressNull() IPv6InterfaceAddress {
+	return IPv6InterfaceAddress{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewIPv6InterfaceAddressUnknown creates an IPv6InterfaceAddress with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewIPv6InterfaceAddressUnknown() IPv6InterfaceAddress {
+	return IPv6InterfaceAddress{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewIPv6InterfaceAddressValue creates an IPv6InterfaceAddress with a known value. Access the value via ValueString method.
+func NewIPv6InterfaceAddressValue(value string) IPv6InterfaceAddress {
+	return IPv6InterfaceAddress{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewIPv6InterfaceAddressPointerValue creates an IPv6InterfaceAddress with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewIPv6InterfaceAddressPointerValue(value *string) IPv6InterfaceAddress {
+	return IPv6InterfaceAddress{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
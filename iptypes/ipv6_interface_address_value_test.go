@@ -0,0 +1,407 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package iptypes_test
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/iptypes"
+)
+
+func TestIPv6InterfaceAddressStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentAddress iptypes.IPv6InterfaceAddress
+		givenAddress   basetypes.StringValuable
+		expectedMatch  bool
+		expectedDiags  diag.Diagnostics
+	}{
+		"not equal - address mismatch": {
+			currentAddress: iptypes.NewIPv6InterfaceAddressValue("2001:db8::5/64"),
+			givenAddress:   iptypes.NewIPv6InterfaceAddressValue("2001:db8::6/64"),
+			expectedMatch:  false,
+		},
+		"not equal - prefix length mismatch": {
+			currentAddress: iptypes.NewIPv6InterfaceAddressValue("2001:db8::5/64"),
+			givenAddress:   iptypes.NewIPv6InterfaceAddressValue("2001:db8::5/48"),
+			expectedMatch:  false,
+		},
+		"not equal - host bits are significant": {
+			currentAddress: iptypes.NewIPv6InterfaceAddressValue("2001:db8::5/64"),
+			givenAddress:   iptypes.NewIPv6InterfaceAddressValue("2001:db8::/64"),
+			expectedMatch:  false,
+		},
+		"semantically equal - byte-for-byte match": {
+			currentAddress: iptypes.NewIPv6InterfaceAddressValue("2001:db8::5/64"),
+			givenAddress:   iptypes.NewIPv6InterfaceAddressValue("2001:db8::5/64"),
+			expectedMatch:  true,
+		},
+		"semantically equal - case insensitive": {
+			currentAddress: iptypes.NewIPv6InterfaceAddressValue("2001:DB8::5/64"),
+			givenAddress:   iptypes.NewIPv6InterfaceAddressValue("2001:db8::5/64"),
+			expectedMatch:  true,
+		},
+		"semantically equal - compressed match": {
+			currentAddress: iptypes.NewIPv6InterfaceAddressValue("2001:DB8:0:0:0:0:0:5/64"),
+			givenAddress:   iptypes.NewIPv6InterfaceAddressValue("2001:db8::5/64"),
+			expectedMatch:  true,
+		},
+		"semantically equal - compressed loopback match": {
+			currentAddress: iptypes.NewIPv6InterfaceAddressValue("0:0:0:0:0:0:0:1/128"),
+			givenAddress:   iptypes.NewIPv6InterfaceAddressValue("::1/128"),
+			expectedMatch:  true,
+		},
+		"error - not given IPv6InterfaceAddress value": {
+			currentAddress: iptypes.NewIPv6InterfaceAddressValue("2001:db8::5/64"),
+			givenAddress:   basetypes.NewStringValue("2001:db8::5/64"),
+			expectedMatch:  false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: iptypes.IPv6InterfaceAddress\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentAddress.StringSemanticEquals(context.Background(), testCase.givenAddress)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestIPv6InterfaceAddressValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		addressValue  iptypes.IPv6InterfaceAddress
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			addressValue: iptypes.IPv6InterfaceAddress{},
+		},
+		"null": {
+			addressValue: iptypes.NewIPv6InterfaceAddressNull(),
+		},
+		"unknown": {
+			addressValue: iptypes.NewIPv6InterfaceAddressUnknown(),
+		},
+		"valid IPv6 interface address": {
+			addressValue: iptypes.NewIPv6InterfaceAddressValue("2001:db8::5/64"),
+		},
+		"valid IPv6 interface address - subnet-router anycast": {
+			addressValue: iptypes.NewIPv6InterfaceAddressValue("2001:db8::/64"),
+		},
+		"valid IPv6 interface address - uppercase": {
+			addressValue: iptypes.NewIPv6InterfaceAddressValue("2001:DB8::5/64"),
+		},
+		"valid IPv6 interface address - /128": {
+			addressValue: iptypes.NewIPv6InterfaceAddressValue("::1/128"),
+		},
+		"invalid IPv6 interface address - missing prefix length": {
+			addressValue: iptypes.NewIPv6InterfaceAddressValue("2001:db8::5"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid IPv6 Interface Address String Value",
+					"A string value was provided that is not valid IPv6 interface address string format (address/prefix length).\n\n"+
+						"Given Value: 2001:db8::5\n"+
+						"Error: "+"netip.ParsePrefix(\"2001:db8::5\"): no '/'",
+				),
+			},
+		},
+		"invalid IPv6 interface address - zone": {
+			addressValue: iptypes.NewIPv6InterfaceAddressValue("fe80::1%eth0/64"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid IPv6 Interface Address String Value",
+					"A string value was provided that is not valid IPv6 interface address string format (address/prefix length).\n\n"+
+						"Given Value: fe80::1%eth0/64\n"+
+						"Error: "+"netip.ParsePrefix(\"fe80::1%eth0/64\"): IPv6 zones cannot be present in a prefix",
+				),
+			},
+		},
+		"invalid IPv6 interface address - IPv4 address": {
+			addressValue: iptypes.NewIPv6InterfaceAddressValue("10.0.0.5/24"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid IPv6 Interface Address String Value",
+					"An IPv4 interface address string format was provided, string value must be IPv6 interface address string format.\n\n"+
+						"Given Value: 10.0.0.5/24\n",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.addressValue.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{Path: path.Root("test")},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestIPv6InterfaceAddressValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		addressValue    iptypes.IPv6InterfaceAddress
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			addressValue: iptypes.IPv6InterfaceAddress{},
+		},
+		"null": {
+			addressValue: iptypes.NewIPv6InterfaceAddressNull(),
+		},
+		"unknown": {
+			addressValue: iptypes.NewIPv6InterfaceAddressUnknown(),
+		},
+		"valid IPv6 interface address": {
+			addressValue: iptypes.NewIPv6InterfaceAddressValue("2001:db8::5/64"),
+		},
+		"valid IPv6 interface address - subnet-router anycast": {
+			addressValue: iptypes.NewIPv6InterfaceAddressValue("2001:db8::/64"),
+		},
+		"valid IPv6 interface address - uppercase": {
+			addressValue: iptypes.NewIPv6InterfaceAddressValue("2001:DB8::5/64"),
+		},
+		"valid IPv6 interface address - /128": {
+			addressValue: iptypes.NewIPv6InterfaceAddressValue("::1/128"),
+		},
+		"invalid IPv6 interface address - missing prefix length": {
+			addressValue: iptypes.NewIPv6InterfaceAddressValue("2001:db8::5"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid IPv6 Interface Address String Value: "+
+					"A string value was provided that is not valid IPv6 interface address string format (address/prefix length).\n\n"+
+					"Given Value: 2001:db8::5\n"+
+					"Error: "+"netip.ParsePrefix(\"2001:db8::5\"): no '/'",
+			),
+		},
+		"invalid IPv6 interface address - zone": {
+			addressValue: iptypes.NewIPv6InterfaceAddressValue("fe80::1%eth0/64"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid IPv6 Interface Address String Value: "+
+					"A string value was provided that is not valid IPv6 interface address string format (address/prefix length).\n\n"+
+					"Given Value: fe80::1%eth0/64\n"+
+					"Error: "+"netip.ParsePrefix(\"fe80::1%eth0/64\"): IPv6 zones cannot be present in a prefix",
+			),
+		},
+		"invalid IPv6 interface address - IPv4 address": {
+			addressValue: iptypes.NewIPv6InterfaceAddressValue("10.0.0.5/24"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid IPv6 Interface Address String Value: "+
+					"An IPv4 interface address string format was provided, string value must be IPv6 interface address string format.\n\n"+
+					"Given Value: 10.0.0.5/24\n",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.addressValue.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestIPv6InterfaceAddressValueIPv6InterfaceAddress(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		addressValue     iptypes.IPv6InterfaceAddress
+		expectedIPPrefix netip.Prefix
+		expectedDiags    diag.Diagnostics
+	}{
+		"IPv6 interface address value is null": {
+			addressValue: iptypes.NewIPv6InterfaceAddressNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"IPv6InterfaceAddress ValueIPv6InterfaceAddress Error",
+					"IPv6 interface address string value is null",
+				),
+			},
+		},
+		"IPv6 interface address value is unknown": {
+			addressValue: iptypes.NewIPv6InterfaceAddressUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"IPv6InterfaceAddress ValueIPv6InterfaceAddress Error",
+					"IPv6 interface address string value is unknown",
+				),
+			},
+		},
+		"valid IPv6 interface address": {
+			addressValue:     iptypes.NewIPv6InterfaceAddressValue("2001:db8::5/64"),
+			expectedIPPrefix: netip.MustParsePrefix("2001:db8::5/64"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ipPrefix, diags := testCase.addressValue.ValueIPv6InterfaceAddress()
+
+			if ipPrefix != testCase.expectedIPPrefix {
+				t.Errorf("Unexpected difference in netip.Prefix, got: %s, expected: %s", ipPrefix, testCase.expectedIPPrefix)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestIPv6InterfaceAddressValueAddr(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		addressValue   iptypes.IPv6InterfaceAddress
+		expectedIPAddr netip.Addr
+		expectedDiags  diag.Diagnostics
+	}{
+		"IPv6 interface address value is null": {
+			addressValue: iptypes.NewIPv6InterfaceAddressNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"IPv6InterfaceAddress ValueAddr Error",
+					"IPv6 interface address string value is null",
+				),
+			},
+		},
+		"IPv6 interface address value is unknown": {
+			addressValue: iptypes.NewIPv6InterfaceAddressUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"IPv6InterfaceAddress ValueAddr Error",
+					"IPv6 interface address string value is unknown",
+				),
+			},
+		},
+		"valid IPv6 interface address": {
+			addressValue:   iptypes.NewIPv6InterfaceAddressValue("2001:db8::5/64"),
+			expectedIPAddr: netip.MustParseAddr("2001:db8::5"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ipAddr, diags := testCase.addressValue.ValueAddr()
+
+			if ipAddr != testCase.expectedIPAddr {
+				t.Errorf("Unexpected difference in netip.Addr, got: %s, expected: %s", ipAddr, testCase.expectedIPAddr)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestIPv6InterfaceAddressValuePrefix(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		addressValue     iptypes.IPv6InterfaceAddress
+		expectedIPPrefix netip.Prefix
+		expectedDiags    diag.Diagnostics
+	}{
+		"IPv6 interface address value is null": {
+			addressValue: iptypes.NewIPv6InterfaceAddressNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"IPv6InterfaceAddress ValuePrefix Error",
+					"IPv6 interface address string value is null",
+				),
+			},
+		},
+		"IPv6 interface address value is unknown": {
+			addressValue: iptypes.NewIPv6InterfaceAddressUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"IPv6InterfaceAddress ValuePrefix Error",
+					"IPv6 interface address string value is unknown",
+				),
+			},
+		},
+		"valid IPv6 interface address": {
+			addressValue:     iptypes.NewIPv6InterfaceAddressValue("2001:db8::5/64"),
+			expectedIPPrefix: netip.MustParsePrefix("2001:db8::/64"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ipPrefix, diags := testCase.addressValue.ValuePrefix()
+
+			if ipPrefix != testCase.expectedIPPrefix {
+				t.Errorf("Unexpected difference in netip.Prefix, got: %s, expected: %s", ipPrefix, testCase.expectedIPPrefix)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package iptypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable = (*IPv6MulticastAddressType)(nil)
+)
+
+// IPv6MulticastAddressType is an attribute type that represents a valid IPv6 multicast address string in ff00::/8 (RFC
+// 4291), such as `ff15::1`. IPv6 addresses with the reserved scopes 0 and f are not valid. The IPv6MulticastAddressType
+// fields optionally restrict valid values by IPv6 multicast scope and any-source or source-specific multicast range.
+// Semantic equality logic is defined for IPv6MulticastAddressType such that an address string with the zero bits
+// `compressed` will be considered equivalent to the `non-compressed` string.
+//
+// Examples:
+//   - `FF02:0:0:0:0:0:0:1` is semantically equal to `ff02::1`
+//   - `FF3E:0:0:0:0:0:8000:1` is semantically equal to `ff3e::8000:1`
+type IPv6MulticastAddressType struct {
+	basetypes.StringType
+
+	// Scopes restricts IPv6 multicast addresses to the given scopes, such as MulticastScopeLinkLocal. All scopes are
+	// permitted if empty.
+	Scopes []MulticastScope
+
+	// Mode restricts multicast addresses to any-source multicast (MulticastModeASM) or source-specific multicast
+	// (MulticastModeSSM). Both are permitted if zero.
+	Mode MulticastMode
+}
+
+// String returns a human readable string of the type name.
+func (t IPv6MulticastAddressType) String() string {
+	return "iptypes.IPv6MulticastAddressType"
+}
+
+// ValueType returns the Value type.
+func (t IPv6MulticastAddressType) ValueType(ctx context.Context) attr.Value {
+	return IPv6MulticastAddress{
+		restrictions: multicastRestrictions{scopes: t.Scopes, mode: t.Mode},
+	}
+}
+
+// Equal returns true if the given type is equivalent. The restrictions of the types are not compared, as they only
+// apply to validation, so that values created by provider logic are compatible with any restricted type.
+func (t IPv6MulticastAddressType) Equal(o attr.Type) bool {
+	other, ok := o.(IPv6MulticastAddressType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t IPv6MulticastAddressType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return IPv6MulticastAddress{
+		StringValue:  in,
+		restrictions: multicastRestrictions{scopes: t.Scopes, mode: t.Mode},
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t IPv6MulticastAddressType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
@@ -0,0 +1,262 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package iptypes
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/hwtypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*IPv6MulticastAddress)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*IPv6MulticastAddress)(nil)
+	_ xattr.ValidateableAttribute                = (*IPv6MulticastAddress)(nil)
+	_ function.ValidateableParameter             = (*IPv6MulticastAddress)(nil)
+)
+
+// IPv6MulticastAddress represents a valid IPv6 multicast address string in ff00::/8 (RFC 4291), such as `ff15::1`. IPv6
+// addresses with the reserved scopes 0 and f are not valid. The IPv6MulticastAddressType fields optionally restrict
+// valid values by IPv6 multicast scope and any-source or source-specific multicast range. Semantic equality logic is
+// defined for IPv6MulticastAddress such that an address string with the zero bits `compressed` will be considered
+// equivalent to the `non-compressed` string.
+//
+// Examples:
+//   - `FF02:0:0:0:0:0:0:1` is semantically equal to `ff02::1`
+//   - `FF3E:0:0:0:0:0:8000:1` is semantically equal to `ff3e::8000:1`
+type IPv6MulticastAddress struct {
+	basetypes.StringValue
+
+	// restrictions are copied from the IPv6MulticastAddressType the value was created by.
+	restrictions multicastRestrictions
+}
+
+// Type returns an IPv6MulticastAddressType.
+func (v IPv6MulticastAddress) Type(_ context.Context) attr.Type {
+	return IPv6MulticastAddressType{
+		Scopes: v.restrictions.scopes,
+		Mode:   v.restrictions.mode,
+	}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v IPv6MulticastAddress) Equal(o attr.Value) bool {
+	other, ok := o.(IPv6MulticastAddress)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given IPv6 multicast address string value is semantically equal to the
+// current IPv6 multicast address string value. This comparison utilizes netip.ParseAddr and then compares the resulting
+// netip.Addr representations. This means `compressed` IPv6 addresses are considered semantically equal to
+// `non-compressed` IPv6 addresses.
+//
+// Examples:
+//   - `FF02:0:0:0:0:0:0:1` is semantically equal to `ff02::1`
+//   - `FF3E:0:0:0:0:0:8000:1` is semantically equal to `ff3e::8000:1`
+func (v IPv6MulticastAddress) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(IPv6MulticastAddress)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// IPv6 multicast addresses are already validated at this point, ignoring errors
+	newIPAddr, _ := netip.ParseAddr(newValue.ValueString())
+	currentIPAddr, _ := netip.ParseAddr(v.ValueString())
+
+	return currentIPAddr == newIPAddr, diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String value
+// that is a valid IPv6 multicast address that satisfies the restrictions of its type.
+func (v IPv6MulticastAddress) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	ipAddr, err := netip.ParseAddr(v.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IPv6 Multicast Address String Value",
+			"A string value was provided that is not valid IPv6 multicast address string format (RFC 4291).\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if ipAddr.Is4() {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IPv6 Multicast Address String Value",
+			"An IPv4 string format was provided, string value must be IPv6 multicast address string format (RFC 4291).\n\n"+
+				"Given Value: "+v.ValueString()+"\n",
+		)
+
+		return
+	}
+
+	err = v.restrictions.validate(ipAddr)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IPv6 Multicast Address String Value",
+			"A string value was provided that is not a valid IPv6 multicast address (RFC 4291).\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is a valid IPv6 multicast address that satisfies the restrictions of its type.
+func (v IPv6MulticastAddress) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	ipAddr, err := netip.ParseAddr(v.ValueString())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid IPv6 Multicast Address String Value: "+
+				"A string value was provided that is not valid IPv6 multicast address string format (RFC 4291).\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if ipAddr.Is4() {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid IPv6 Multicast Address String Value: "+
+				"An IPv4 string format was provided, string value must be IPv6 multicast address string format (RFC 4291).\n\n"+
+				"Given Value: "+v.ValueString()+"\n",
+		)
+
+		return
+	}
+
+	err = v.restrictions.validate(ipAddr)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid IPv6 Multicast Address String Value: "+
+				"A string value was provided that is not a valid IPv6 multicast address (RFC 4291).\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueIPv6MulticastAddress calls netip.ParseAddr with the IPv6MulticastAddress StringValue. A null or unknown value
+// will produce an error diagnostic.
+func (v IPv6MulticastAddress) ValueIPv6MulticastAddress() (netip.Addr, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("IPv6MulticastAddress ValueIPv6MulticastAddress Error", "IPv6 multicast address string value is null"))
+		return netip.Addr{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("IPv6MulticastAddress ValueIPv6MulticastAddress Error", "IPv6 multicast address string value is unknown"))
+		return netip.Addr{}, diags
+	}
+
+	ipAddr, err := netip.ParseAddr(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("IPv6MulticastAddress ValueIPv6MulticastAddress Error", err.Error()))
+		return netip.Addr{}, diags
+	}
+
+	return ipAddr, nil
+}
+
+// ValueMACAddress returns the Ethernet multicast MAC address that the IPv6MulticastAddress StringValue maps to as an
+// hwtypes.MACAddress, which is 33:33 followed by the low 32 bits of the address (RFC 2464), such as `33:33:00:00:00:01`
+// for `ff02::1`. A null or unknown value will produce a null or unknown MACAddress respectively.
+func (v IPv6MulticastAddress) ValueMACAddress() (hwtypes.MACAddress, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		return hwtypes.NewMACAddressNull(), nil
+	}
+
+	if v.IsUnknown() {
+		return hwtypes.NewMACAddressUnknown(), nil
+	}
+
+	ipAddr, err := netip.ParseAddr(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("IPv6MulticastAddress ValueMACAddress Error", err.Error()))
+		return hwtypes.NewMACAddressUnknown(), diags
+	}
+
+	err = v.restrictions.validate(ipAddr)
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("IPv6MulticastAddress ValueMACAddress Error", err.Error()))
+		return hwtypes.NewMACAddressUnknown(), diags
+	}
+
+	return hwtypes.NewMACAddressValue(multicastMACAddress(ipAddr).String()), nil
+}
+
+// NewIPv6MulticastAddressNull creates an IPv6MulticastAddress with a null value. Determine whether the value is null via IsNull method.
+func NewIPv6MulticastAddressNull() IPv6MulticastAddress {
+	return IPv6MulticastAddress{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewIPv6MulticastAddressUnknown creates an IPv6MulticastAddress with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewIPv6MulticastAddressUnknown() IPv6MulticastAddress {
+	return IPv6MulticastAddress{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewIPv6MulticastAddressValue creates an IPv6MulticastAddress with a known value. Access the value via ValueString method.
+func NewIPv6MulticastAddressValue(value string) IPv6MulticastAddress {
+	return IPv6MulticastAddress{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewIPv6MulticastAddressPointerValue creates an IPv6MulticastAddress with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewIPv6MulticastAddressPointerValue(value *string) IPv6MulticastAddress {
+	return IPv6MulticastAddress{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
@@ -0,0 +1,409 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package iptypes_test
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/hwtypes"
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/iptypes"
+)
+
+// newRestrictedIPv6MulticastAddressValue creates a IPv6MulticastAddress with the restrictions of the given type, as Plugin
+// Framework would when populating a data model.
+func newRestrictedIPv6MulticastAddressValue(addressType iptypes.IPv6MulticastAddressType, value string) iptypes.IPv6MulticastAddress {
+	v, _ := addressType.ValueFromString(context.Background(), basetypes.NewStringValue(value))
+
+	return v.(iptypes.IPv6MulticastAddress)
+}
+
+func TestIPv6MulticastAddressStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentAddress iptypes.IPv6MulticastAddress
+		givenAddress   basetypes.StringValuable
+		expectedMatch  bool
+		expectedDiags  diag.Diagnostics
+	}{
+		"not equal - IPv6 address mismatch": {
+			currentAddress: iptypes.NewIPv6MulticastAddressValue("ff02::1"),
+			givenAddress:   iptypes.NewIPv6MulticastAddressValue("ff02::2"),
+			expectedMatch:  false,
+		},
+		"semantically equal - byte-for-byte match": {
+			currentAddress: iptypes.NewIPv6MulticastAddressValue("ff15::1"),
+			givenAddress:   iptypes.NewIPv6MulticastAddressValue("ff15::1"),
+			expectedMatch:  true,
+		},
+		"semantically equal - case insensitive": {
+			currentAddress: iptypes.NewIPv6MulticastAddressValue("FF15::1"),
+			givenAddress:   iptypes.NewIPv6MulticastAddressValue("ff15::1"),
+			expectedMatch:  true,
+		},
+		"semantically equal - compressed match": {
+			currentAddress: iptypes.NewIPv6MulticastAddressValue("FF02:0:0:0:0:0:0:1"),
+			givenAddress:   iptypes.NewIPv6MulticastAddressValue("ff02::1"),
+			expectedMatch:  true,
+		},
+		"semantically equal - compressed source-specific match": {
+			currentAddress: iptypes.NewIPv6MulticastAddressValue("FF3E:0:0:0:0:0:8000:1"),
+			givenAddress:   iptypes.NewIPv6MulticastAddressValue("ff3e::8000:1"),
+			expectedMatch:  true,
+		},
+		"error - not given IPv6MulticastAddress value": {
+			currentAddress: iptypes.NewIPv6MulticastAddressValue("ff15::1"),
+			givenAddress:   basetypes.NewStringValue("ff15::1"),
+			expectedMatch:  false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: iptypes.IPv6MulticastAddress\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentAddress.StringSemanticEquals(context.Background(), testCase.givenAddress)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestIPv6MulticastAddressValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		addressValue  iptypes.IPv6MulticastAddress
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			addressValue: iptypes.IPv6MulticastAddress{},
+		},
+		"null": {
+			addressValue: iptypes.NewIPv6MulticastAddressNull(),
+		},
+		"unknown": {
+			addressValue: iptypes.NewIPv6MulticastAddressUnknown(),
+		},
+		"valid IPv6 multicast address": {
+			addressValue: iptypes.NewIPv6MulticastAddressValue("ff15::1"),
+		},
+		"valid IPv6 multicast address - link-local with zone": {
+			addressValue: iptypes.NewIPv6MulticastAddressValue("ff02::1%eth0"),
+		},
+		"valid IPv6 multicast address - scope restriction": {
+			addressValue: newRestrictedIPv6MulticastAddressValue(
+				iptypes.IPv6MulticastAddressType{
+					Scopes: []iptypes.MulticastScope{iptypes.MulticastScopeSiteLocal, iptypes.MulticastScopeGlobal},
+				},
+				"ff1e::1",
+			),
+		},
+		"valid IPv6 multicast address - SSM restriction": {
+			addressValue: newRestrictedIPv6MulticastAddressValue(
+				iptypes.IPv6MulticastAddressType{Mode: iptypes.MulticastModeSSM},
+				"ff3e::8000:1",
+			),
+		},
+		"invalid - not an address": {
+			addressValue: iptypes.NewIPv6MulticastAddressValue("not-an-address"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid IPv6 Multicast Address String Value",
+					"A string value was provided that is not valid IPv6 multicast address string format (RFC 4291).\n\n"+
+						"Given Value: not-an-address\n"+
+						"Error: "+"ParseAddr(\"not-an-address\"): unable to parse IP",
+				),
+			},
+		},
+		"invalid - IPv4 multicast address": {
+			addressValue: iptypes.NewIPv6MulticastAddressValue("239.1.1.1"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid IPv6 Multicast Address String Value",
+					"An IPv4 string format was provided, string value must be IPv6 multicast address string format (RFC 4291).\n\n"+
+						"Given Value: 239.1.1.1\n",
+				),
+			},
+		},
+		"invalid - IPv6 unicast address": {
+			addressValue: iptypes.NewIPv6MulticastAddressValue("2001:db8::1"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid IPv6 Multicast Address String Value",
+					"A string value was provided that is not a valid IPv6 multicast address (RFC 4291).\n\n"+
+						"Given Value: 2001:db8::1\n"+
+						"Error: "+"address 2001:db8::1 is not in the IPv6 multicast range ff00::/8",
+				),
+			},
+		},
+		"invalid - IPv6 reserved scope": {
+			addressValue: iptypes.NewIPv6MulticastAddressValue("ff00::1"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid IPv6 Multicast Address String Value",
+					"A string value was provided that is not a valid IPv6 multicast address (RFC 4291).\n\n"+
+						"Given Value: ff00::1\n"+
+						"Error: "+"address ff00::1 has reserved multicast scope 0",
+				),
+			},
+		},
+		"invalid - scope restriction": {
+			addressValue: newRestrictedIPv6MulticastAddressValue(
+				iptypes.IPv6MulticastAddressType{
+					Scopes: []iptypes.MulticastScope{iptypes.MulticastScopeSiteLocal, iptypes.MulticastScopeGlobal},
+				},
+				"ff02::1",
+			),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid IPv6 Multicast Address String Value",
+					"A string value was provided that is not a valid IPv6 multicast address (RFC 4291).\n\n"+
+						"Given Value: ff02::1\n"+
+						"Error: "+"address ff02::1 has multicast scope link-local, expected one of: site-local, global",
+				),
+			},
+		},
+		"invalid - SSM restriction": {
+			addressValue: newRestrictedIPv6MulticastAddressValue(
+				iptypes.IPv6MulticastAddressType{Mode: iptypes.MulticastModeSSM},
+				"ff3e:40:2001:db8::1",
+			),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid IPv6 Multicast Address String Value",
+					"A string value was provided that is not a valid IPv6 multicast address (RFC 4291).\n\n"+
+						"Given Value: ff3e:40:2001:db8::1\n"+
+						"Error: "+"address ff3e:40:2001:db8::1 is not a source-specific multicast address in 232.0.0.0/8 or ff3x::/96",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.addressValue.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{Path: path.Root("test")},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestIPv6MulticastAddressValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		addressValue    iptypes.IPv6MulticastAddress
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			addressValue: iptypes.IPv6MulticastAddress{},
+		},
+		"null": {
+			addressValue: iptypes.NewIPv6MulticastAddressNull(),
+		},
+		"unknown": {
+			addressValue: iptypes.NewIPv6MulticastAddressUnknown(),
+		},
+		"valid IPv6 multicast address": {
+			addressValue: iptypes.NewIPv6MulticastAddressValue("ff15::1"),
+		},
+		"invalid - not an address": {
+			addressValue: iptypes.NewIPv6MulticastAddressValue("not-an-address"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid IPv6 Multicast Address String Value: "+
+					"A string value was provided that is not valid IPv6 multicast address string format (RFC 4291).\n\n"+
+					"Given Value: not-an-address\n"+
+					"Error: "+"ParseAddr(\"not-an-address\"): unable to parse IP",
+			),
+		},
+		"invalid - IPv4 multicast address": {
+			addressValue: iptypes.NewIPv6MulticastAddressValue("239.1.1.1"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid IPv6 Multicast Address String Value: "+
+					"An IPv4 string format was provided, string value must be IPv6 multicast address string format (RFC 4291).\n\n"+
+					"Given Value: 239.1.1.1\n",
+			),
+		},
+		"invalid - scope restriction": {
+			addressValue: newRestrictedIPv6MulticastAddressValue(
+				iptypes.IPv6MulticastAddressType{
+					Scopes: []iptypes.MulticastScope{iptypes.MulticastScopeGlobal},
+				},
+				"ff05::1:3",
+			),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid IPv6 Multicast Address String Value: "+
+					"A string value was provided that is not a valid IPv6 multicast address (RFC 4291).\n\n"+
+					"Given Value: ff05::1:3\n"+
+					"Error: "+"address ff05::1:3 has multicast scope site-local, expected one of: global",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.addressValue.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestIPv6MulticastAddressValueIPv6MulticastAddress(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		addressValue   iptypes.IPv6MulticastAddress
+		expectedIPAddr netip.Addr
+		expectedDiags  diag.Diagnostics
+	}{
+		"IPv6 multicast address value is null": {
+			addressValue: iptypes.NewIPv6MulticastAddressNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"IPv6MulticastAddress ValueIPv6MulticastAddress Error",
+					"IPv6 multicast address string value is null",
+				),
+			},
+		},
+		"IPv6 multicast address value is unknown": {
+			addressValue: iptypes.NewIPv6MulticastAddressUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"IPv6MulticastAddress ValueIPv6MulticastAddress Error",
+					"IPv6 multicast address string value is unknown",
+				),
+			},
+		},
+		"valid IPv6 multicast address": {
+			addressValue:   iptypes.NewIPv6MulticastAddressValue("ff15::1"),
+			expectedIPAddr: netip.MustParseAddr("ff15::1"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ipAddr, diags := testCase.addressValue.ValueIPv6MulticastAddress()
+
+			if ipAddr != testCase.expectedIPAddr {
+				t.Errorf("Unexpected difference in netip.Addr, got: %s, expected: %s", ipAddr, testCase.expectedIPAddr)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestIPv6MulticastAddressValueMACAddress(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		addressValue       iptypes.IPv6MulticastAddress
+		expectedMACAddress hwtypes.MACAddress
+		expectedDiags      diag.Diagnostics
+	}{
+		"IPv6 multicast address value is null": {
+			addressValue:       iptypes.NewIPv6MulticastAddressNull(),
+			expectedMACAddress: hwtypes.NewMACAddressNull(),
+		},
+		"IPv6 multicast address value is unknown": {
+			addressValue:       iptypes.NewIPv6MulticastAddressUnknown(),
+			expectedMACAddress: hwtypes.NewMACAddressUnknown(),
+		},
+		"IPv6 all nodes": {
+			addressValue:       iptypes.NewIPv6MulticastAddressValue("ff02::1"),
+			expectedMACAddress: hwtypes.NewMACAddressValue("33:33:00:00:00:01"),
+		},
+		"IPv6 solicited-node": {
+			addressValue:       iptypes.NewIPv6MulticastAddressValue("ff02::1:ff00:5"),
+			expectedMACAddress: hwtypes.NewMACAddressValue("33:33:ff:00:00:05"),
+		},
+		"invalid - unicast address": {
+			addressValue:       iptypes.NewIPv6MulticastAddressValue("2001:db8::1"),
+			expectedMACAddress: hwtypes.NewMACAddressUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"IPv6MulticastAddress ValueMACAddress Error",
+					"address 2001:db8::1 is not in the IPv6 multicast range ff00::/8",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			macAddress, diags := testCase.addressValue.ValueMACAddress()
+
+			if !macAddress.Equal(testCase.expectedMACAddress) {
+				t.Errorf("Unexpected difference in MACAddress, got: %s, expected: %s", macAddress, testCase.expectedMACAddress)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package iptypes
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+	"slices"
+	"strings"
+)
+
+var (
+	// ipv4MulticastPrefix is the IPv4 multicast address range (RFC 5771).
+	ipv4MulticastPrefix = netip.MustParsePrefix("224.0.0.0/4")
+
+	// ipv4SourceSpecificMulticastPrefix is the IPv4 source-specific multicast address range (RFC 4607 Section 1).
+	ipv4SourceSpecificMulticastPrefix = netip.MustParsePrefix("232.0.0.0/8")
+
+	// ipv6MulticastPrefix is the IPv6 multicast address range (RFC 4291 Section 2.7).
+	ipv6MulticastPrefix = netip.MustParsePrefix("ff00::/8")
+)
+
+// MulticastScope is the scope of an IPv6 multicast address, which is the 4-bit scop field following the flags of the
+// address (RFC 4291 Section 2.7, RFC 7346).
+type MulticastScope uint8
+
+const (
+	// MulticastScopeInterfaceLocal is the interface-local scope, which only spans a single interface of a node.
+	MulticastScopeInterfaceLocal MulticastScope = 0x1
+
+	// MulticastScopeLinkLocal is the link-local scope, such as ff02::1.
+	MulticastScopeLinkLocal MulticastScope = 0x2
+
+	// MulticastScopeRealmLocal is the realm-local scope (RFC 7346).
+	MulticastScopeRealmLocal MulticastScope = 0x3
+
+	// MulticastScopeAdminLocal is the admin-local scope, which is the smallest administratively configured scope.
+	MulticastScopeAdminLocal MulticastScope = 0x4
+
+	// MulticastScopeSiteLocal is the site-local scope.
+	MulticastScopeSiteLocal MulticastScope = 0x5
+
+	// MulticastScopeOrganizationLocal is the organization-local scope, which spans multiple sites of an organization.
+	MulticastScopeOrganizationLocal MulticastScope = 0x8
+
+	// MulticastScopeGlobal is the global scope.
+	MulticastScopeGlobal MulticastScope = 0xe
+)
+
+// String returns the name of the multicast scope, such as `link-local`, or its hexadecimal value if the scope is
+// unassigned.
+func (s MulticastScope) String() string {
+	switch s {
+	case MulticastScopeInterfaceLocal:
+		return "interface-local"
+	case MulticastScopeLinkLocal:
+		return "link-local"
+	case MulticastScopeRealmLocal:
+		return "realm-local"
+	case MulticastScopeAdminLocal:
+		return "admin-local"
+	case MulticastScopeSiteLocal:
+		return "site-local"
+	case MulticastScopeOrganizationLocal:
+		return "organization-local"
+	case MulticastScopeGlobal:
+		return "global"
+	default:
+		return fmt.Sprintf("%x", uint8(s))
+	}
+}
+
+// MulticastMode is the multicast service model that multicast addresses are restricted to (RFC 4607).
+type MulticastMode uint8
+
+const (
+	// MulticastModeASM restricts multicast addresses to any-source multicast, which excludes the source-specific
+	// multicast ranges.
+	MulticastModeASM MulticastMode = iota + 1
+
+	// MulticastModeSSM restricts multicast addresses to the source-specific multicast ranges 232.0.0.0/8 and
+	// ff3x::/96 (RFC 4607 Section 1).
+	MulticastModeSSM
+)
+
+// multicastRestrictions are the restrictions of a multicast address type, which are copied into its values so that
+// validation can apply them.
+type multicastRestrictions struct {
+	scopes []MulticastScope
+	mode   MulticastMode
+}
+
+// validate returns an error if a multicast address is not within the multicast ranges or does not satisfy the
+// restrictions.
+func (r multicastRestrictions) validate(addr netip.Addr) error {
+	if addr.Is4() {
+		if !ipv4MulticastPrefix.Contains(addr) {
+			return fmt.Errorf("address %s is not in the IPv4 multicast range %s", addr, ipv4MulticastPrefix)
+		}
+	} else {
+		if !ipv6MulticastPrefix.Contains(addr.WithZone("")) {
+			return fmt.Errorf("address %s is not in the IPv6 multicast range %s", addr, ipv6MulticastPrefix)
+		}
+
+		scope := multicastScope(addr)
+
+		// Scopes 0 and f are reserved (RFC 4291 Section 2.7)
+		if scope == 0x0 || scope == 0xf {
+			return fmt.Errorf("address %s has reserved multicast scope %s", addr, scope)
+		}
+
+		if len(r.scopes) > 0 && !slices.Contains(r.scopes, scope) {
+			names := make([]string, 0, len(r.scopes))
+
+			for _, s := range r.scopes {
+				names = append(names, s.String())
+			}
+
+			return fmt.Errorf("address %s has multicast scope %s, expected one of: %s", addr, scope, strings.Join(names, ", "))
+		}
+	}
+
+	switch r.mode {
+	case MulticastModeASM:
+		if isSourceSpecificMulticast(addr) {
+			return fmt.Errorf("address %s is a source-specific multicast address, expected an any-source multicast address", addr)
+		}
+	case MulticastModeSSM:
+		if !isSourceSpecificMulticast(addr) {
+			return fmt.Errorf("address %s is not a source-specific multicast address in 232.0.0.0/8 or ff3x::/96", addr)
+		}
+	}
+
+	return nil
+}
+
+// multicastScope returns the scope of an IPv6 multicast address.
+func multicastScope(addr netip.Addr) MulticastScope {
+	return MulticastScope(addr.As16()[1] & 0x0f)
+}
+
+// isSourceSpecificMulticast returns true if a multicast address is in 232.0.0.0/8 or ff3x::/96 of any scope, which is
+// a multicast address with the P and T flags set and a zero prefix length and network prefix (RFC 4607 Section 1).
+func isSourceSpecificMulticast(addr netip.Addr) bool {
+	if addr.Is4() {
+		return ipv4SourceSpecificMulticastPrefix.Contains(addr)
+	}
+
+	octets := addr.As16()
+
+	if octets[0] != 0xff || octets[1]>>4 != 0x3 {
+		return false
+	}
+
+	for _, octet := range octets[2:12] {
+		if octet != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// multicastMACAddress returns the Ethernet multicast MAC address of a multicast group, which is 01:00:5e followed by
+// the low 23 bits of an IPv4 address (RFC 1112 Section 6.4), or 33:33 followed by the low 32 bits of an IPv6 address
+// (RFC 2464 Section 7).
+func multicastMACAddress(addr netip.Addr) net.HardwareAddr {
+	if addr.Is4() {
+		octets := addr.As4()
+
+		return net.HardwareAddr{0x01, 0x00, 0x5e, octets[1] & 0x7f, octets[2], octets[3]}
+	}
+
+	octets := addr.As16()
+
+	return net.HardwareAddr{0x33, 0x33, octets[12], octets[13], octets[14], octets[15]}
+}
@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package iptypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable = (*MulticastAddressType)(nil)
+)
+
+// MulticastAddressType is an attribute type that represents a valid multicast address string in 224.0.0.0/4 (RFC 5771)
+// or ff00::/8 (RFC 4291), such as `239.1.1.1` or `ff15::1`. IPv6 addresses with the reserved scopes 0 and f are not
+// valid. The MulticastAddressType fields optionally restrict valid values by IPv6 multicast scope and any-source or
+// source-specific multicast range. Semantic equality logic is defined for MulticastAddressType such that an address
+// string with the zero bits `compressed` will be considered equivalent to the `non-compressed` string.
+//
+// Examples:
+//   - `FF02:0:0:0:0:0:0:1` is semantically equal to `ff02::1`
+//   - `FF3E:0:0:0:0:0:8000:1` is semantically equal to `ff3e::8000:1`
+type MulticastAddressType struct {
+	basetypes.StringType
+
+	// Scopes restricts IPv6 multicast addresses to the given scopes, such as MulticastScopeLinkLocal. All scopes are
+	// permitted if empty. IPv4 multicast addresses are not restricted by scope.
+	Scopes []MulticastScope
+
+	// Mode restricts multicast addresses to any-source multicast (MulticastModeASM) or source-specific multicast
+	// (MulticastModeSSM). Both are permitted if zero.
+	Mode MulticastMode
+}
+
+// String returns a human readable string of the type name.
+func (t MulticastAddressType) String() string {
+	return "iptypes.MulticastAddressType"
+}
+
+// ValueType returns the Value type.
+func (t MulticastAddressType) ValueType(ctx context.Context) attr.Value {
+	return MulticastAddress{
+		restrictions: multicastRestrictions{scopes: t.Scopes, mode: t.Mode},
+	}
+}
+
+// Equal returns true if the given type is equivalent. The restrictions of the types are not compared, as they only
+// apply to validation, so that values created by provider logic are compatible with any restricted type.
+func (t MulticastAddressType) Equal(o attr.Type) bool {
+	other, ok := o.(MulticastAddressType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t MulticastAddressType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return MulticastAddress{
+		StringValue:  in,
+		restrictions: multicastRestrictions{scopes: t.Scopes, mode: t.Mode},
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t MulticastAddressType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
@@ -0,0 +1,123 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package iptypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/iptypes"
+)
+
+func TestMulticastAddressTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in          tftypes.Value
+		expectation attr.Value
+		expectedErr string
+	}{
+		"valid IPv4 address": {
+			in:          tftypes.NewValue(tftypes.String, "239.1.1.1"),
+			expectation: iptypes.NewMulticastAddressValue("239.1.1.1"),
+		},
+		"valid IPv6 address": {
+			in:          tftypes.NewValue(tftypes.String, "ff15::1"),
+			expectation: iptypes.NewMulticastAddressValue("ff15::1"),
+		},
+		"unknown": {
+			in:          tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expectation: iptypes.NewMulticastAddressUnknown(),
+		},
+		"null": {
+			in:          tftypes.NewValue(tftypes.String, nil),
+			expectation: iptypes.NewMulticastAddressNull(),
+		},
+		"wrongType": {
+			in:          tftypes.NewValue(tftypes.Number, 123),
+			expectedErr: "can't unmarshal tftypes.Number into *string, expected string",
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			got, err := iptypes.MulticastAddressType{}.ValueFromTerraform(ctx, testCase.in)
+			if err != nil {
+				if testCase.expectedErr == "" {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+				if testCase.expectedErr != err.Error() {
+					t.Fatalf("Expected error to be %q, got %q", testCase.expectedErr, err.Error())
+				}
+				return
+			}
+			if testCase.expectedErr != "" {
+				t.Fatalf("Expected error to be %q, didn't get an error", testCase.expectedErr)
+			}
+			if !got.Equal(testCase.expectation) {
+				t.Errorf("Expected %+v, got %+v", testCase.expectation, got)
+			}
+			if testCase.expectation.IsNull() != testCase.in.IsNull() {
+				t.Errorf("Expected null-ness match: expected %t, got %t", testCase.expectation.IsNull(), testCase.in.IsNull())
+			}
+			if testCase.expectation.IsUnknown() != !testCase.in.IsKnown() {
+				t.Errorf("Expected unknown-ness match: expected %t, got %t", testCase.expectation.IsUnknown(), !testCase.in.IsKnown())
+			}
+		})
+	}
+}
+
+func TestMulticastAddressTypeRestrictions(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		addressType iptypes.MulticastAddressType
+	}{
+		"unrestricted": {
+			addressType: iptypes.MulticastAddressType{},
+		},
+		"scopes": {
+			addressType: iptypes.MulticastAddressType{
+				Scopes: []iptypes.MulticastScope{iptypes.MulticastScopeSiteLocal, iptypes.MulticastScopeGlobal},
+			},
+		},
+		"scopes and mode": {
+			addressType: iptypes.MulticastAddressType{
+				Scopes: []iptypes.MulticastScope{iptypes.MulticastScopeGlobal},
+				Mode:   iptypes.MulticastModeSSM,
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			got, err := testCase.addressType.ValueFromTerraform(ctx, tftypes.NewValue(tftypes.String, "ff3e::8000:1"))
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			// The restrictions of the type are carried by its values
+			if diff := cmp.Diff(got.Type(ctx), testCase.addressType); diff != "" {
+				t.Errorf("Unexpected type (-got, +expected): %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.addressType.ValueType(ctx).Type(ctx), testCase.addressType); diff != "" {
+				t.Errorf("Unexpected value type (-got, +expected): %s", diff)
+			}
+
+			// Restrictions do not affect type equality
+			if !testCase.addressType.Equal(iptypes.MulticastAddressType{}) {
+				t.Errorf("Expected %s to equal an unrestricted type", testCase.addressType)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package iptypes_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/iptypes"
+)
+
+type MulticastAddressResourceModel struct {
+	Group iptypes.MulticastAddress `tfsdk:"group"`
+}
+
+func ExampleMulticastAddress_ValueMACAddress() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := MulticastAddressResourceModel{
+		Group: iptypes.NewMulticastAddressValue("239.129.1.1"),
+	}
+
+	// Check that the MulticastAddress data is known and find the Ethernet MAC address the group is received on
+	if !data.Group.IsNull() && !data.Group.IsUnknown() {
+		macAddress, diags := data.Group.ValueMACAddress()
+		if diags.HasError() {
+			return
+		}
+
+		// Output: 01:00:5e:01:01:01
+		fmt.Println(macAddress.ValueString())
+	}
+}
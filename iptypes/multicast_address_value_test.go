@@ -0,0 +1,504 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package iptypes_test
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/hwtypes"
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/iptypes"
+)
+
+// newRestrictedMulticastAddressValue creates a MulticastAddress with the restrictions of the given type, as Plugin
+// Framework would when populating a data model.
+func newRestrictedMulticastAddressValue(addressType iptypes.MulticastAddressType, value string) iptypes.MulticastAddress {
+	v, _ := addressType.ValueFromString(context.Background(), basetypes.NewStringValue(value))
+
+	return v.(iptypes.MulticastAddress)
+}
+
+func TestMulticastAddressStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentAddress iptypes.MulticastAddress
+		givenAddress   basetypes.StringValuable
+		expectedMatch  bool
+		expectedDiags  diag.Diagnostics
+	}{
+		"not equal - IPv4 address mismatch": {
+			currentAddress: iptypes.NewMulticastAddressValue("239.1.1.1"),
+			givenAddress:   iptypes.NewMulticastAddressValue("239.1.1.2"),
+			expectedMatch:  false,
+		},
+		"not equal - IPv6 address mismatch": {
+			currentAddress: iptypes.NewMulticastAddressValue("ff02::1"),
+			givenAddress:   iptypes.NewMulticastAddressValue("ff02::2"),
+			expectedMatch:  false,
+		},
+		"not equal - IPv4 and IPv4-mapped IPv6 address": {
+			currentAddress: iptypes.NewMulticastAddressValue("239.1.1.1"),
+			givenAddress:   iptypes.NewMulticastAddressValue("::ffff:239.1.1.1"),
+			expectedMatch:  false,
+		},
+		"semantically equal - byte-for-byte match": {
+			currentAddress: iptypes.NewMulticastAddressValue("239.1.1.1"),
+			givenAddress:   iptypes.NewMulticastAddressValue("239.1.1.1"),
+			expectedMatch:  true,
+		},
+		"semantically equal - case insensitive": {
+			currentAddress: iptypes.NewMulticastAddressValue("FF15::1"),
+			givenAddress:   iptypes.NewMulticastAddressValue("ff15::1"),
+			expectedMatch:  true,
+		},
+		"semantically equal - compressed match": {
+			currentAddress: iptypes.NewMulticastAddressValue("FF02:0:0:0:0:0:0:1"),
+			givenAddress:   iptypes.NewMulticastAddressValue("ff02::1"),
+			expectedMatch:  true,
+		},
+		"semantically equal - compressed source-specific match": {
+			currentAddress: iptypes.NewMulticastAddressValue("FF3E:0:0:0:0:0:8000:1"),
+			givenAddress:   iptypes.NewMulticastAddressValue("ff3e::8000:1"),
+			expectedMatch:  true,
+		},
+		"error - not given MulticastAddress value": {
+			currentAddress: iptypes.NewMulticastAddressValue("239.1.1.1"),
+			givenAddress:   basetypes.NewStringValue("239.1.1.1"),
+			expectedMatch:  false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: iptypes.MulticastAddress\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentAddress.StringSemanticEquals(context.Background(), testCase.givenAddress)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestMulticastAddressValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		addressValue  iptypes.MulticastAddress
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			addressValue: iptypes.MulticastAddress{},
+		},
+		"null": {
+			addressValue: iptypes.NewMulticastAddressNull(),
+		},
+		"unknown": {
+			addressValue: iptypes.NewMulticastAddressUnknown(),
+		},
+		"valid IPv4 multicast address": {
+			addressValue: iptypes.NewMulticastAddressValue("239.1.1.1"),
+		},
+		"valid IPv4 multicast address - lowest": {
+			addressValue: iptypes.NewMulticastAddressValue("224.0.0.0"),
+		},
+		"valid IPv4 multicast address - highest": {
+			addressValue: iptypes.NewMulticastAddressValue("239.255.255.255"),
+		},
+		"valid IPv6 multicast address": {
+			addressValue: iptypes.NewMulticastAddressValue("ff15::1"),
+		},
+		"valid IPv6 multicast address - link-local with zone": {
+			addressValue: iptypes.NewMulticastAddressValue("ff02::1%eth0"),
+		},
+		"valid IPv6 multicast address - scope restriction": {
+			addressValue: newRestrictedMulticastAddressValue(
+				iptypes.MulticastAddressType{
+					Scopes: []iptypes.MulticastScope{iptypes.MulticastScopeSiteLocal, iptypes.MulticastScopeGlobal},
+				},
+				"ff1e::1",
+			),
+		},
+		"valid IPv4 multicast address - scope restriction": {
+			addressValue: newRestrictedMulticastAddressValue(
+				iptypes.MulticastAddressType{
+					Scopes: []iptypes.MulticastScope{iptypes.MulticastScopeGlobal},
+				},
+				"239.1.1.1",
+			),
+		},
+		"valid IPv4 multicast address - ASM restriction": {
+			addressValue: newRestrictedMulticastAddressValue(
+				iptypes.MulticastAddressType{Mode: iptypes.MulticastModeASM},
+				"239.1.1.1",
+			),
+		},
+		"valid IPv4 multicast address - SSM restriction": {
+			addressValue: newRestrictedMulticastAddressValue(
+				iptypes.MulticastAddressType{Mode: iptypes.MulticastModeSSM},
+				"232.1.1.1",
+			),
+		},
+		"valid IPv6 multicast address - SSM restriction": {
+			addressValue: newRestrictedMulticastAddressValue(
+				iptypes.MulticastAddressType{Mode: iptypes.MulticastModeSSM},
+				"ff3e::8000:1",
+			),
+		},
+		"invalid - not an address": {
+			addressValue: iptypes.NewMulticastAddressValue("not-an-address"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Multicast Address String Value",
+					"A string value was provided that is not valid multicast address string format (RFC 5771, RFC 4291).\n\n"+
+						"Given Value: not-an-address\n"+
+						"Error: "+"ParseAddr(\"not-an-address\"): unable to parse IP",
+				),
+			},
+		},
+		"invalid - IPv4 unicast address": {
+			addressValue: iptypes.NewMulticastAddressValue("192.168.0.1"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Multicast Address String Value",
+					"A string value was provided that is not a valid multicast address (RFC 5771, RFC 4291).\n\n"+
+						"Given Value: 192.168.0.1\n"+
+						"Error: "+"address 192.168.0.1 is not in the IPv4 multicast range 224.0.0.0/4",
+				),
+			},
+		},
+		"invalid - IPv4 reserved address": {
+			addressValue: iptypes.NewMulticastAddressValue("240.0.0.1"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Multicast Address String Value",
+					"A string value was provided that is not a valid multicast address (RFC 5771, RFC 4291).\n\n"+
+						"Given Value: 240.0.0.1\n"+
+						"Error: "+"address 240.0.0.1 is not in the IPv4 multicast range 224.0.0.0/4",
+				),
+			},
+		},
+		"invalid - IPv6 unicast address": {
+			addressValue: iptypes.NewMulticastAddressValue("2001:db8::1"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Multicast Address String Value",
+					"A string value was provided that is not a valid multicast address (RFC 5771, RFC 4291).\n\n"+
+						"Given Value: 2001:db8::1\n"+
+						"Error: "+"address 2001:db8::1 is not in the IPv6 multicast range ff00::/8",
+				),
+			},
+		},
+		"invalid - IPv4-mapped IPv6 address": {
+			addressValue: iptypes.NewMulticastAddressValue("::ffff:239.1.1.1"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Multicast Address String Value",
+					"A string value was provided that is not a valid multicast address (RFC 5771, RFC 4291).\n\n"+
+						"Given Value: ::ffff:239.1.1.1\n"+
+						"Error: "+"address ::ffff:239.1.1.1 is not in the IPv6 multicast range ff00::/8",
+				),
+			},
+		},
+		"invalid - IPv6 reserved scope": {
+			addressValue: iptypes.NewMulticastAddressValue("ff00::1"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Multicast Address String Value",
+					"A string value was provided that is not a valid multicast address (RFC 5771, RFC 4291).\n\n"+
+						"Given Value: ff00::1\n"+
+						"Error: "+"address ff00::1 has reserved multicast scope 0",
+				),
+			},
+		},
+		"invalid - scope restriction": {
+			addressValue: newRestrictedMulticastAddressValue(
+				iptypes.MulticastAddressType{
+					Scopes: []iptypes.MulticastScope{iptypes.MulticastScopeSiteLocal, iptypes.MulticastScopeGlobal},
+				},
+				"ff02::1",
+			),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Multicast Address String Value",
+					"A string value was provided that is not a valid multicast address (RFC 5771, RFC 4291).\n\n"+
+						"Given Value: ff02::1\n"+
+						"Error: "+"address ff02::1 has multicast scope link-local, expected one of: site-local, global",
+				),
+			},
+		},
+		"invalid - ASM restriction": {
+			addressValue: newRestrictedMulticastAddressValue(
+				iptypes.MulticastAddressType{Mode: iptypes.MulticastModeASM},
+				"232.1.1.1",
+			),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Multicast Address String Value",
+					"A string value was provided that is not a valid multicast address (RFC 5771, RFC 4291).\n\n"+
+						"Given Value: 232.1.1.1\n"+
+						"Error: "+"address 232.1.1.1 is a source-specific multicast address, expected an any-source multicast address",
+				),
+			},
+		},
+		"invalid - SSM restriction": {
+			addressValue: newRestrictedMulticastAddressValue(
+				iptypes.MulticastAddressType{Mode: iptypes.MulticastModeSSM},
+				"ff3e:40:2001:db8::1",
+			),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Multicast Address String Value",
+					"A string value was provided that is not a valid multicast address (RFC 5771, RFC 4291).\n\n"+
+						"Given Value: ff3e:40:2001:db8::1\n"+
+						"Error: "+"address ff3e:40:2001:db8::1 is not a source-specific multicast address in 232.0.0.0/8 or ff3x::/96",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.addressValue.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{Path: path.Root("test")},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestMulticastAddressValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		addressValue    iptypes.MulticastAddress
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			addressValue: iptypes.MulticastAddress{},
+		},
+		"null": {
+			addressValue: iptypes.NewMulticastAddressNull(),
+		},
+		"unknown": {
+			addressValue: iptypes.NewMulticastAddressUnknown(),
+		},
+		"valid IPv4 multicast address": {
+			addressValue: iptypes.NewMulticastAddressValue("239.1.1.1"),
+		},
+		"valid IPv6 multicast address": {
+			addressValue: iptypes.NewMulticastAddressValue("ff15::1"),
+		},
+		"invalid - not an address": {
+			addressValue: iptypes.NewMulticastAddressValue("not-an-address"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid Multicast Address String Value: "+
+					"A string value was provided that is not valid multicast address string format (RFC 5771, RFC 4291).\n\n"+
+					"Given Value: not-an-address\n"+
+					"Error: "+"ParseAddr(\"not-an-address\"): unable to parse IP",
+			),
+		},
+		"invalid - IPv4 unicast address": {
+			addressValue: iptypes.NewMulticastAddressValue("192.168.0.1"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid Multicast Address String Value: "+
+					"A string value was provided that is not a valid multicast address (RFC 5771, RFC 4291).\n\n"+
+					"Given Value: 192.168.0.1\n"+
+					"Error: "+"address 192.168.0.1 is not in the IPv4 multicast range 224.0.0.0/4",
+			),
+		},
+		"invalid - scope restriction": {
+			addressValue: newRestrictedMulticastAddressValue(
+				iptypes.MulticastAddressType{
+					Scopes: []iptypes.MulticastScope{iptypes.MulticastScopeGlobal},
+				},
+				"ff05::1:3",
+			),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid Multicast Address String Value: "+
+					"A string value was provided that is not a valid multicast address (RFC 5771, RFC 4291).\n\n"+
+					"Given Value: ff05::1:3\n"+
+					"Error: "+"address ff05::1:3 has multicast scope site-local, expected one of: global",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.addressValue.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestMulticastAddressValueMulticastAddress(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		addressValue   iptypes.MulticastAddress
+		expectedIPAddr netip.Addr
+		expectedDiags  diag.Diagnostics
+	}{
+		"multicast address value is null": {
+			addressValue: iptypes.NewMulticastAddressNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"MulticastAddress ValueMulticastAddress Error",
+					"multicast address string value is null",
+				),
+			},
+		},
+		"multicast address value is unknown": {
+			addressValue: iptypes.NewMulticastAddressUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"MulticastAddress ValueMulticastAddress Error",
+					"multicast address string value is unknown",
+				),
+			},
+		},
+		"valid IPv4 multicast address": {
+			addressValue:   iptypes.NewMulticastAddressValue("239.1.1.1"),
+			expectedIPAddr: netip.MustParseAddr("239.1.1.1"),
+		},
+		"valid IPv6 multicast address": {
+			addressValue:   iptypes.NewMulticastAddressValue("ff15::1"),
+			expectedIPAddr: netip.MustParseAddr("ff15::1"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ipAddr, diags := testCase.addressValue.ValueMulticastAddress()
+
+			if ipAddr != testCase.expectedIPAddr {
+				t.Errorf("Unexpected difference in netip.Addr, got: %s, expected: %s", ipAddr, testCase.expectedIPAddr)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestMulticastAddressValueMACAddress(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		addressValue       iptypes.MulticastAddress
+		expectedMACAddress hwtypes.MACAddress
+		expectedDiags      diag.Diagnostics
+	}{
+		"multicast address value is null": {
+			addressValue:       iptypes.NewMulticastAddressNull(),
+			expectedMACAddress: hwtypes.NewMACAddressNull(),
+		},
+		"multicast address value is unknown": {
+			addressValue:       iptypes.NewMulticastAddressUnknown(),
+			expectedMACAddress: hwtypes.NewMACAddressUnknown(),
+		},
+		"IPv4 all hosts": {
+			addressValue:       iptypes.NewMulticastAddressValue("224.0.0.1"),
+			expectedMACAddress: hwtypes.NewMACAddressValue("01:00:5e:00:00:01"),
+		},
+		"IPv4 high bit of second octet is not mapped": {
+			addressValue:       iptypes.NewMulticastAddressValue("239.129.1.1"),
+			expectedMACAddress: hwtypes.NewMACAddressValue("01:00:5e:01:01:01"),
+		},
+		"IPv6 all nodes": {
+			addressValue:       iptypes.NewMulticastAddressValue("ff02::1"),
+			expectedMACAddress: hwtypes.NewMACAddressValue("33:33:00:00:00:01"),
+		},
+		"IPv6 solicited-node": {
+			addressValue:       iptypes.NewMulticastAddressValue("ff02::1:ff00:5"),
+			expectedMACAddress: hwtypes.NewMACAddressValue("33:33:ff:00:00:05"),
+		},
+		"invalid - unicast address": {
+			addressValue:       iptypes.NewMulticastAddressValue("192.168.0.1"),
+			expectedMACAddress: hwtypes.NewMACAddressUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"MulticastAddress ValueMACAddress Error",
+					"address 192.168.0.1 is not in the IPv4 multicast range 224.0.0.0/4",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			macAddress, diags := testCase.addressValue.ValueMACAddress()
+
+			if !macAddress.Equal(testCase.expectedMACAddress) {
+				t.Errorf("Unexpected difference in MACAddress, got: %s, expected: %s", macAddress, testCase.expectedMACAddress)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,5 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package routingtypes contains Terraform Plugin Framework Custom Type implementations for routing protocol and overlay network identifiers.
+package routingtypes
@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package routingtypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = (*DottedQuadIDType)(nil)
+
+// DottedQuadIDType is an attribute type that represents a 32-bit identifier, such as an OSPF area ID or a router ID,
+// written either as an unsigned integer or in dotted-quad notation. Although the dotted-quad form resembles an IPv4
+// address, the value is an opaque identifier. Semantic equality logic is defined for DottedQuadIDType, so that both
+// notations of the same identifier are considered equal.
+//
+// Examples:
+//   - `0` is semantically equal to `0.0.0.0`
+//   - `10` is semantically equal to `0.0.0.10`
+//   - `4294967295` is semantically equal to `255.255.255.255`
+type DottedQuadIDType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t DottedQuadIDType) String() string {
+	return "routingtypes.DottedQuadIDType"
+}
+
+// ValueType returns the Value type.
+func (t DottedQuadIDType) ValueType(ctx context.Context) attr.Value {
+	return DottedQuadID{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t DottedQuadIDType) Equal(o attr.Type) bool {
+	other, ok := o.(DottedQuadIDType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t DottedQuadIDType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return DottedQuadID{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t DottedQuadIDType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
@@ -0,0 +1,229 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package routingtypes
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*DottedQuadID)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*DottedQuadID)(nil)
+	_ xattr.ValidateableAttribute                = (*DottedQuadID)(nil)
+	_ function.ValidateableParameter             = (*DottedQuadID)(nil)
+)
+
+// DottedQuadID represents a 32-bit identifier, such as an OSPF area ID or a router ID, written either as an unsigned
+// integer or in dotted-quad notation. Although the dotted-quad form resembles an IPv4 address, the value is an opaque
+// identifier. Semantic equality logic is defined for DottedQuadID, so that both notations of the same identifier are
+// considered equal.
+//
+// Examples:
+//   - `0` is semantically equal to `0.0.0.0`
+//   - `10` is semantically equal to `0.0.0.10`
+//   - `4294967295` is semantically equal to `255.255.255.255`
+type DottedQuadID struct {
+	basetypes.StringValue
+}
+
+// Type returns a DottedQuadIDType.
+func (v DottedQuadID) Type(_ context.Context) attr.Type {
+	return DottedQuadIDType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v DottedQuadID) Equal(o attr.Value) bool {
+	other, ok := o.(DottedQuadID)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given identifier string value is semantically equal to the current identifier string value.
+// Both values are converted to their 32-bit integer representation before comparison, so integer and dotted-quad notation
+// of the same identifier are considered equal.
+//
+// Examples:
+//   - `0` is semantically equal to `0.0.0.0`
+//   - `10` is semantically equal to `0.0.0.10`
+//   - `4294967295` is semantically equal to `255.255.255.255`
+func (v DottedQuadID) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(DottedQuadID)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// Identifiers are already validated at this point, ignoring errors
+	newID, _ := parseDottedQuadID(newValue.ValueString())
+	currentID, _ := parseDottedQuadID(v.ValueString())
+
+	return currentID == newID, diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String
+// value that is either an unsigned 32-bit integer or four dot-separated decimal octets. Leading zeroes will be rejected
+// as invalid in both forms, as the dotted-quad form is parsed with the Go `net/netip` library.
+func (v DottedQuadID) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseDottedQuadID(v.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Dotted-Quad Identifier String Value",
+			"A string value was provided that is not a valid 32-bit identifier in integer (e.g. 10) or dotted-quad (e.g. 0.0.0.10) format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is either an unsigned 32-bit integer or four dot-separated decimal octets.
+// Leading zeroes will be rejected as invalid in both forms, as the dotted-quad form is parsed with the Go `net/netip`
+// library.
+func (v DottedQuadID) ValidateParameter(_ context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseDottedQuadID(v.ValueString())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid Dotted-Quad Identifier String Value: "+
+				"A string value was provided that is not a valid 32-bit identifier in integer (e.g. 10) or dotted-quad (e.g. 0.0.0.10) format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueDottedQuadID returns the DottedQuadID StringValue as a 32-bit integer. A null or unknown value will produce an error diagnostic.
+func (v DottedQuadID) ValueDottedQuadID() (uint32, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("DottedQuadID ValueDottedQuadID Error", "dotted-quad identifier string value is null"))
+		return 0, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("DottedQuadID ValueDottedQuadID Error", "dotted-quad identifier string value is unknown"))
+		return 0, diags
+	}
+
+	id, err := parseDottedQuadID(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("DottedQuadID ValueDottedQuadID Error", err.Error()))
+		return 0, diags
+	}
+
+	return id, nil
+}
+
+// ValueDottedQuadString returns the DottedQuadID StringValue in dotted-quad notation, regardless of which notation the
+// value was written in. A null or unknown value will produce an error diagnostic.
+func (v DottedQuadID) ValueDottedQuadString() (string, diag.Diagnostics) {
+	id, diags := v.ValueDottedQuadID()
+	if diags.HasError() {
+		return "", diags
+	}
+
+	return FormatDottedQuad(id), nil
+}
+
+// FormatDottedQuad formats a 32-bit identifier in dotted-quad notation, such as `0.0.0.10` for 10.
+func FormatDottedQuad(id uint32) string {
+	var octets [4]byte
+
+	binary.BigEndian.PutUint32(octets[:], id)
+
+	return netip.AddrFrom4(octets).String()
+}
+
+// parseDottedQuadID parses a 32-bit identifier written either as an unsigned integer or in dotted-quad notation.
+// Errors intentionally avoid describing the value as an IP address.
+func parseDottedQuadID(s string) (uint32, error) {
+	if !strings.Contains(s, ".") {
+		// Leading zeroes are ambiguous, as inet_aton style parsers read `010` as octal 8.
+		if len(s) > 1 && s[0] == '0' {
+			return 0, fmt.Errorf("identifier %q: integer form must not have leading zeroes", s)
+		}
+
+		id, err := strconv.ParseUint(s, 10, 32)
+		if err != nil {
+			return 0, fmt.Errorf("identifier %q: integer form must be between 0 and 4294967295", s)
+		}
+
+		return uint32(id), nil
+	}
+
+	addr, err := netip.ParseAddr(s)
+	if err != nil || !addr.Is4() {
+		return 0, fmt.Errorf("identifier %q: dotted-quad form must be four decimal octets between 0 and 255 without leading zeroes", s)
+	}
+
+	octets := addr.As4()
+
+	return binary.BigEndian.Uint32(octets[:]), nil
+}
+
+// NewDottedQuadIDNull creates a DottedQuadID with a null value. Determine whether the value is null via IsNull method.
+func NewDottedQuadIDNull() DottedQuadID {
+	return DottedQuadID{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewDottedQuadIDUnknown creates a DottedQuadID with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewDottedQuadIDUnknown() DottedQuadID {
+	return DottedQuadID{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewDottedQuadIDValue creates a DottedQuadID with a known value. Access the value via ValueString method.
+func NewDottedQuadIDValue(value string) DottedQuadID {
+	return DottedQuadID{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewDottedQuadIDPointerValue creates a DottedQuadID with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewDottedQuadIDPointerValue(value *string) DottedQuadID {
+	return DottedQuadID{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package routingtypes_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/routingtypes"
+)
+
+type OSPFAreaResourceModel struct {
+	AreaID routingtypes.DottedQuadID `tfsdk:"area_id"`
+}
+
+func ExampleDottedQuadID_ValueDottedQuadID() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := OSPFAreaResourceModel{
+		AreaID: routingtypes.NewDottedQuadIDValue("10"),
+	}
+
+	// Check that the DottedQuadID data is known and able to be converted to uint32
+	if !data.AreaID.IsNull() && !data.AreaID.IsUnknown() {
+		areaID, diags := data.AreaID.ValueDottedQuadID()
+		if diags.HasError() {
+			return
+		}
+
+		// Output: 10, 0.0.0.10
+		fmt.Printf("%d, %s\n", areaID, routingtypes.FormatDottedQuad(areaID))
+	}
+}
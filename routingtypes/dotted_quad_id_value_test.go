@@ -0,0 +1,318 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package routingtypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/routingtypes"
+)
+
+func TestDottedQuadIDStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentID     routingtypes.DottedQuadID
+		givenID       basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"semantically equal - integer byte-for-byte match": {
+			currentID:     routingtypes.NewDottedQuadIDValue("10"),
+			givenID:       routingtypes.NewDottedQuadIDValue("10"),
+			expectedMatch: true,
+		},
+		"semantically equal - dotted-quad byte-for-byte match": {
+			currentID:     routingtypes.NewDottedQuadIDValue("192.0.2.1"),
+			givenID:       routingtypes.NewDottedQuadIDValue("192.0.2.1"),
+			expectedMatch: true,
+		},
+		"semantically equal - backbone area": {
+			currentID:     routingtypes.NewDottedQuadIDValue("0"),
+			givenID:       routingtypes.NewDottedQuadIDValue("0.0.0.0"),
+			expectedMatch: true,
+		},
+		"semantically equal - integer vs dotted-quad": {
+			currentID:     routingtypes.NewDottedQuadIDValue("0.0.0.10"),
+			givenID:       routingtypes.NewDottedQuadIDValue("10"),
+			expectedMatch: true,
+		},
+		"semantically equal - integer vs dotted-quad - multiple octets": {
+			currentID:     routingtypes.NewDottedQuadIDValue("256"),
+			givenID:       routingtypes.NewDottedQuadIDValue("0.0.1.0"),
+			expectedMatch: true,
+		},
+		"not equal - dotted-quad mismatch": {
+			currentID:     routingtypes.NewDottedQuadIDValue("0.0.0.10"),
+			givenID:       routingtypes.NewDottedQuadIDValue("10.0.0.0"),
+			expectedMatch: false,
+		},
+		"error - not given DottedQuadID value": {
+			currentID:     routingtypes.NewDottedQuadIDValue("0"),
+			givenID:       basetypes.NewStringValue("0.0.0.0"),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: routingtypes.DottedQuadID\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentID.StringSemanticEquals(context.Background(), testCase.givenID)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDottedQuadIDValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		id            routingtypes.DottedQuadID
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			id: routingtypes.DottedQuadID{},
+		},
+		"null": {
+			id: routingtypes.NewDottedQuadIDNull(),
+		},
+		"unknown": {
+			id: routingtypes.NewDottedQuadIDUnknown(),
+		},
+		"valid - integer": {
+			id: routingtypes.NewDottedQuadIDValue("10"),
+		},
+		"valid - integer zero": {
+			id: routingtypes.NewDottedQuadIDValue("0"),
+		},
+		"valid - integer maximum": {
+			id: routingtypes.NewDottedQuadIDValue("4294967295"),
+		},
+		"valid - dotted-quad": {
+			id: routingtypes.NewDottedQuadIDValue("0.0.0.10"),
+		},
+		"invalid - integer exceeds 32 bits": {
+			id: routingtypes.NewDottedQuadIDValue("4294967296"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Dotted-Quad Identifier String Value",
+					"A string value was provided that is not a valid 32-bit identifier in integer (e.g. 10) or dotted-quad (e.g. 0.0.0.10) format.\n\n"+
+						"Given Value: 4294967296\n"+
+						"Error: identifier \"4294967296\": integer form must be between 0 and 4294967295",
+				),
+			},
+		},
+		"invalid - negative integer": {
+			id: routingtypes.NewDottedQuadIDValue("-1"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Dotted-Quad Identifier String Value",
+					"A string value was provided that is not a valid 32-bit identifier in integer (e.g. 10) or dotted-quad (e.g. 0.0.0.10) format.\n\n"+
+						"Given Value: -1\n"+
+						"Error: identifier \"-1\": integer form must be between 0 and 4294967295",
+				),
+			},
+		},
+		"invalid - three octets": {
+			id: routingtypes.NewDottedQuadIDValue("0.0.10"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Dotted-Quad Identifier String Value",
+					"A string value was provided that is not a valid 32-bit identifier in integer (e.g. 10) or dotted-quad (e.g. 0.0.0.10) format.\n\n"+
+						"Given Value: 0.0.10\n"+
+						"Error: identifier \"0.0.10\": dotted-quad form must be four decimal octets between 0 and 255 without leading zeroes",
+				),
+			},
+		},
+		"invalid - integer leading zeroes": {
+			id: routingtypes.NewDottedQuadIDValue("010"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Dotted-Quad Identifier String Value",
+					"A string value was provided that is not a valid 32-bit identifier in integer (e.g. 10) or dotted-quad (e.g. 0.0.0.10) format.\n\n"+
+						"Given Value: 010\n"+
+						"Error: identifier \"010\": integer form must not have leading zeroes",
+				),
+			},
+		},
+		"invalid - leading zeroes": {
+			id: routingtypes.NewDottedQuadIDValue("0.0.0.010"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Dotted-Quad Identifier String Value",
+					"A string value was provided that is not a valid 32-bit identifier in integer (e.g. 10) or dotted-quad (e.g. 0.0.0.10) format.\n\n"+
+						"Given Value: 0.0.0.010\n"+
+						"Error: identifier \"0.0.0.010\": dotted-quad form must be four decimal octets between 0 and 255 without leading zeroes",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.id.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{Path: path.Root("test")},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDottedQuadIDValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		id              routingtypes.DottedQuadID
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			id: routingtypes.DottedQuadID{},
+		},
+		"null": {
+			id: routingtypes.NewDottedQuadIDNull(),
+		},
+		"unknown": {
+			id: routingtypes.NewDottedQuadIDUnknown(),
+		},
+		"valid - integer": {
+			id: routingtypes.NewDottedQuadIDValue("0"),
+		},
+		"valid - dotted-quad": {
+			id: routingtypes.NewDottedQuadIDValue("192.0.2.1"),
+		},
+		"invalid - IPv6 notation": {
+			id: routingtypes.NewDottedQuadIDValue("::ffff:0.0.0.10"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid Dotted-Quad Identifier String Value: "+
+					"A string value was provided that is not a valid 32-bit identifier in integer (e.g. 10) or dotted-quad (e.g. 0.0.0.10) format.\n\n"+
+					"Given Value: ::ffff:0.0.0.10\n"+
+					"Error: identifier \"::ffff:0.0.0.10\": dotted-quad form must be four decimal octets between 0 and 255 without leading zeroes",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.id.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDottedQuadIDValueDottedQuadID(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		id                 routingtypes.DottedQuadID
+		expectedID         uint32
+		expectedDottedQuad string
+		expectedDiags      diag.Diagnostics
+	}{
+		"value is null": {
+			id: routingtypes.NewDottedQuadIDNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"DottedQuadID ValueDottedQuadID Error",
+					"dotted-quad identifier string value is null",
+				),
+			},
+		},
+		"value is unknown": {
+			id: routingtypes.NewDottedQuadIDUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"DottedQuadID ValueDottedQuadID Error",
+					"dotted-quad identifier string value is unknown",
+				),
+			},
+		},
+		"valid - integer": {
+			id:                 routingtypes.NewDottedQuadIDValue("10"),
+			expectedID:         10,
+			expectedDottedQuad: "0.0.0.10",
+		},
+		"valid - dotted-quad": {
+			id:                 routingtypes.NewDottedQuadIDValue("192.0.2.1"),
+			expectedID:         3221225985,
+			expectedDottedQuad: "192.0.2.1",
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			id, diags := testCase.id.ValueDottedQuadID()
+
+			if id != testCase.expectedID {
+				t.Errorf("Unexpected difference in identifier, got: %d, expected: %d", id, testCase.expectedID)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+
+			dottedQuad, diags := testCase.id.ValueDottedQuadString()
+
+			if dottedQuad != testCase.expectedDottedQuad {
+				t.Errorf("Unexpected difference in dotted-quad string, got: %s, expected: %s", dottedQuad, testCase.expectedDottedQuad)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
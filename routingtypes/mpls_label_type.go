@@ -0,0 +1,81 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package routingtypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.Int64Typable = (*MPLSLabelType)(nil)
+
+// MPLSLabelType is an attribute type that represents a valid 20-bit MPLS label (RFC 3032), between 0 and 1048575.
+// Labels 0 through 15 are reserved for special purposes (RFC 7274) and produce a warning diagnostic during
+// attribute validation, or an error when RejectReserved is enabled. No semantic equality logic is defined for
+// MPLSLabelType, so it will follow Terraform's data-consistency rules for numbers.
+type MPLSLabelType struct {
+	basetypes.Int64Type
+
+	// RejectReserved causes the special-purpose labels 0 through 15 to be rejected as invalid, rather than
+	// producing a warning diagnostic.
+	RejectReserved bool
+}
+
+// String returns a human readable string of the type name.
+func (t MPLSLabelType) String() string {
+	return "routingtypes.MPLSLabelType"
+}
+
+// ValueType returns the Value type.
+func (t MPLSLabelType) ValueType(ctx context.Context) attr.Value {
+	return MPLSLabel{
+		rejectReserved: t.RejectReserved,
+	}
+}
+
+// Equal returns true if the given type is equivalent. RejectReserved is not compared, as it only applies to validation,
+// so that values created by provider logic are compatible with any MPLSLabelType.
+func (t MPLSLabelType) Equal(o attr.Type) bool {
+	other, ok := o.(MPLSLabelType)
+
+	if !ok {
+		return false
+	}
+
+	return t.Int64Type.Equal(other.Int64Type)
+}
+
+// ValueFromInt64 returns an Int64Valuable type given an Int64Value.
+func (t MPLSLabelType) ValueFromInt64(_ context.Context, in basetypes.Int64Value) (basetypes.Int64Valuable, diag.Diagnostics) {
+	return MPLSLabel{
+		Int64Value:     in,
+		rejectReserved: t.RejectReserved,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t MPLSLabelType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.Int64Type.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	int64Value, ok := attrValue.(basetypes.Int64Value)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	int64Valuable, diags := t.ValueFromInt64(ctx, int64Value)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting Int64Value to Int64Valuable: %v", diags)
+	}
+
+	return int64Valuable, nil
+}
@@ -0,0 +1,120 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package routingtypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/routingtypes"
+)
+
+func TestMPLSLabelTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in          tftypes.Value
+		expectation attr.Value
+		expectedErr string
+	}{
+		"valid": {
+			in:          tftypes.NewValue(tftypes.Number, 100016),
+			expectation: routingtypes.NewMPLSLabelValue(100016),
+		},
+		"unknown": {
+			in:          tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+			expectation: routingtypes.NewMPLSLabelUnknown(),
+		},
+		"null": {
+			in:          tftypes.NewValue(tftypes.Number, nil),
+			expectation: routingtypes.NewMPLSLabelNull(),
+		},
+		"wrongType": {
+			in:          tftypes.NewValue(tftypes.String, "100016"),
+			expectedErr: "can't unmarshal tftypes.String into *big.Float, expected *big.Float",
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			got, err := routingtypes.MPLSLabelType{}.ValueFromTerraform(ctx, testCase.in)
+			if err != nil {
+				if testCase.expectedErr == "" {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+				if testCase.expectedErr != err.Error() {
+					t.Fatalf("Expected error to be %q, got %q", testCase.expectedErr, err.Error())
+				}
+				return
+			}
+			if testCase.expectedErr != "" {
+				t.Fatalf("Expected error to be %q, didn't get an error", testCase.expectedErr)
+			}
+			if !got.Equal(testCase.expectation) {
+				t.Errorf("Expected %+v, got %+v", testCase.expectation, got)
+			}
+			if testCase.expectation.IsNull() != testCase.in.IsNull() {
+				t.Errorf("Expected null-ness match: expected %t, got %t", testCase.expectation.IsNull(), testCase.in.IsNull())
+			}
+			if testCase.expectation.IsUnknown() != !testCase.in.IsKnown() {
+				t.Errorf("Expected unknown-ness match: expected %t, got %t", testCase.expectation.IsUnknown(), !testCase.in.IsKnown())
+			}
+		})
+	}
+}
+
+func TestMPLSLabelTypeRejectReserved(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	labelType := routingtypes.MPLSLabelType{RejectReserved: true}
+
+	got, err := labelType.ValueFromTerraform(ctx, tftypes.NewValue(tftypes.Number, 3))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	// RejectReserved is carried by values of the type
+	if diff := cmp.Diff(got.Type(ctx), labelType); diff != "" {
+		t.Errorf("Unexpected type (-got, +expected): %s", diff)
+	}
+
+	// RejectReserved does not affect type equality
+	if !labelType.Equal(routingtypes.MPLSLabelType{}) {
+		t.Errorf("Expected %s to equal MPLSLabelType without RejectReserved", labelType)
+	}
+}
+
+func TestMPLSLabelTypeRejectReservedListElement(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	labelType := routingtypes.MPLSLabelType{RejectReserved: true}
+
+	// Values created by provider logic must be accepted as elements of a restricted element type
+	_, diags := basetypes.NewListValue(labelType, []attr.Value{
+		routingtypes.NewMPLSLabelValue(16),
+		routingtypes.NewMPLSLabelValue(1048575),
+	})
+
+	if diff := cmp.Diff(diags, diag.Diagnostics(nil)); diff != "" {
+		t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+	}
+
+	_, diags = basetypes.NewSetValueFrom(ctx, labelType, []routingtypes.MPLSLabel{
+		routingtypes.NewMPLSLabelValue(16),
+	})
+
+	if diff := cmp.Diff(diags, diag.Diagnostics(nil)); diff != "" {
+		t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package routingtypes
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.Int64Valuable        = (*MPLSLabel)(nil)
+	_ xattr.ValidateableAttribute    = (*MPLSLabel)(nil)
+	_ function.ValidateableParameter = (*MPLSLabel)(nil)
+)
+
+const (
+	// maxMPLSLabel is the largest value representable by the 20-bit label field of an MPLS label stack entry.
+	maxMPLSLabel = 1<<20 - 1
+
+	// maxReservedMPLSLabel is the largest of the special-purpose labels reserved by RFC 3032 and RFC 7274.
+	maxReservedMPLSLabel = 15
+)
+
+// MPLSLabel represents a valid 20-bit MPLS label (RFC 3032), between 0 and 1048575. Labels 0 through 15 are
+// reserved for special purposes (RFC 7274) and produce a warning diagnostic during attribute validation, or an
+// error when the RejectReserved field of MPLSLabelType is enabled. No semantic equality logic is defined for
+// MPLSLabel, so it will follow Terraform's data-consistency rules for numbers.
+type MPLSLabel struct {
+	basetypes.Int64Value
+
+	// rejectReserved is populated from MPLSLabelType so validation can honor the schema configuration.
+	rejectReserved bool
+}
+
+// Type returns an MPLSLabelType.
+func (v MPLSLabel) Type(_ context.Context) attr.Type {
+	return MPLSLabelType{
+		RejectReserved: v.rejectReserved,
+	}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v MPLSLabel) Equal(o attr.Value) bool {
+	other, ok := o.(MPLSLabel)
+
+	if !ok {
+		return false
+	}
+
+	return v.Int64Value.Equal(other.Int64Value)
+}
+
+// IsReserved returns true if the label is one of the special-purpose labels 0 through 15.
+func (v MPLSLabel) IsReserved() bool {
+	if v.IsUnknown() || v.IsNull() {
+		return false
+	}
+
+	return v.ValueInt64() >= 0 && v.ValueInt64() <= maxReservedMPLSLabel
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be an Int64
+// value that fits within the 20-bit MPLS label field. Reserved labels 0 through 15 produce a warning diagnostic,
+// or an error diagnostic if the RejectReserved field of MPLSLabelType is enabled.
+func (v MPLSLabel) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if v.ValueInt64() < 0 || v.ValueInt64() > maxMPLSLabel {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid MPLS Label Integer Value",
+			"An integer value was provided that is not a valid MPLS label, which must be between 0 and 1048575 (20 bits).\n\n"+
+				"Given Value: "+strconv.FormatInt(v.ValueInt64(), 10)+"\n",
+		)
+
+		return
+	}
+
+	if v.IsReserved() && v.rejectReserved {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid MPLS Label Integer Value",
+			"An integer value was provided that is a reserved special-purpose MPLS label (0-15), which is not permitted.\n\n"+
+				"Given Value: "+strconv.FormatInt(v.ValueInt64(), 10)+"\n",
+		)
+
+		return
+	}
+
+	if v.IsReserved() {
+		resp.Diagnostics.AddAttributeWarning(
+			req.Path,
+			"Reserved MPLS Label Integer Value",
+			"An integer value was provided that is a reserved special-purpose MPLS label (0-15). "+
+				"These labels have a fixed meaning in the forwarding plane, such as explicit or implicit null, and are not typically assigned by configuration.\n\n"+
+				"Given Value: "+strconv.FormatInt(v.ValueInt64(), 10)+"\n",
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be an Int64 value that fits within the 20-bit MPLS label field. Reserved labels 0 through 15 are
+// rejected if the RejectReserved field of MPLSLabelType is enabled.
+func (v MPLSLabel) ValidateParameter(_ context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if v.ValueInt64() < 0 || v.ValueInt64() > maxMPLSLabel {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid MPLS Label Integer Value: "+
+				"An integer value was provided that is not a valid MPLS label, which must be between 0 and 1048575 (20 bits).\n\n"+
+				"Given Value: "+strconv.FormatInt(v.ValueInt64(), 10)+"\n",
+		)
+
+		return
+	}
+
+	if v.IsReserved() && v.rejectReserved {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid MPLS Label Integer Value: "+
+				"An integer value was provided that is a reserved special-purpose MPLS label (0-15), which is not permitted.\n\n"+
+				"Given Value: "+strconv.FormatInt(v.ValueInt64(), 10)+"\n",
+		)
+
+		return
+	}
+}
+
+// ValueMPLSLabel returns the MPLSLabel Int64Value as a uint32. A null, unknown or out of range value will produce an error diagnostic.
+func (v MPLSLabel) ValueMPLSLabel() (uint32, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("MPLSLabel ValueMPLSLabel Error", "MPLS label integer value is null"))
+		return 0, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("MPLSLabel ValueMPLSLabel Error", "MPLS label integer value is unknown"))
+		return 0, diags
+	}
+
+	if v.ValueInt64() < 0 || v.ValueInt64() > maxMPLSLabel {
+		diags.Append(diag.NewErrorDiagnostic("MPLSLabel ValueMPLSLabel Error", "MPLS label integer value "+strconv.FormatInt(v.ValueInt64(), 10)+" is out of range"))
+		return 0, diags
+	}
+
+	return uint32(v.ValueInt64()), nil
+}
+
+// NewMPLSLabelNull creates an MPLSLabel with a null value. Determine whether the value is null via IsNull method.
+func NewMPLSLabelNull() MPLSLabel {
+	return MPLSLabel{
+		Int64Value: basetypes.NewInt64Null(),
+	}
+}
+
+// NewMPLSLabelUnknown creates an MPLSLabel with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewMPLSLabelUnknown() MPLSLabel {
+	return MPLSLabel{
+		Int64Value: basetypes.NewInt64Unknown(),
+	}
+}
+
+// NewMPLSLabelValue creates an MPLSLabel with a known value. Access the value via ValueInt64 method.
+func NewMPLSLabelValue(value int64) MPLSLabel {
+	return MPLSLabel{
+		Int64Value: basetypes.NewInt64Value(value),
+	}
+}
+
+// NewMPLSLabelPointerValue creates an MPLSLabel with a null value if nil or a known value. Access the value via ValueInt64Pointer method.
+func NewMPLSLabelPointerValue(value *int64) MPLSLabel {
+	return MPLSLabel{
+		Int64Value: basetypes.NewInt64PointerValue(value),
+	}
+}
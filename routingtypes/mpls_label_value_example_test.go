@@ -0,0 +1,33 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package routingtypes_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/routingtypes"
+)
+
+type MPLSLabelResourceModel struct {
+	MPLSLabel routingtypes.MPLSLabel `tfsdk:"mpls_label"`
+}
+
+func ExampleMPLSLabel_ValueMPLSLabel() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := MPLSLabelResourceModel{
+		MPLSLabel: routingtypes.NewMPLSLabelValue(100016),
+	}
+
+	// Check that the MPLSLabel data is known and able to be converted to uint32
+	if !data.MPLSLabel.IsNull() && !data.MPLSLabel.IsUnknown() {
+		label, diags := data.MPLSLabel.ValueMPLSLabel()
+		if diags.HasError() {
+			return
+		}
+
+		// Output: 100016, false
+		fmt.Printf("%d, %t\n", label, data.MPLSLabel.IsReserved())
+	}
+}
@@ -0,0 +1,247 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package routingtypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/routingtypes"
+)
+
+// newRejectReservedMPLSLabelValue creates an MPLSLabel with RejectReserved enabled, as Plugin Framework would when
+// populating a data model.
+func newRejectReservedMPLSLabelValue(t *testing.T, value int64) routingtypes.MPLSLabel {
+	t.Helper()
+
+	valuable, diags := routingtypes.MPLSLabelType{RejectReserved: true}.ValueFromInt64(context.Background(), basetypes.NewInt64Value(value))
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics creating MPLSLabel %d: %v", value, diags)
+	}
+
+	label, ok := valuable.(routingtypes.MPLSLabel)
+	if !ok {
+		t.Fatalf("Unexpected value type %T creating MPLSLabel %d", valuable, value)
+	}
+
+	return label
+}
+
+func TestMPLSLabelValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		label         routingtypes.MPLSLabel
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			label: routingtypes.MPLSLabel{},
+		},
+		"null": {
+			label: routingtypes.NewMPLSLabelNull(),
+		},
+		"unknown": {
+			label: routingtypes.NewMPLSLabelUnknown(),
+		},
+		"valid MPLS label - first unreserved": {
+			label: routingtypes.NewMPLSLabelValue(16),
+		},
+		"valid MPLS label - maximum": {
+			label: routingtypes.NewMPLSLabelValue(1048575),
+		},
+		"valid MPLS label - reject reserved - unreserved": {
+			label: newRejectReservedMPLSLabelValue(t, 16),
+		},
+		"reserved MPLS label - warning": {
+			label: routingtypes.NewMPLSLabelValue(3),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(
+					path.Root("test"),
+					"Reserved MPLS Label Integer Value",
+					"An integer value was provided that is a reserved special-purpose MPLS label (0-15). "+
+						"These labels have a fixed meaning in the forwarding plane, such as explicit or implicit null, and are not typically assigned by configuration.\n\n"+
+						"Given Value: 3\n",
+				),
+			},
+		},
+		"reserved MPLS label - reject reserved": {
+			label: newRejectReservedMPLSLabelValue(t, 15),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid MPLS Label Integer Value",
+					"An integer value was provided that is a reserved special-purpose MPLS label (0-15), which is not permitted.\n\n"+
+						"Given Value: 15\n",
+				),
+			},
+		},
+		"invalid MPLS label - negative": {
+			label: routingtypes.NewMPLSLabelValue(-1),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid MPLS Label Integer Value",
+					"An integer value was provided that is not a valid MPLS label, which must be between 0 and 1048575 (20 bits).\n\n"+
+						"Given Value: -1\n",
+				),
+			},
+		},
+		"invalid MPLS label - exceeds 20 bits": {
+			label: routingtypes.NewMPLSLabelValue(1048576),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid MPLS Label Integer Value",
+					"An integer value was provided that is not a valid MPLS label, which must be between 0 and 1048575 (20 bits).\n\n"+
+						"Given Value: 1048576\n",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.label.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{Path: path.Root("test")},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestMPLSLabelValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		label           routingtypes.MPLSLabel
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			label: routingtypes.MPLSLabel{},
+		},
+		"null": {
+			label: routingtypes.NewMPLSLabelNull(),
+		},
+		"unknown": {
+			label: routingtypes.NewMPLSLabelUnknown(),
+		},
+		"valid MPLS label": {
+			label: routingtypes.NewMPLSLabelValue(100016),
+		},
+		"reserved MPLS label - allowed": {
+			label: routingtypes.NewMPLSLabelValue(0),
+		},
+		"reserved MPLS label - reject reserved": {
+			label: newRejectReservedMPLSLabelValue(t, 0),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid MPLS Label Integer Value: "+
+					"An integer value was provided that is a reserved special-purpose MPLS label (0-15), which is not permitted.\n\n"+
+					"Given Value: 0\n",
+			),
+		},
+		"invalid MPLS label - exceeds 20 bits": {
+			label: routingtypes.NewMPLSLabelValue(1048576),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid MPLS Label Integer Value: "+
+					"An integer value was provided that is not a valid MPLS label, which must be between 0 and 1048575 (20 bits).\n\n"+
+					"Given Value: 1048576\n",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.label.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestMPLSLabelValueMPLSLabel(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		label         routingtypes.MPLSLabel
+		expectedLabel uint32
+		expectedDiags diag.Diagnostics
+	}{
+		"MPLS label value is null": {
+			label: routingtypes.NewMPLSLabelNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"MPLSLabel ValueMPLSLabel Error",
+					"MPLS label integer value is null",
+				),
+			},
+		},
+		"MPLS label value is unknown": {
+			label: routingtypes.NewMPLSLabelUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"MPLSLabel ValueMPLSLabel Error",
+					"MPLS label integer value is unknown",
+				),
+			},
+		},
+		"MPLS label value is out of range": {
+			label: routingtypes.NewMPLSLabelValue(1048576),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"MPLSLabel ValueMPLSLabel Error",
+					"MPLS label integer value 1048576 is out of range",
+				),
+			},
+		},
+		"valid MPLS label": {
+			label:         routingtypes.NewMPLSLabelValue(100016),
+			expectedLabel: 100016,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			label, diags := testCase.label.ValueMPLSLabel()
+
+			if label != testCase.expectedLabel {
+				t.Errorf("Unexpected difference in MPLS label, got: %d, expected: %d", label, testCase.expectedLabel)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
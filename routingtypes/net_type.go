@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package routingtypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = (*NETType)(nil)
+
+// NETType is an attribute type that represents a valid IS-IS Network Entity Title (ISO/IEC 10589), which is an NSAP
+// address made up of a one octet AFI, an area of up to 12 octets, a six octet system ID and an NSEL of 00. Semantic
+// equality logic is defined for NETType, so that titles expressed with varying case and dot placement are considered equal.
+//
+// All of the following are semantically equal:
+//   - 49.0001.abcd.ef00.1001.00
+//   - 49.0001.ABCD.EF00.1001.00
+//   - 49.0001.abcdef001001.00
+//   - 490001.abcd.ef00.1001.00
+type NETType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t NETType) String() string {
+	return "routingtypes.NETType"
+}
+
+// ValueType returns the Value type.
+func (t NETType) ValueType(ctx context.Context) attr.Value {
+	return NET{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t NETType) Equal(o attr.Type) bool {
+	other, ok := o.(NETType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t NETType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return NET{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t NETType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
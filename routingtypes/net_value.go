@@ -0,0 +1,279 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package routingtypes
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/iptypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*NET)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*NET)(nil)
+	_ xattr.ValidateableAttribute                = (*NET)(nil)
+	_ function.ValidateableParameter             = (*NET)(nil)
+)
+
+const (
+	// netSystemIDLength is the length in octets of an IS-IS system ID.
+	netSystemIDLength = 6
+
+	// netMinLength is the length in octets of a NET with an AFI, no area, a system ID and an NSEL.
+	netMinLength = 1 + netSystemIDLength + 1
+
+	// netMaxLength is the length in octets of a NET with an AFI, a 12 octet area, a system ID and an NSEL.
+	netMaxLength = 1 + 12 + netSystemIDLength + 1
+)
+
+// NET represents a valid IS-IS Network Entity Title (ISO/IEC 10589), which is an NSAP address made up of a one octet
+// AFI, an area of up to 12 octets, a six octet system ID and an NSEL of 00. The title is written as hexadecimal digits,
+// optionally separated by dots on octet boundaries. Semantic equality logic is defined for NET, so that titles expressed
+// with varying case and dot placement are considered equal.
+//
+// All of the following are semantically equal:
+//   - 49.0001.abcd.ef00.1001.00
+//   - 49.0001.ABCD.EF00.1001.00
+//   - 49.0001.abcdef001001.00
+//   - 490001.abcd.ef00.1001.00
+type NET struct {
+	basetypes.StringValue
+}
+
+// Type returns a NETType.
+func (v NET) Type(_ context.Context) attr.Type {
+	return NETType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v NET) Equal(o attr.Value) bool {
+	other, ok := o.(NET)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given NET string value is semantically equal to the current NET string value.
+// Both values are decoded into their octets before comparison, so titles expressed with varying case and dot placement
+// are considered equal.
+//
+// All of the following are semantically equal:
+//   - 49.0001.abcd.ef00.1001.00
+//   - 49.0001.ABCD.EF00.1001.00
+//   - 49.0001.abcdef001001.00
+//   - 490001.abcd.ef00.1001.00
+func (v NET) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(NET)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// NETs are already validated at this point, ignoring errors
+	newNET, _ := parseNET(newValue.ValueString())
+	currentNET, _ := parseNET(v.ValueString())
+
+	return bytes.Equal(currentNET, newNET), diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String
+// value that is a valid IS-IS Network Entity Title with an NSEL of 00.
+func (v NET) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseNET(v.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid NET String Value",
+			"A string value was provided that is not valid IS-IS Network Entity Title string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is a valid IS-IS Network Entity Title with an NSEL of 00.
+func (v NET) ValidateParameter(_ context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseNET(v.ValueString())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid NET String Value: "+
+				"A string value was provided that is not valid IS-IS Network Entity Title string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueArea returns the area address of the NET, which is the AFI followed by the area, such as `49.0001` for
+// `49.0001.1921.6800.1001.00`. A null or unknown value will produce an error diagnostic.
+func (v NET) ValueArea() (string, diag.Diagnostics) {
+	netOctets, diags := v.valueNET("ValueArea")
+	if diags.HasError() {
+		return "", diags
+	}
+
+	area := netOctets[1 : len(netOctets)-netSystemIDLength-1]
+	if len(area) == 0 {
+		return hex.EncodeToString(netOctets[:1]), nil
+	}
+
+	return hex.EncodeToString(netOctets[:1]) + "." + formatNETGroups(area), nil
+}
+
+// ValueSystemID returns the system ID of the NET in the conventional dotted format, such as `1921.6800.1001` for
+// `49.0001.1921.6800.1001.00`. A null or unknown value will produce an error diagnostic.
+func (v NET) ValueSystemID() (string, diag.Diagnostics) {
+	netOctets, diags := v.valueNET("ValueSystemID")
+	if diags.HasError() {
+		return "", diags
+	}
+
+	return formatNETGroups(netOctets[len(netOctets)-netSystemIDLength-1 : len(netOctets)-1]), nil
+}
+
+// valueNET parses the NET StringValue into octets, producing error diagnostics attributed to the given accessor method.
+func (v NET) valueNET(method string) ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("NET "+method+" Error", "NET string value is null"))
+		return nil, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("NET "+method+" Error", "NET string value is unknown"))
+		return nil, diags
+	}
+
+	netOctets, err := parseNET(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("NET "+method+" Error", err.Error()))
+		return nil, diags
+	}
+
+	return netOctets, nil
+}
+
+// SystemIDFromIPv4Address derives an IS-IS system ID from an IPv4 address the way operators commonly do, by zero
+// padding each octet to three decimal digits and regrouping the resulting twelve digits, such that `192.168.1.1`
+// becomes `1921.6800.1001`. A null, unknown or non-IPv4 value will produce an error diagnostic.
+func SystemIDFromIPv4Address(address iptypes.IPv4Address) (string, diag.Diagnostics) {
+	ipAddr, diags := address.ValueIPv4Address()
+	if diags.HasError() {
+		return "", diags
+	}
+
+	if !ipAddr.Is4() {
+		diags.Append(diag.NewErrorDiagnostic("SystemIDFromIPv4Address Error", "address "+ipAddr.String()+" is not an IPv4 address"))
+		return "", diags
+	}
+
+	octets := ipAddr.As4()
+	digits := fmt.Sprintf("%03d%03d%03d%03d", octets[0], octets[1], octets[2], octets[3])
+
+	return digits[0:4] + "." + digits[4:8] + "." + digits[8:12], nil
+}
+
+// parseNET decodes a NET string into its octets, validating the overall length and the NSEL. Dots are permitted
+// between any two octets.
+func parseNET(s string) ([]byte, error) {
+	groups := strings.Split(s, ".")
+
+	for _, group := range groups {
+		if group == "" || len(group)%2 != 0 {
+			return nil, fmt.Errorf("NET %q: dot-separated groups must contain a non-zero, even number of hexadecimal digits", s)
+		}
+	}
+
+	netOctets, err := hex.DecodeString(strings.Join(groups, ""))
+	if err != nil {
+		return nil, fmt.Errorf("NET %q: invalid hexadecimal digits", s)
+	}
+
+	if len(netOctets) < netMinLength || len(netOctets) > netMaxLength {
+		return nil, fmt.Errorf("NET %q: must be between %d and %d octets (1 octet AFI, 0-12 octet area, 6 octet system ID, 1 octet NSEL), got %d", s, netMinLength, netMaxLength, len(netOctets))
+	}
+
+	if nsel := netOctets[len(netOctets)-1]; nsel != 0 {
+		return nil, fmt.Errorf("NET %q: NSEL must be 00, got %02x", s, nsel)
+	}
+
+	return netOctets, nil
+}
+
+// formatNETGroups formats octets as lowercase hexadecimal in dot-separated groups of two octets, with a trailing
+// single octet group if necessary.
+func formatNETGroups(octets []byte) string {
+	var groups []string
+
+	for i := 0; i < len(octets); i += 2 {
+		groups = append(groups, hex.EncodeToString(octets[i:min(i+2, len(octets))]))
+	}
+
+	return strings.Join(groups, ".")
+}
+
+// NewNETNull creates a NET with a null value. Determine whether the value is null via IsNull method.
+func NewNETNull() NET {
+	return NET{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewNETUnknown creates a NET with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewNETUnknown() NET {
+	return NET{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewNETValue creates a NET with a known value. Access the value via ValueString method.
+func NewNETValue(value string) NET {
+	return NET{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewNETPointerValue creates a NET with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewNETPointerValue(value *string) NET {
+	return NET{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package routingtypes_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/iptypes"
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/routingtypes"
+)
+
+type ISISInstanceResourceModel struct {
+	NET routingtypes.NET `tfsdk:"net"`
+}
+
+func ExampleNET_ValueSystemID() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := ISISInstanceResourceModel{
+		NET: routingtypes.NewNETValue("49.0001.192168001001.00"),
+	}
+
+	// Check that the NET data is known and able to be split into area and system ID
+	if !data.NET.IsNull() && !data.NET.IsUnknown() {
+		area, diags := data.NET.ValueArea()
+		if diags.HasError() {
+			return
+		}
+
+		systemID, diags := data.NET.ValueSystemID()
+		if diags.HasError() {
+			return
+		}
+
+		// Output: 49.0001, 1921.6800.1001
+		fmt.Printf("%s, %s\n", area, systemID)
+	}
+}
+
+func ExampleSystemIDFromIPv4Address() {
+	loopback := iptypes.NewIPv4AddressValue("192.168.1.1")
+
+	systemID, diags := routingtypes.SystemIDFromIPv4Address(loopback)
+	if diags.HasError() {
+		return
+	}
+
+	// Output: 49.0001.1921.6800.1001.00
+	fmt.Printf("49.0001.%s.00\n", systemID)
+}
@@ -0,0 +1,405 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package routingtypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/iptypes"
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/routingtypes"
+)
+
+func TestNETStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentNET    routingtypes.NET
+		givenNET      basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"semantically equal - byte-for-byte match": {
+			currentNET:    routingtypes.NewNETValue("49.0001.1921.6800.1001.00"),
+			givenNET:      routingtypes.NewNETValue("49.0001.1921.6800.1001.00"),
+			expectedMatch: true,
+		},
+		"semantically equal - case insensitive": {
+			currentNET:    routingtypes.NewNETValue("49.0001.abcd.ef00.1001.00"),
+			givenNET:      routingtypes.NewNETValue("49.0001.ABCD.EF00.1001.00"),
+			expectedMatch: true,
+		},
+		"semantically equal - system ID not grouped": {
+			currentNET:    routingtypes.NewNETValue("49.0001.1921.6800.1001.00"),
+			givenNET:      routingtypes.NewNETValue("49.0001.192168001001.00"),
+			expectedMatch: true,
+		},
+		"semantically equal - AFI and area grouped": {
+			currentNET:    routingtypes.NewNETValue("49.0001.1921.6800.1001.00"),
+			givenNET:      routingtypes.NewNETValue("490001.1921.6800.1001.00"),
+			expectedMatch: true,
+		},
+		"semantically equal - no dots": {
+			currentNET:    routingtypes.NewNETValue("49.0001.1921.6800.1001.00"),
+			givenNET:      routingtypes.NewNETValue("49000119216800100100"),
+			expectedMatch: true,
+		},
+		"not equal - area mismatch": {
+			currentNET:    routingtypes.NewNETValue("49.0001.1921.6800.1001.00"),
+			givenNET:      routingtypes.NewNETValue("49.0002.1921.6800.1001.00"),
+			expectedMatch: false,
+		},
+		"not equal - area length mismatch": {
+			currentNET:    routingtypes.NewNETValue("49.0001.1921.6800.1001.00"),
+			givenNET:      routingtypes.NewNETValue("49.000001.1921.6800.1001.00"),
+			expectedMatch: false,
+		},
+		"error - not given NET value": {
+			currentNET:    routingtypes.NewNETValue("49.0001.1921.6800.1001.00"),
+			givenNET:      basetypes.NewStringValue("49.0001.1921.6800.1001.00"),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: routingtypes.NET\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentNET.StringSemanticEquals(context.Background(), testCase.givenNET)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNETValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		net           routingtypes.NET
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			net: routingtypes.NET{},
+		},
+		"null": {
+			net: routingtypes.NewNETNull(),
+		},
+		"unknown": {
+			net: routingtypes.NewNETUnknown(),
+		},
+		"valid NET - private AFI": {
+			net: routingtypes.NewNETValue("49.0001.1921.6800.1001.00"),
+		},
+		"valid NET - no area": {
+			net: routingtypes.NewNETValue("49.1921.6800.1001.00"),
+		},
+		"valid NET - maximum area": {
+			net: routingtypes.NewNETValue("39.0102.0304.0506.0708.090a.0b0c.1921.6800.1001.00"),
+		},
+		"invalid NET - odd group": {
+			net: routingtypes.NewNETValue("49.001.1921.6800.1001.00"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid NET String Value",
+					"A string value was provided that is not valid IS-IS Network Entity Title string format.\n\n"+
+						"Given Value: 49.001.1921.6800.1001.00\n"+
+						"Error: NET \"49.001.1921.6800.1001.00\": dot-separated groups must contain a non-zero, even number of hexadecimal digits",
+				),
+			},
+		},
+		"invalid NET - bogus digit": {
+			net: routingtypes.NewNETValue("49.0001.1921.6800.100g.00"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid NET String Value",
+					"A string value was provided that is not valid IS-IS Network Entity Title string format.\n\n"+
+						"Given Value: 49.0001.1921.6800.100g.00\n"+
+						"Error: NET \"49.0001.1921.6800.100g.00\": invalid hexadecimal digits",
+				),
+			},
+		},
+		"invalid NET - too short": {
+			net: routingtypes.NewNETValue("49.6800.1001.00"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid NET String Value",
+					"A string value was provided that is not valid IS-IS Network Entity Title string format.\n\n"+
+						"Given Value: 49.6800.1001.00\n"+
+						"Error: NET \"49.6800.1001.00\": must be between 8 and 20 octets (1 octet AFI, 0-12 octet area, 6 octet system ID, 1 octet NSEL), got 6",
+				),
+			},
+		},
+		"invalid NET - non-zero NSEL": {
+			net: routingtypes.NewNETValue("49.0001.1921.6800.1001.01"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid NET String Value",
+					"A string value was provided that is not valid IS-IS Network Entity Title string format.\n\n"+
+						"Given Value: 49.0001.1921.6800.1001.01\n"+
+						"Error: NET \"49.0001.1921.6800.1001.01\": NSEL must be 00, got 01",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.net.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{Path: path.Root("test")},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNETValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		net             routingtypes.NET
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			net: routingtypes.NET{},
+		},
+		"null": {
+			net: routingtypes.NewNETNull(),
+		},
+		"unknown": {
+			net: routingtypes.NewNETUnknown(),
+		},
+		"valid NET": {
+			net: routingtypes.NewNETValue("49.0001.1921.6800.1001.00"),
+		},
+		"invalid NET - too long": {
+			net: routingtypes.NewNETValue("39.0102.0304.0506.0708.090a.0b0c0d.1921.6800.1001.00"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid NET String Value: "+
+					"A string value was provided that is not valid IS-IS Network Entity Title string format.\n\n"+
+					"Given Value: 39.0102.0304.0506.0708.090a.0b0c0d.1921.6800.1001.00\n"+
+					"Error: NET \"39.0102.0304.0506.0708.090a.0b0c0d.1921.6800.1001.00\": must be between 8 and 20 octets (1 octet AFI, 0-12 octet area, 6 octet system ID, 1 octet NSEL), got 21",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.net.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNETValueArea(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		net           routingtypes.NET
+		expectedArea  string
+		expectedDiags diag.Diagnostics
+	}{
+		"NET value is null": {
+			net: routingtypes.NewNETNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"NET ValueArea Error",
+					"NET string value is null",
+				),
+			},
+		},
+		"NET value is unknown": {
+			net: routingtypes.NewNETUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"NET ValueArea Error",
+					"NET string value is unknown",
+				),
+			},
+		},
+		"valid NET": {
+			net:          routingtypes.NewNETValue("49.0001.1921.6800.1001.00"),
+			expectedArea: "49.0001",
+		},
+		"valid NET - no area": {
+			net:          routingtypes.NewNETValue("49.1921.6800.1001.00"),
+			expectedArea: "49",
+		},
+		"valid NET - odd length area": {
+			net:          routingtypes.NewNETValue("49.000102.1921.6800.1001.00"),
+			expectedArea: "49.0001.02",
+		},
+		"valid NET - maximum length area": {
+			net:          routingtypes.NewNETValue("47.0005.80FF.F800.0000.0108.0001.1921.6800.1001.00"),
+			expectedArea: "47.0005.80ff.f800.0000.0108.0001",
+		},
+		"valid NET - regrouped": {
+			net:          routingtypes.NewNETValue("490001192168001001.00"),
+			expectedArea: "49.0001",
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			area, diags := testCase.net.ValueArea()
+
+			if area != testCase.expectedArea {
+				t.Errorf("Unexpected difference in area, got: %s, expected: %s", area, testCase.expectedArea)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNETValueSystemID(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		net              routingtypes.NET
+		expectedSystemID string
+		expectedDiags    diag.Diagnostics
+	}{
+		"NET value is null": {
+			net: routingtypes.NewNETNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"NET ValueSystemID Error",
+					"NET string value is null",
+				),
+			},
+		},
+		"NET value is unknown": {
+			net: routingtypes.NewNETUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"NET ValueSystemID Error",
+					"NET string value is unknown",
+				),
+			},
+		},
+		"valid NET": {
+			net:              routingtypes.NewNETValue("49.0001.ABCD.EF00.1001.00"),
+			expectedSystemID: "abcd.ef00.1001",
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			systemID, diags := testCase.net.ValueSystemID()
+
+			if systemID != testCase.expectedSystemID {
+				t.Errorf("Unexpected difference in system ID, got: %s, expected: %s", systemID, testCase.expectedSystemID)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSystemIDFromIPv4Address(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		address          iptypes.IPv4Address
+		expectedSystemID string
+		expectedDiags    diag.Diagnostics
+	}{
+		"address value is null": {
+			address: iptypes.NewIPv4AddressNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"IPv4Address ValueIPv4Address Error",
+					"IPv4 address string value is null",
+				),
+			},
+		},
+		"address value is IPv6": {
+			address: iptypes.NewIPv4AddressValue("2001:db8::1"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"SystemIDFromIPv4Address Error",
+					"address 2001:db8::1 is not an IPv4 address",
+				),
+			},
+		},
+		"valid address": {
+			address:          iptypes.NewIPv4AddressValue("192.168.1.1"),
+			expectedSystemID: "1921.6800.1001",
+		},
+		"valid address - short octets": {
+			address:          iptypes.NewIPv4AddressValue("10.0.0.5"),
+			expectedSystemID: "0100.0000.0005",
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			systemID, diags := routingtypes.SystemIDFromIPv4Address(testCase.address)
+
+			if systemID != testCase.expectedSystemID {
+				t.Errorf("Unexpected difference in system ID, got: %s, expected: %s", systemID, testCase.expectedSystemID)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package routingtypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = (*PrefixListEntryType)(nil)
+
+// PrefixListEntryType is an attribute type that represents a valid route filter prefix list entry, which is an IPv4
+// or IPv6 CIDR with optional bounds on the length of matched prefixes, written either as `10.0.0.0/8 ge 16 le 24` or as
+// `10.0.0.0/8{16,24}`. Semantic equality logic is defined for PrefixListEntryType, so that entries matching the same
+// prefixes are considered equal regardless of syntax.
+//
+// All of the following are semantically equal:
+//   - 10.0.0.0/8 ge 16 le 32
+//   - 10.0.0.0/8 ge 16
+//   - 10.0.0.0/8{16,32}
+type PrefixListEntryType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t PrefixListEntryType) String() string {
+	return "routingtypes.PrefixListEntryType"
+}
+
+// ValueType returns the Value type.
+func (t PrefixListEntryType) ValueType(ctx context.Context) attr.Value {
+	return PrefixListEntry{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t PrefixListEntryType) Equal(o attr.Type) bool {
+	other, ok := o.(PrefixListEntryType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t PrefixListEntryType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return PrefixListEntry{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t PrefixListEntryType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
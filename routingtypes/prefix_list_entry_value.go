@@ -0,0 +1,355 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package routingtypes
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/cidrtypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*PrefixListEntry)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*PrefixListEntry)(nil)
+	_ xattr.ValidateableAttribute                = (*PrefixListEntry)(nil)
+	_ function.ValidateableParameter             = (*PrefixListEntry)(nil)
+)
+
+// PrefixListEntry represents a valid route filter prefix list entry, which is an IPv4 or IPv6 CIDR with optional bounds
+// on the length of matched prefixes. Entries are written either with `ge` and `le` keywords, such as
+// `10.0.0.0/8 ge 16 le 24`, or with a length range, such as `10.0.0.0/8{16,24}`, where spaces around the CIDR, braces
+// and both lengths are allowed but neither length may be omitted. Without `ge` and `le`, an entry only matches the
+// CIDR itself. With only `ge`, `le` defaults to the maximum prefix length, and with only `le`, `ge` defaults to the
+// CIDR prefix length. The CIDR must not have host bits set, and the bounds must satisfy `length <= ge <= le <= 32` for
+// IPv4 or `length <= ge <= le <= 128` for IPv6. Semantic equality logic is defined for PrefixListEntry, so that
+// entries matching the same prefixes are considered equal regardless of syntax.
+//
+// All of the following are semantically equal:
+//   - 10.0.0.0/8 ge 16 le 32
+//   - 10.0.0.0/8 ge 16
+//   - 10.0.0.0/8{16,32}
+type PrefixListEntry struct {
+	basetypes.StringValue
+}
+
+// Type returns a PrefixListEntryType.
+func (v PrefixListEntry) Type(_ context.Context) attr.Type {
+	return PrefixListEntryType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v PrefixListEntry) Equal(o attr.Value) bool {
+	other, ok := o.(PrefixListEntry)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given prefix list entry string value is semantically equal to the current
+// prefix list entry string value. Both values are parsed into their CIDR and length bounds before comparison, so entries
+// with default bounds written out, `compressed` IPv6 CIDRs or either syntax are considered equal.
+//
+// All of the following are semantically equal:
+//   - 10.0.0.0/8 ge 16 le 32
+//   - 10.0.0.0/8 ge 16
+//   - 10.0.0.0/8{16,32}
+func (v PrefixListEntry) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(PrefixListEntry)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// Prefix list entries are already validated at this point, ignoring errors
+	newRange, _ := parsePrefixListEntry(newValue.ValueString())
+	currentRange, _ := parsePrefixListEntry(v.ValueString())
+
+	return currentRange == newRange, diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String
+// value that is a valid prefix list entry.
+func (v PrefixListEntry) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parsePrefixListEntry(v.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Prefix List Entry String Value",
+			"A string value was provided that is not valid prefix list entry string format, such as `10.0.0.0/8 ge 16 le 24` or `10.0.0.0/8{16,24}`.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is a valid prefix list entry.
+func (v PrefixListEntry) ValidateParameter(_ context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parsePrefixListEntry(v.ValueString())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid Prefix List Entry String Value: "+
+				"A string value was provided that is not valid prefix list entry string format, such as `10.0.0.0/8 ge 16 le 24` or `10.0.0.0/8{16,24}`.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValuePrefixRange parses the PrefixListEntry StringValue into a PrefixRange with the default bounds applied. A null
+// or unknown value will produce an error diagnostic.
+func (v PrefixListEntry) ValuePrefixRange() (PrefixRange, diag.Diagnostics) {
+	return v.valuePrefixListEntry("ValuePrefixRange")
+}
+
+// ValuePrefix returns the CIDR of the PrefixListEntry StringValue as a cidrtypes.IPPrefix, such as `10.0.0.0/8` for
+// `10.0.0.0/8 ge 16 le 24`. A null or unknown value will produce an error diagnostic.
+func (v PrefixListEntry) ValuePrefix() (cidrtypes.IPPrefix, diag.Diagnostics) {
+	prefixRange, diags := v.valuePrefixListEntry("ValuePrefix")
+	if diags.HasError() {
+		return cidrtypes.IPPrefix{}, diags
+	}
+
+	return cidrtypes.NewIPPrefixValue(prefixRange.Prefix.String()), nil
+}
+
+// Matches returns true if the given prefix is matched by the PrefixListEntry StringValue, which allows routing policy
+// to be checked without a device. See PrefixRange.Matches for the matching rules. A null or unknown value will produce
+// an error diagnostic.
+func (v PrefixListEntry) Matches(prefix netip.Prefix) (bool, diag.Diagnostics) {
+	prefixRange, diags := v.valuePrefixListEntry("Matches")
+	if diags.HasError() {
+		return false, diags
+	}
+
+	return prefixRange.Matches(prefix), nil
+}
+
+// valuePrefixListEntry parses the PrefixListEntry StringValue, producing error diagnostics attributed to the given
+// method.
+func (v PrefixListEntry) valuePrefixListEntry(method string) (PrefixRange, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("PrefixListEntry "+method+" Error", "prefix list entry string value is null"))
+		return PrefixRange{}, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("PrefixListEntry "+method+" Error", "prefix list entry string value is unknown"))
+		return PrefixRange{}, diags
+	}
+
+	prefixRange, err := parsePrefixListEntry(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("PrefixListEntry "+method+" Error", err.Error()))
+		return PrefixRange{}, diags
+	}
+
+	return prefixRange, nil
+}
+
+// PrefixRange is a parsed prefix list entry, which matches prefixes within a CIDR that have a length within bounds.
+type PrefixRange struct {
+	// Prefix is the CIDR that matched prefixes must be within, which does not have host bits set.
+	Prefix netip.Prefix
+
+	// MinLength is the minimum length of matched prefixes, which is the `ge` bound.
+	MinLength int
+
+	// MaxLength is the maximum length of matched prefixes, which is the `le` bound.
+	MaxLength int
+}
+
+// String returns the prefix range in `ge` and `le` syntax, such as `10.0.0.0/8 ge 16 le 24`, where both bounds are
+// omitted if the range only matches the CIDR itself.
+func (r PrefixRange) String() string {
+	if r.MinLength == r.Prefix.Bits() && r.MaxLength == r.Prefix.Bits() {
+		return r.Prefix.String()
+	}
+
+	return fmt.Sprintf("%s ge %d le %d", r.Prefix, r.MinLength, r.MaxLength)
+}
+
+// Matches returns true if the given prefix is of the same address family, is within the CIDR of the range and has a
+// length from MinLength to MaxLength, such as `10.1.0.0/16` for `10.0.0.0/8 ge 16 le 24`. Host bits of the given
+// prefix are ignored. An invalid prefix is never matched.
+func (r PrefixRange) Matches(prefix netip.Prefix) bool {
+	if !prefix.IsValid() || prefix.Bits() < r.MinLength || prefix.Bits() > r.MaxLength {
+		return false
+	}
+
+	return prefix.Addr().BitLen() == r.Prefix.Addr().BitLen() && r.Prefix.Contains(prefix.Addr())
+}
+
+// parsePrefixListEntry parses a prefix list entry in either `ge` and `le` syntax or length range syntax, applying the
+// default bounds and validating that `length <= ge <= le <= maximum length`.
+func parsePrefixListEntry(s string) (PrefixRange, error) {
+	var cidr string
+	var ge, le string
+
+	if i := strings.IndexByte(s, '{'); i >= 0 {
+		bounds, ok := strings.CutSuffix(strings.TrimSpace(s[i+1:]), "}")
+		if !ok {
+			return PrefixRange{}, fmt.Errorf("prefix list entry %q: length range must end with }", s)
+		}
+
+		ge, le, ok = strings.Cut(bounds, ",")
+		if !ok {
+			return PrefixRange{}, fmt.Errorf("prefix list entry %q: length range must be two lengths separated by a comma", s)
+		}
+
+		ge, le = strings.TrimSpace(ge), strings.TrimSpace(le)
+		if ge == "" || le == "" {
+			return PrefixRange{}, fmt.Errorf("prefix list entry %q: length range must not have an empty length", s)
+		}
+
+		cidr = strings.TrimSpace(s[:i])
+	} else {
+		fields := strings.Fields(s)
+		if len(fields) == 0 {
+			return PrefixRange{}, fmt.Errorf("prefix list entry %q: must not be empty", s)
+		}
+
+		cidr = fields[0]
+
+		for rest := fields[1:]; len(rest) > 0; rest = rest[2:] {
+			if len(rest) < 2 {
+				return PrefixRange{}, fmt.Errorf("prefix list entry %q: %s must be followed by a length", s, rest[0])
+			}
+
+			switch {
+			case strings.EqualFold(rest[0], "ge") && ge == "" && le == "":
+				ge = rest[1]
+			case strings.EqualFold(rest[0], "le") && le == "":
+				le = rest[1]
+			default:
+				return PrefixRange{}, fmt.Errorf("prefix list entry %q: unexpected %s, expected at most one ge followed by at most one le", s, rest[0])
+			}
+		}
+	}
+
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return PrefixRange{}, fmt.Errorf("prefix list entry %q: %w", s, err)
+	}
+
+	if prefix != prefix.Masked() {
+		return PrefixRange{}, fmt.Errorf("prefix list entry %q: prefix %s must not have host bits set, expected %s", s, prefix, prefix.Masked())
+	}
+
+	maxLength := prefix.Addr().BitLen()
+	prefixRange := PrefixRange{
+		Prefix:    prefix,
+		MinLength: prefix.Bits(),
+		MaxLength: prefix.Bits(),
+	}
+
+	if ge != "" {
+		prefixRange.MinLength, err = parsePrefixListLength(s, "ge", ge)
+		if err != nil {
+			return PrefixRange{}, err
+		}
+
+		prefixRange.MaxLength = maxLength
+	}
+
+	if le != "" {
+		prefixRange.MaxLength, err = parsePrefixListLength(s, "le", le)
+		if err != nil {
+			return PrefixRange{}, err
+		}
+	}
+
+	if prefixRange.MinLength < prefix.Bits() {
+		return PrefixRange{}, fmt.Errorf("prefix list entry %q: ge %d must be greater than or equal to the prefix length %d", s, prefixRange.MinLength, prefix.Bits())
+	}
+
+	if prefixRange.MinLength > maxLength {
+		return PrefixRange{}, fmt.Errorf("prefix list entry %q: ge %d must be less than or equal to %d", s, prefixRange.MinLength, maxLength)
+	}
+
+	if prefixRange.MaxLength < prefixRange.MinLength {
+		return PrefixRange{}, fmt.Errorf("prefix list entry %q: le %d must be greater than or equal to ge %d", s, prefixRange.MaxLength, prefixRange.MinLength)
+	}
+
+	if prefixRange.MaxLength > maxLength {
+		return PrefixRange{}, fmt.Errorf("prefix list entry %q: le %d must be less than or equal to %d", s, prefixRange.MaxLength, maxLength)
+	}
+
+	return prefixRange, nil
+}
+
+// parsePrefixListLength parses a decimal prefix length bound of a prefix list entry.
+func parsePrefixListLength(s string, bound string, length string) (int, error) {
+	n, err := strconv.ParseUint(length, 10, 8)
+	if err != nil {
+		return 0, fmt.Errorf("prefix list entry %q: %s %q must be a decimal prefix length", s, bound, length)
+	}
+
+	return int(n), nil
+}
+
+// NewPrefixListEntryNull creates a PrefixListEntry with a null value. Determine whether the value is null via IsNull method.
+func NewPrefixListEntryNull() PrefixListEntry {
+	return PrefixListEntry{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewPrefixListEntryUnknown creates a PrefixListEntry with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewPrefixListEntryUnknown() PrefixListEntry {
+	return PrefixListEntry{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewPrefixListEntryValue creates a PrefixListEntry with a known value. Access the value via ValueString method.
+func NewPrefixListEntryValue(value string) PrefixListEntry {
+	return PrefixListEntry{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewPrefixListEntryPointerValue creates a PrefixListEntry with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewPrefixListEntryPointerValue(value *string) PrefixListEntry {
+	return PrefixListEntry{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
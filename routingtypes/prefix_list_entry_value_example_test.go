@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package routingtypes_test
+
+import (
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/routingtypes"
+)
+
+type PrefixListResourceModel struct {
+	Entries []routingtypes.PrefixListEntry `tfsdk:"entries"`
+}
+
+func ExamplePrefixListEntry_Matches() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := PrefixListResourceModel{
+		Entries: []routingtypes.PrefixListEntry{
+			routingtypes.NewPrefixListEntryValue("10.0.0.0/8 ge 16 le 24"),
+			routingtypes.NewPrefixListEntryValue("192.168.0.0/16{24,32}"),
+		},
+	}
+
+	// Check which of the known entries match the announced routes
+	routes := []netip.Prefix{
+		netip.MustParsePrefix("10.1.0.0/16"),
+		netip.MustParsePrefix("10.0.0.0/8"),
+		netip.MustParsePrefix("192.168.7.0/24"),
+	}
+
+	for _, route := range routes {
+		matched := false
+
+		for _, entry := range data.Entries {
+			if entry.IsNull() || entry.IsUnknown() {
+				continue
+			}
+
+			match, diags := entry.Matches(route)
+			if diags.HasError() {
+				return
+			}
+
+			matched = matched || match
+		}
+
+		fmt.Printf("%s: %t\n", route, matched)
+	}
+
+	// Output:
+	// 10.1.0.0/16: true
+	// 10.0.0.0/8: false
+	// 192.168.7.0/24: true
+}
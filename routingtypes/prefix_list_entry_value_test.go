@@ -0,0 +1,579 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package routingtypes_test
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/cidrtypes"
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/routingtypes"
+)
+
+func TestPrefixListEntryStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentEntry  routingtypes.PrefixListEntry
+		givenEntry    basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"not equal - prefix mismatch": {
+			currentEntry:  routingtypes.NewPrefixListEntryValue("10.0.0.0/8 ge 16 le 24"),
+			givenEntry:    routingtypes.NewPrefixListEntryValue("11.0.0.0/8 ge 16 le 24"),
+			expectedMatch: false,
+		},
+		"not equal - ge mismatch": {
+			currentEntry:  routingtypes.NewPrefixListEntryValue("10.0.0.0/8 ge 16 le 24"),
+			givenEntry:    routingtypes.NewPrefixListEntryValue("10.0.0.0/8 ge 17 le 24"),
+			expectedMatch: false,
+		},
+		"not equal - le mismatch": {
+			currentEntry:  routingtypes.NewPrefixListEntryValue("10.0.0.0/8 ge 16 le 24"),
+			givenEntry:    routingtypes.NewPrefixListEntryValue("10.0.0.0/8{16,25}"),
+			expectedMatch: false,
+		},
+		"not equal - exact and ge": {
+			currentEntry:  routingtypes.NewPrefixListEntryValue("10.0.0.0/8"),
+			givenEntry:    routingtypes.NewPrefixListEntryValue("10.0.0.0/8 ge 8"),
+			expectedMatch: false,
+		},
+		"semantically equal - byte-for-byte match": {
+			currentEntry:  routingtypes.NewPrefixListEntryValue("10.0.0.0/8 ge 16 le 24"),
+			givenEntry:    routingtypes.NewPrefixListEntryValue("10.0.0.0/8 ge 16 le 24"),
+			expectedMatch: true,
+		},
+		"semantically equal - ge le and length range": {
+			currentEntry:  routingtypes.NewPrefixListEntryValue("10.0.0.0/8 ge 16 le 24"),
+			givenEntry:    routingtypes.NewPrefixListEntryValue("10.0.0.0/8{16,24}"),
+			expectedMatch: true,
+		},
+		"semantically equal - length range spaces": {
+			currentEntry:  routingtypes.NewPrefixListEntryValue("10.0.0.0/8{16,24}"),
+			givenEntry:    routingtypes.NewPrefixListEntryValue(" 10.0.0.0/8 { 16, 24 } "),
+			expectedMatch: true,
+		},
+		"semantically equal - default le": {
+			currentEntry:  routingtypes.NewPrefixListEntryValue("10.0.0.0/8 ge 16"),
+			givenEntry:    routingtypes.NewPrefixListEntryValue("10.0.0.0/8 ge 16 le 32"),
+			expectedMatch: true,
+		},
+		"semantically equal - default ge": {
+			currentEntry:  routingtypes.NewPrefixListEntryValue("10.0.0.0/8 le 24"),
+			givenEntry:    routingtypes.NewPrefixListEntryValue("10.0.0.0/8{8,24}"),
+			expectedMatch: true,
+		},
+		"semantically equal - exact": {
+			currentEntry:  routingtypes.NewPrefixListEntryValue("10.0.0.0/8"),
+			givenEntry:    routingtypes.NewPrefixListEntryValue("10.0.0.0/8 le 8"),
+			expectedMatch: true,
+		},
+		"semantically equal - case and whitespace": {
+			currentEntry:  routingtypes.NewPrefixListEntryValue("10.0.0.0/8  GE 16\tLE 24"),
+			givenEntry:    routingtypes.NewPrefixListEntryValue("10.0.0.0/8 ge 16 le 24"),
+			expectedMatch: true,
+		},
+		"semantically equal - IPv6 compressed": {
+			currentEntry:  routingtypes.NewPrefixListEntryValue("2001:0DB8:0:0:0:0:0:0/32 ge 48"),
+			givenEntry:    routingtypes.NewPrefixListEntryValue("2001:db8::/32{48,128}"),
+			expectedMatch: true,
+		},
+		"error - not given PrefixListEntry value": {
+			currentEntry:  routingtypes.NewPrefixListEntryValue("10.0.0.0/8"),
+			givenEntry:    basetypes.NewStringValue("10.0.0.0/8"),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: routingtypes.PrefixListEntry\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentEntry.StringSemanticEquals(context.Background(), testCase.givenEntry)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestPrefixListEntryValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	invalid := func(value string, err string) diag.Diagnostics {
+		return diag.Diagnostics{
+			diag.NewAttributeErrorDiagnostic(
+				path.Root("test"),
+				"Invalid Prefix List Entry String Value",
+				"A string value was provided that is not valid prefix list entry string format, such as `10.0.0.0/8 ge 16 le 24` or `10.0.0.0/8{16,24}`.\n\n"+
+					"Given Value: "+value+"\n"+
+					"Error: "+err,
+			),
+		}
+	}
+
+	testCases := map[string]struct {
+		entry         routingtypes.PrefixListEntry
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			entry: routingtypes.PrefixListEntry{},
+		},
+		"null": {
+			entry: routingtypes.NewPrefixListEntryNull(),
+		},
+		"unknown": {
+			entry: routingtypes.NewPrefixListEntryUnknown(),
+		},
+		"valid - exact": {
+			entry: routingtypes.NewPrefixListEntryValue("10.0.0.0/8"),
+		},
+		"valid - ge le": {
+			entry: routingtypes.NewPrefixListEntryValue("10.0.0.0/8 ge 16 le 24"),
+		},
+		"valid - ge equal to prefix length": {
+			entry: routingtypes.NewPrefixListEntryValue("10.0.0.0/8 ge 8 le 8"),
+		},
+		"valid - default route le 32": {
+			entry: routingtypes.NewPrefixListEntryValue("0.0.0.0/0 le 32"),
+		},
+		"valid - length range": {
+			entry: routingtypes.NewPrefixListEntryValue("10.0.0.0/8{16,24}"),
+		},
+		"valid - length range with spaces": {
+			entry: routingtypes.NewPrefixListEntryValue("10.0.0.0/8{ 16, 24 }"),
+		},
+		"valid - length range with space before brace": {
+			entry: routingtypes.NewPrefixListEntryValue("10.0.0.0/8 {16,24}"),
+		},
+		"valid - length range with leading and trailing spaces": {
+			entry: routingtypes.NewPrefixListEntryValue(" 10.0.0.0/8{16,24} "),
+		},
+		"valid - IPv6": {
+			entry: routingtypes.NewPrefixListEntryValue("2001:db8::/32 ge 48 le 64"),
+		},
+		"valid - IPv6 le 128": {
+			entry: routingtypes.NewPrefixListEntryValue("::/0{0,128}"),
+		},
+		"invalid - empty": {
+			entry:         routingtypes.NewPrefixListEntryValue(""),
+			expectedDiags: invalid("", `prefix list entry "": must not be empty`),
+		},
+		"invalid - not a CIDR": {
+			entry:         routingtypes.NewPrefixListEntryValue("10.0.0.0 ge 16"),
+			expectedDiags: invalid("10.0.0.0 ge 16", `prefix list entry "10.0.0.0 ge 16": netip.ParsePrefix("10.0.0.0"): no '/'`),
+		},
+		"invalid - host bits": {
+			entry:         routingtypes.NewPrefixListEntryValue("10.1.0.0/8 ge 16"),
+			expectedDiags: invalid("10.1.0.0/8 ge 16", `prefix list entry "10.1.0.0/8 ge 16": prefix 10.1.0.0/8 must not have host bits set, expected 10.0.0.0/8`),
+		},
+		"invalid - missing length": {
+			entry:         routingtypes.NewPrefixListEntryValue("10.0.0.0/8 ge"),
+			expectedDiags: invalid("10.0.0.0/8 ge", `prefix list entry "10.0.0.0/8 ge": ge must be followed by a length`),
+		},
+		"invalid - le before ge": {
+			entry:         routingtypes.NewPrefixListEntryValue("10.0.0.0/8 le 24 ge 16"),
+			expectedDiags: invalid("10.0.0.0/8 le 24 ge 16", `prefix list entry "10.0.0.0/8 le 24 ge 16": unexpected ge, expected at most one ge followed by at most one le`),
+		},
+		"invalid - unknown keyword": {
+			entry:         routingtypes.NewPrefixListEntryValue("10.0.0.0/8 eq 16"),
+			expectedDiags: invalid("10.0.0.0/8 eq 16", `prefix list entry "10.0.0.0/8 eq 16": unexpected eq, expected at most one ge followed by at most one le`),
+		},
+		"invalid - length not a number": {
+			entry:         routingtypes.NewPrefixListEntryValue("10.0.0.0/8 ge /16"),
+			expectedDiags: invalid("10.0.0.0/8 ge /16", `prefix list entry "10.0.0.0/8 ge /16": ge "/16" must be a decimal prefix length`),
+		},
+		"invalid - ge less than prefix length": {
+			entry:         routingtypes.NewPrefixListEntryValue("10.0.0.0/8 ge 4"),
+			expectedDiags: invalid("10.0.0.0/8 ge 4", `prefix list entry "10.0.0.0/8 ge 4": ge 4 must be greater than or equal to the prefix length 8`),
+		},
+		"invalid - ge greater than maximum": {
+			entry:         routingtypes.NewPrefixListEntryValue("10.0.0.0/8 ge 33"),
+			expectedDiags: invalid("10.0.0.0/8 ge 33", `prefix list entry "10.0.0.0/8 ge 33": ge 33 must be less than or equal to 32`),
+		},
+		"invalid - le less than ge": {
+			entry:         routingtypes.NewPrefixListEntryValue("10.0.0.0/8 ge 24 le 16"),
+			expectedDiags: invalid("10.0.0.0/8 ge 24 le 16", `prefix list entry "10.0.0.0/8 ge 24 le 16": le 16 must be greater than or equal to ge 24`),
+		},
+		"invalid - le less than prefix length": {
+			entry:         routingtypes.NewPrefixListEntryValue("10.0.0.0/8 le 4"),
+			expectedDiags: invalid("10.0.0.0/8 le 4", `prefix list entry "10.0.0.0/8 le 4": le 4 must be greater than or equal to ge 8`),
+		},
+		"invalid - le greater than maximum": {
+			entry:         routingtypes.NewPrefixListEntryValue("2001:db8::/32{48,129}"),
+			expectedDiags: invalid("2001:db8::/32{48,129}", `prefix list entry "2001:db8::/32{48,129}": le 129 must be less than or equal to 128`),
+		},
+		"invalid - length range unterminated": {
+			entry:         routingtypes.NewPrefixListEntryValue("10.0.0.0/8{16,24"),
+			expectedDiags: invalid("10.0.0.0/8{16,24", `prefix list entry "10.0.0.0/8{16,24": length range must end with }`),
+		},
+		"invalid - length range single length": {
+			entry:         routingtypes.NewPrefixListEntryValue("10.0.0.0/8{16}"),
+			expectedDiags: invalid("10.0.0.0/8{16}", `prefix list entry "10.0.0.0/8{16}": length range must be two lengths separated by a comma`),
+		},
+		"invalid - length range empty ge": {
+			entry:         routingtypes.NewPrefixListEntryValue("10.0.0.0/8{,24}"),
+			expectedDiags: invalid("10.0.0.0/8{,24}", `prefix list entry "10.0.0.0/8{,24}": length range must not have an empty length`),
+		},
+		"invalid - length range empty le": {
+			entry:         routingtypes.NewPrefixListEntryValue("10.0.0.0/8{16, }"),
+			expectedDiags: invalid("10.0.0.0/8{16, }", `prefix list entry "10.0.0.0/8{16, }": length range must not have an empty length`),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.entry.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{Path: path.Root("test")},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestPrefixListEntryValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		entry           routingtypes.PrefixListEntry
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			entry: routingtypes.PrefixListEntry{},
+		},
+		"null": {
+			entry: routingtypes.NewPrefixListEntryNull(),
+		},
+		"unknown": {
+			entry: routingtypes.NewPrefixListEntryUnknown(),
+		},
+		"valid - ge le": {
+			entry: routingtypes.NewPrefixListEntryValue("10.0.0.0/8 ge 16 le 24"),
+		},
+		"valid - length range": {
+			entry: routingtypes.NewPrefixListEntryValue("10.0.0.0/8{16,24}"),
+		},
+		"invalid - le less than ge": {
+			entry: routingtypes.NewPrefixListEntryValue("10.0.0.0/8 ge 24 le 16"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid Prefix List Entry String Value: "+
+					"A string value was provided that is not valid prefix list entry string format, such as `10.0.0.0/8 ge 16 le 24` or `10.0.0.0/8{16,24}`.\n\n"+
+					"Given Value: 10.0.0.0/8 ge 24 le 16\n"+
+					"Error: "+`prefix list entry "10.0.0.0/8 ge 24 le 16": le 16 must be greater than or equal to ge 24`,
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.entry.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestPrefixListEntryValuePrefixRange(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		entry          routingtypes.PrefixListEntry
+		expectedRange  routingtypes.PrefixRange
+		expectedString string
+		expectedDiags  diag.Diagnostics
+	}{
+		"prefix list entry value is null": {
+			entry: routingtypes.NewPrefixListEntryNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"PrefixListEntry ValuePrefixRange Error",
+					"prefix list entry string value is null",
+				),
+			},
+		},
+		"prefix list entry value is unknown": {
+			entry: routingtypes.NewPrefixListEntryUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"PrefixListEntry ValuePrefixRange Error",
+					"prefix list entry string value is unknown",
+				),
+			},
+		},
+		"exact": {
+			entry: routingtypes.NewPrefixListEntryValue("10.0.0.0/8"),
+			expectedRange: routingtypes.PrefixRange{
+				Prefix:    netip.MustParsePrefix("10.0.0.0/8"),
+				MinLength: 8,
+				MaxLength: 8,
+			},
+			expectedString: "10.0.0.0/8",
+		},
+		"default le": {
+			entry: routingtypes.NewPrefixListEntryValue("10.0.0.0/8 ge 16"),
+			expectedRange: routingtypes.PrefixRange{
+				Prefix:    netip.MustParsePrefix("10.0.0.0/8"),
+				MinLength: 16,
+				MaxLength: 32,
+			},
+			expectedString: "10.0.0.0/8 ge 16 le 32",
+		},
+		"default ge": {
+			entry: routingtypes.NewPrefixListEntryValue("2001:db8::/32 le 64"),
+			expectedRange: routingtypes.PrefixRange{
+				Prefix:    netip.MustParsePrefix("2001:db8::/32"),
+				MinLength: 32,
+				MaxLength: 64,
+			},
+			expectedString: "2001:db8::/32 ge 32 le 64",
+		},
+		"length range": {
+			entry: routingtypes.NewPrefixListEntryValue("10.0.0.0/8{16,24}"),
+			expectedRange: routingtypes.PrefixRange{
+				Prefix:    netip.MustParsePrefix("10.0.0.0/8"),
+				MinLength: 16,
+				MaxLength: 24,
+			},
+			expectedString: "10.0.0.0/8 ge 16 le 24",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			prefixRange, diags := testCase.entry.ValuePrefixRange()
+
+			if prefixRange != testCase.expectedRange {
+				t.Errorf("Unexpected difference in PrefixRange, got: %+v, expected: %+v", prefixRange, testCase.expectedRange)
+			}
+
+			if testCase.expectedString != "" && prefixRange.String() != testCase.expectedString {
+				t.Errorf("Unexpected PrefixRange string, got: %s, expected: %s", prefixRange, testCase.expectedString)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestPrefixListEntryValuePrefix(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		entry         routingtypes.PrefixListEntry
+		expected      cidrtypes.IPPrefix
+		expectedDiags diag.Diagnostics
+	}{
+		"prefix list entry value is null": {
+			entry:    routingtypes.NewPrefixListEntryNull(),
+			expected: cidrtypes.IPPrefix{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"PrefixListEntry ValuePrefix Error",
+					"prefix list entry string value is null",
+				),
+			},
+		},
+		"prefix list entry value is unknown": {
+			entry:    routingtypes.NewPrefixListEntryUnknown(),
+			expected: cidrtypes.IPPrefix{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"PrefixListEntry ValuePrefix Error",
+					"prefix list entry string value is unknown",
+				),
+			},
+		},
+		"IPv4": {
+			entry:    routingtypes.NewPrefixListEntryValue("10.0.0.0/8 ge 16 le 24"),
+			expected: cidrtypes.NewIPPrefixValue("10.0.0.0/8"),
+		},
+		"IPv6": {
+			entry:    routingtypes.NewPrefixListEntryValue("2001:0DB8:0:0:0:0:0:0/32{48,64}"),
+			expected: cidrtypes.NewIPPrefixValue("2001:db8::/32"),
+		},
+		"invalid": {
+			entry:    routingtypes.NewPrefixListEntryValue("10.0.0.0/8 ge 4"),
+			expected: cidrtypes.IPPrefix{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"PrefixListEntry ValuePrefix Error",
+					`prefix list entry "10.0.0.0/8 ge 4": ge 4 must be greater than or equal to the prefix length 8`,
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.entry.ValuePrefix()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("Unexpected difference in IPPrefix (-got, +expected): %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestPrefixListEntryMatches(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		entry         routingtypes.PrefixListEntry
+		prefix        netip.Prefix
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"prefix list entry value is null": {
+			entry:  routingtypes.NewPrefixListEntryNull(),
+			prefix: netip.MustParsePrefix("10.0.0.0/8"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"PrefixListEntry Matches Error",
+					"prefix list entry string value is null",
+				),
+			},
+		},
+		"prefix list entry value is unknown": {
+			entry:  routingtypes.NewPrefixListEntryUnknown(),
+			prefix: netip.MustParsePrefix("10.0.0.0/8"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"PrefixListEntry Matches Error",
+					"prefix list entry string value is unknown",
+				),
+			},
+		},
+		"exact - matched": {
+			entry:         routingtypes.NewPrefixListEntryValue("10.0.0.0/8"),
+			prefix:        netip.MustParsePrefix("10.0.0.0/8"),
+			expectedMatch: true,
+		},
+		"exact - longer prefix not matched": {
+			entry:         routingtypes.NewPrefixListEntryValue("10.0.0.0/8"),
+			prefix:        netip.MustParsePrefix("10.1.0.0/16"),
+			expectedMatch: false,
+		},
+		"ge le - minimum length matched": {
+			entry:         routingtypes.NewPrefixListEntryValue("10.0.0.0/8 ge 16 le 24"),
+			prefix:        netip.MustParsePrefix("10.1.0.0/16"),
+			expectedMatch: true,
+		},
+		"ge le - maximum length matched": {
+			entry:         routingtypes.NewPrefixListEntryValue("10.0.0.0/8 ge 16 le 24"),
+			prefix:        netip.MustParsePrefix("10.255.255.0/24"),
+			expectedMatch: true,
+		},
+		"ge le - shorter than ge not matched": {
+			entry:         routingtypes.NewPrefixListEntryValue("10.0.0.0/8 ge 16 le 24"),
+			prefix:        netip.MustParsePrefix("10.0.0.0/15"),
+			expectedMatch: false,
+		},
+		"ge le - longer than le not matched": {
+			entry:         routingtypes.NewPrefixListEntryValue("10.0.0.0/8 ge 16 le 24"),
+			prefix:        netip.MustParsePrefix("10.1.1.0/25"),
+			expectedMatch: false,
+		},
+		"ge le - outside CIDR not matched": {
+			entry:         routingtypes.NewPrefixListEntryValue("10.0.0.0/8 ge 16 le 24"),
+			prefix:        netip.MustParsePrefix("11.1.0.0/16"),
+			expectedMatch: false,
+		},
+		"default route le 32 - host route matched": {
+			entry:         routingtypes.NewPrefixListEntryValue("0.0.0.0/0 le 32"),
+			prefix:        netip.MustParsePrefix("192.0.2.1/32"),
+			expectedMatch: true,
+		},
+		"default route le 32 - IPv6 not matched": {
+			entry:         routingtypes.NewPrefixListEntryValue("0.0.0.0/0 le 32"),
+			prefix:        netip.MustParsePrefix("2001:db8::/32"),
+			expectedMatch: false,
+		},
+		"IPv6 length range - matched": {
+			entry:         routingtypes.NewPrefixListEntryValue("2001:db8::/32{48,64}"),
+			prefix:        netip.MustParsePrefix("2001:db8:1::/48"),
+			expectedMatch: true,
+		},
+		"IPv6 - IPv4 prefix not matched": {
+			entry:         routingtypes.NewPrefixListEntryValue("::/0 le 128"),
+			prefix:        netip.MustParsePrefix("10.0.0.0/8"),
+			expectedMatch: false,
+		},
+		"invalid prefix not matched": {
+			entry:         routingtypes.NewPrefixListEntryValue("0.0.0.0/0 le 32"),
+			prefix:        netip.Prefix{},
+			expectedMatch: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.entry.Matches(testCase.prefix)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected Matches to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
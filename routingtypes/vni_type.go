@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package routingtypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.Int64Typable = (*VNIType)(nil)
+
+// VNIType is an attribute type that represents a valid 24-bit VXLAN Network Identifier (RFC 7348), between 0 and 16777215.
+// No semantic equality logic is defined for VNIType, so it will follow Terraform's data-consistency rules for numbers.
+type VNIType struct {
+	basetypes.Int64Type
+}
+
+// String returns a human readable string of the type name.
+func (t VNIType) String() string {
+	return "routingtypes.VNIType"
+}
+
+// ValueType returns the Value type.
+func (t VNIType) ValueType(ctx context.Context) attr.Value {
+	return VNI{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t VNIType) Equal(o attr.Type) bool {
+	other, ok := o.(VNIType)
+
+	if !ok {
+		return false
+	}
+
+	return t.Int64Type.Equal(other.Int64Type)
+}
+
+// ValueFromInt64 returns an Int64Valuable type given an Int64Value.
+func (t VNIType) ValueFromInt64(_ context.Context, in basetypes.Int64Value) (basetypes.Int64Valuable, diag.Diagnostics) {
+	return VNI{
+		Int64Value: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t VNIType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.Int64Type.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	int64Value, ok := attrValue.(basetypes.Int64Value)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	int64Valuable, diags := t.ValueFromInt64(ctx, int64Value)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting Int64Value to Int64Valuable: %v", diags)
+	}
+
+	return int64Valuable, nil
+}
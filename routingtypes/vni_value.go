@@ -0,0 +1,134 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package routingtypes
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.Int64Valuable        = (*VNI)(nil)
+	_ xattr.ValidateableAttribute    = (*VNI)(nil)
+	_ function.ValidateableParameter = (*VNI)(nil)
+)
+
+// maxVNI is the largest value representable by the 24-bit VNI field of a VXLAN header.
+const maxVNI = 1<<24 - 1
+
+// VNI represents a valid 24-bit VXLAN Network Identifier (RFC 7348), between 0 and 16777215. No semantic equality
+// logic is defined for VNI, so it will follow Terraform's data-consistency rules for numbers.
+type VNI struct {
+	basetypes.Int64Value
+}
+
+// Type returns a VNIType.
+func (v VNI) Type(_ context.Context) attr.Type {
+	return VNIType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v VNI) Equal(o attr.Value) bool {
+	other, ok := o.(VNI)
+
+	if !ok {
+		return false
+	}
+
+	return v.Int64Value.Equal(other.Int64Value)
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be an Int64
+// value that fits within the 24-bit VNI field.
+func (v VNI) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if v.ValueInt64() < 0 || v.ValueInt64() > maxVNI {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid VNI Integer Value",
+			"An integer value was provided that is not a valid VXLAN Network Identifier, which must be between 0 and 16777215 (24 bits).\n\n"+
+				"Given Value: "+strconv.FormatInt(v.ValueInt64(), 10)+"\n",
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be an Int64 value that fits within the 24-bit VNI field.
+func (v VNI) ValidateParameter(_ context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if v.ValueInt64() < 0 || v.ValueInt64() > maxVNI {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid VNI Integer Value: "+
+				"An integer value was provided that is not a valid VXLAN Network Identifier, which must be between 0 and 16777215 (24 bits).\n\n"+
+				"Given Value: "+strconv.FormatInt(v.ValueInt64(), 10)+"\n",
+		)
+
+		return
+	}
+}
+
+// ValueVNI returns the VNI Int64Value as a uint32. A null, unknown or out of range value will produce an error diagnostic.
+func (v VNI) ValueVNI() (uint32, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("VNI ValueVNI Error", "VNI integer value is null"))
+		return 0, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("VNI ValueVNI Error", "VNI integer value is unknown"))
+		return 0, diags
+	}
+
+	if v.ValueInt64() < 0 || v.ValueInt64() > maxVNI {
+		diags.Append(diag.NewErrorDiagnostic("VNI ValueVNI Error", "VNI integer value "+strconv.FormatInt(v.ValueInt64(), 10)+" is out of range"))
+		return 0, diags
+	}
+
+	return uint32(v.ValueInt64()), nil
+}
+
+// NewVNINull creates a VNI with a null value. Determine whether the value is null via IsNull method.
+func NewVNINull() VNI {
+	return VNI{
+		Int64Value: basetypes.NewInt64Null(),
+	}
+}
+
+// NewVNIUnknown creates a VNI with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewVNIUnknown() VNI {
+	return VNI{
+		Int64Value: basetypes.NewInt64Unknown(),
+	}
+}
+
+// NewVNIValue creates a VNI with a known value. Access the value via ValueInt64 method.
+func NewVNIValue(value int64) VNI {
+	return VNI{
+		Int64Value: basetypes.NewInt64Value(value),
+	}
+}
+
+// NewVNIPointerValue creates a VNI with a null value if nil or a known value. Access the value via ValueInt64Pointer method.
+func NewVNIPointerValue(value *int64) VNI {
+	return VNI{
+		Int64Value: basetypes.NewInt64PointerValue(value),
+	}
+}
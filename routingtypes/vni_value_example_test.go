@@ -0,0 +1,33 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package routingtypes_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/routingtypes"
+)
+
+type VNIResourceModel struct {
+	VNI routingtypes.VNI `tfsdk:"vni"`
+}
+
+func ExampleVNI_ValueVNI() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := VNIResourceModel{
+		VNI: routingtypes.NewVNIValue(10100),
+	}
+
+	// Check that the VNI data is known and able to be converted to uint32
+	if !data.VNI.IsNull() && !data.VNI.IsUnknown() {
+		vni, diags := data.VNI.ValueVNI()
+		if diags.HasError() {
+			return
+		}
+
+		// Output: 10100
+		fmt.Printf("%d\n", vni)
+	}
+}
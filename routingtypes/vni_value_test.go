@@ -0,0 +1,190 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package routingtypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/routingtypes"
+)
+
+func TestVNIValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		vni           routingtypes.VNI
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			vni: routingtypes.VNI{},
+		},
+		"null": {
+			vni: routingtypes.NewVNINull(),
+		},
+		"unknown": {
+			vni: routingtypes.NewVNIUnknown(),
+		},
+		"valid VNI - minimum": {
+			vni: routingtypes.NewVNIValue(0),
+		},
+		"valid VNI - maximum": {
+			vni: routingtypes.NewVNIValue(16777215),
+		},
+		"invalid VNI - negative": {
+			vni: routingtypes.NewVNIValue(-1),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid VNI Integer Value",
+					"An integer value was provided that is not a valid VXLAN Network Identifier, which must be between 0 and 16777215 (24 bits).\n\n"+
+						"Given Value: -1\n",
+				),
+			},
+		},
+		"invalid VNI - exceeds 24 bits": {
+			vni: routingtypes.NewVNIValue(16777216),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid VNI Integer Value",
+					"An integer value was provided that is not a valid VXLAN Network Identifier, which must be between 0 and 16777215 (24 bits).\n\n"+
+						"Given Value: 16777216\n",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.vni.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{Path: path.Root("test")},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestVNIValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		vni             routingtypes.VNI
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			vni: routingtypes.VNI{},
+		},
+		"null": {
+			vni: routingtypes.NewVNINull(),
+		},
+		"unknown": {
+			vni: routingtypes.NewVNIUnknown(),
+		},
+		"valid VNI": {
+			vni: routingtypes.NewVNIValue(10100),
+		},
+		"invalid VNI - exceeds 24 bits": {
+			vni: routingtypes.NewVNIValue(16777216),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid VNI Integer Value: "+
+					"An integer value was provided that is not a valid VXLAN Network Identifier, which must be between 0 and 16777215 (24 bits).\n\n"+
+					"Given Value: 16777216\n",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.vni.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestVNIValueVNI(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		vni           routingtypes.VNI
+		expectedVNI   uint32
+		expectedDiags diag.Diagnostics
+	}{
+		"VNI value is null": {
+			vni: routingtypes.NewVNINull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"VNI ValueVNI Error",
+					"VNI integer value is null",
+				),
+			},
+		},
+		"VNI value is unknown": {
+			vni: routingtypes.NewVNIUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"VNI ValueVNI Error",
+					"VNI integer value is unknown",
+				),
+			},
+		},
+		"VNI value is out of range": {
+			vni: routingtypes.NewVNIValue(16777216),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"VNI ValueVNI Error",
+					"VNI integer value 16777216 is out of range",
+				),
+			},
+		},
+		"valid VNI": {
+			vni:         routingtypes.NewVNIValue(10100),
+			expectedVNI: 10100,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			vni, diags := testCase.vni.ValueVNI()
+
+			if vni != testCase.expectedVNI {
+				t.Errorf("Unexpected difference in VNI, got: %d, expected: %d", vni, testCase.expectedVNI)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,5 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package snmptypes contains Terraform Plugin Framework Custom Type implementations for SNMP object identifier strings.
+package snmptypes
@@ -0,0 +1,85 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package snmptypes
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// maxSubIdentifiers is the maximum number of sub-identifiers in an SNMP object identifier (RFC 2578 Section 3.5).
+const maxSubIdentifiers = 128
+
+// ObjectIdentifier is a parsed SNMP object identifier, with one element per sub-identifier (arc).
+type ObjectIdentifier []uint32
+
+// String returns the object identifier in dotted decimal notation without a leading dot, such as `1.3.6.1.2.1`.
+func (o ObjectIdentifier) String() string {
+	arcs := make([]string, len(o))
+
+	for i, arc := range o {
+		arcs[i] = strconv.FormatUint(uint64(arc), 10)
+	}
+
+	return strings.Join(arcs, ".")
+}
+
+// Equal returns true if both object identifiers contain the same sub-identifiers.
+func (o ObjectIdentifier) Equal(other ObjectIdentifier) bool {
+	return slices.Equal(o, other)
+}
+
+// Contains returns true if other is equal to o or is located in the subtree rooted at o. For example, `1.3.6.1.2.1`
+// contains both `1.3.6.1.2.1` and `1.3.6.1.2.1.1.5.0`, but not `1.3.6.1.2.10`. This mirrors how SNMP view subtrees
+// (RFC 3415) include or exclude object identifiers, so view definitions can be checked offline.
+func (o ObjectIdentifier) Contains(other ObjectIdentifier) bool {
+	if len(other) < len(o) {
+		return false
+	}
+
+	return slices.Equal(o, other[:len(o)])
+}
+
+// parseOID parses an object identifier in dotted decimal notation, with an optional leading dot, and validates the
+// sub-identifier rules of ITU-T X.660 and RFC 2578.
+func parseOID(s string) (ObjectIdentifier, error) {
+	trimmed := strings.TrimPrefix(s, ".")
+
+	if trimmed == "" {
+		return nil, fmt.Errorf("OID %q: must contain at least one sub-identifier", s)
+	}
+
+	arcs := strings.Split(trimmed, ".")
+
+	if len(arcs) > maxSubIdentifiers {
+		return nil, fmt.Errorf("OID %q: must not contain more than %d sub-identifiers, got %d", s, maxSubIdentifiers, len(arcs))
+	}
+
+	oid := make(ObjectIdentifier, len(arcs))
+
+	for i, arc := range arcs {
+		if len(arc) > 1 && arc[0] == '0' {
+			return nil, fmt.Errorf("OID %q: sub-identifier %q must not contain leading zeroes", s, arc)
+		}
+
+		value, err := strconv.ParseUint(arc, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("OID %q: sub-identifier %q is not an unsigned 32-bit decimal integer", s, arc)
+		}
+
+		oid[i] = uint32(value)
+	}
+
+	if oid[0] > 2 {
+		return nil, fmt.Errorf("OID %q: first sub-identifier must be 0, 1 or 2, got %d", s, oid[0])
+	}
+
+	if len(oid) > 1 && oid[0] < 2 && oid[1] >= 40 {
+		return nil, fmt.Errorf("OID %q: second sub-identifier must be less than 40 when the first is 0 or 1, got %d", s, oid[1])
+	}
+
+	return oid, nil
+}
@@ -0,0 +1,65 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package snmptypes_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/snmptypes"
+)
+
+func TestObjectIdentifierContains(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		subtree  snmptypes.ObjectIdentifier
+		oid      snmptypes.ObjectIdentifier
+		expected bool
+	}{
+		"equal": {
+			subtree:  snmptypes.ObjectIdentifier{1, 3, 6, 1, 2, 1},
+			oid:      snmptypes.ObjectIdentifier{1, 3, 6, 1, 2, 1},
+			expected: true,
+		},
+		"descendant": {
+			subtree:  snmptypes.ObjectIdentifier{1, 3, 6, 1, 2, 1},
+			oid:      snmptypes.ObjectIdentifier{1, 3, 6, 1, 2, 1, 1, 5, 0},
+			expected: true,
+		},
+		"ancestor": {
+			subtree:  snmptypes.ObjectIdentifier{1, 3, 6, 1, 2, 1},
+			oid:      snmptypes.ObjectIdentifier{1, 3, 6, 1},
+			expected: false,
+		},
+		"sibling with shared string prefix": {
+			subtree:  snmptypes.ObjectIdentifier{1, 3, 6, 1, 2, 1},
+			oid:      snmptypes.ObjectIdentifier{1, 3, 6, 1, 2, 10},
+			expected: false,
+		},
+		"empty subtree": {
+			subtree:  snmptypes.ObjectIdentifier{},
+			oid:      snmptypes.ObjectIdentifier{1, 3},
+			expected: true,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.subtree.Contains(testCase.oid)
+			if got != testCase.expected {
+				t.Errorf("Expected %s.Contains(%s) to return: %t, but got: %t", testCase.subtree, testCase.oid, testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestObjectIdentifierString(t *testing.T) {
+	t.Parallel()
+
+	got := snmptypes.ObjectIdentifier{1, 3, 6, 1, 4, 1, 4294967295}.String()
+	if expected := "1.3.6.1.4.1.4294967295"; got != expected {
+		t.Errorf("Expected %q, got %q", expected, got)
+	}
+}
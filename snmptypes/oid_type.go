@@ -0,0 +1,76 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package snmptypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = (*OIDType)(nil)
+
+// OIDType is an attribute type that represents a valid SNMP object identifier (RFC 2578) in dotted decimal notation.
+// Semantic equality logic is defined for OIDType, so that identifiers written with or without a leading dot are
+// considered equal.
+//
+// Examples:
+//   - `.1.3.6.1.2.1` is semantically equal to `1.3.6.1.2.1`
+//   - `.1` is semantically equal to `1`
+type OIDType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t OIDType) String() string {
+	return "snmptypes.OIDType"
+}
+
+// ValueType returns the Value type.
+func (t OIDType) ValueType(ctx context.Context) attr.Value {
+	return OID{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t OIDType) Equal(o attr.Type) bool {
+	other, ok := o.(OIDType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t OIDType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return OID{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t OIDType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
@@ -0,0 +1,173 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package snmptypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*OID)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*OID)(nil)
+	_ xattr.ValidateableAttribute                = (*OID)(nil)
+	_ function.ValidateableParameter             = (*OID)(nil)
+)
+
+// OID represents a valid SNMP object identifier (RFC 2578) in dotted decimal notation, with an optional leading dot.
+// Semantic equality logic is defined for OID, so that identifiers written with or without a leading dot are
+// considered equal.
+//
+// Examples:
+//   - `.1.3.6.1.2.1` is semantically equal to `1.3.6.1.2.1`
+//   - `.1` is semantically equal to `1`
+type OID struct {
+	basetypes.StringValue
+}
+
+// Type returns an OIDType.
+func (v OID) Type(_ context.Context) attr.Type {
+	return OIDType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v OID) Equal(o attr.Value) bool {
+	other, ok := o.(OID)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given OID string value is semantically equal to the current OID string value.
+// Both values are parsed into their sub-identifiers before comparison, so a leading dot is optional.
+//
+// Examples:
+//   - `.1.3.6.1.2.1` is semantically equal to `1.3.6.1.2.1`
+//   - `.1` is semantically equal to `1`
+func (v OID) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(OID)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// OIDs are already validated at this point, ignoring errors
+	newOID, _ := parseOID(newValue.ValueString())
+	currentOID, _ := parseOID(v.ValueString())
+
+	return currentOID.Equal(newOID), diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String
+// value that is a valid object identifier: up to 128 unsigned 32-bit sub-identifiers without leading zeroes, where
+// the first is 0, 1 or 2 and the second is less than 40 when the first is 0 or 1.
+func (v OID) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseOID(v.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid OID String Value",
+			"A string value was provided that is not valid SNMP object identifier string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is a valid object identifier: up to 128 unsigned 32-bit sub-identifiers without
+// leading zeroes, where the first is 0, 1 or 2 and the second is less than 40 when the first is 0 or 1.
+func (v OID) ValidateParameter(_ context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	_, err := parseOID(v.ValueString())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid OID String Value: "+
+				"A string value was provided that is not valid SNMP object identifier string format.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ValueOID parses the OID StringValue into an ObjectIdentifier. A null or unknown value will produce an error diagnostic.
+func (v OID) ValueOID() (ObjectIdentifier, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("OID ValueOID Error", "OID string value is null"))
+		return nil, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("OID ValueOID Error", "OID string value is unknown"))
+		return nil, diags
+	}
+
+	oid, err := parseOID(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("OID ValueOID Error", err.Error()))
+		return nil, diags
+	}
+
+	return oid, nil
+}
+
+// NewOIDNull creates an OID with a null value. Determine whether the value is null via IsNull method.
+func NewOIDNull() OID {
+	return OID{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewOIDUnknown creates an OID with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewOIDUnknown() OID {
+	return OID{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewOIDValue creates an OID with a known value. Access the value via ValueString method.
+func NewOIDValue(value string) OID {
+	return OID{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewOIDPointerValue creates an OID with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewOIDPointerValue(value *string) OID {
+	return OID{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
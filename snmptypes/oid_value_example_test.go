@@ -0,0 +1,49 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package snmptypes_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/snmptypes"
+)
+
+type SNMPViewResourceModel struct {
+	Subtree  snmptypes.OID   `tfsdk:"subtree"`
+	Excluded []snmptypes.OID `tfsdk:"excluded"`
+}
+
+func ExampleOID_ValueOID() {
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := SNMPViewResourceModel{
+		Subtree: snmptypes.NewOIDValue(".1.3.6.1.2.1"),
+		Excluded: []snmptypes.OID{
+			snmptypes.NewOIDValue("1.3.6.1.2.1.1"),
+			snmptypes.NewOIDValue("1.3.6.1.4.1.9"),
+		},
+	}
+
+	// Check that the OID data is known and able to be converted to ObjectIdentifier
+	if !data.Subtree.IsNull() && !data.Subtree.IsUnknown() {
+		subtree, diags := data.Subtree.ValueOID()
+		if diags.HasError() {
+			return
+		}
+
+		// Check each excluded OID is within the included subtree
+		for _, excluded := range data.Excluded {
+			oid, diags := excluded.ValueOID()
+			if diags.HasError() {
+				return
+			}
+
+			fmt.Printf("%s: %t\n", oid, subtree.Contains(oid))
+		}
+
+		// Output:
+		// 1.3.6.1.2.1.1: true
+		// 1.3.6.1.4.1.9: false
+	}
+}
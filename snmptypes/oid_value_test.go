@@ -0,0 +1,320 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package snmptypes_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/snmptypes"
+)
+
+func TestOIDStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentOID    snmptypes.OID
+		givenOID      basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"semantically equal - byte-for-byte match": {
+			currentOID:    snmptypes.NewOIDValue("1.3.6.1.2.1"),
+			givenOID:      snmptypes.NewOIDValue("1.3.6.1.2.1"),
+			expectedMatch: true,
+		},
+		"semantically equal - leading dot": {
+			currentOID:    snmptypes.NewOIDValue("1.3.6.1.2.1"),
+			givenOID:      snmptypes.NewOIDValue(".1.3.6.1.2.1"),
+			expectedMatch: true,
+		},
+		"semantically equal - single sub-identifier": {
+			currentOID:    snmptypes.NewOIDValue(".1"),
+			givenOID:      snmptypes.NewOIDValue("1"),
+			expectedMatch: true,
+		},
+		"not equal - prefix": {
+			currentOID:    snmptypes.NewOIDValue("1.3.6.1.2.1"),
+			givenOID:      snmptypes.NewOIDValue("1.3.6.1.2"),
+			expectedMatch: false,
+		},
+		"not equal - sub-identifier mismatch": {
+			currentOID:    snmptypes.NewOIDValue("1.3.6.1.2.1"),
+			givenOID:      snmptypes.NewOIDValue("1.3.6.1.4.1"),
+			expectedMatch: false,
+		},
+		"error - not given OID value": {
+			currentOID:    snmptypes.NewOIDValue("1.3.6.1.2.1"),
+			givenOID:      basetypes.NewStringValue("1.3.6.1.2.1"),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: snmptypes.OID\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentOID.StringSemanticEquals(context.Background(), testCase.givenOID)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestOIDValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	tooLong := strings.Repeat("1.", 128) + "1"
+
+	testCases := map[string]struct {
+		oid           snmptypes.OID
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			oid: snmptypes.OID{},
+		},
+		"null": {
+			oid: snmptypes.NewOIDNull(),
+		},
+		"unknown": {
+			oid: snmptypes.NewOIDUnknown(),
+		},
+		"valid OID - mib-2": {
+			oid: snmptypes.NewOIDValue("1.3.6.1.2.1"),
+		},
+		"valid OID - leading dot": {
+			oid: snmptypes.NewOIDValue(".1.3.6.1.4.1.9.9.13"),
+		},
+		"valid OID - joint-iso-itu-t with large second arc": {
+			oid: snmptypes.NewOIDValue("2.999.1"),
+		},
+		"valid OID - maximum sub-identifier": {
+			oid: snmptypes.NewOIDValue("1.3.6.1.4.1.4294967295"),
+		},
+		"invalid OID - empty": {
+			oid: snmptypes.NewOIDValue("."),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid OID String Value",
+					"A string value was provided that is not valid SNMP object identifier string format.\n\n"+
+						"Given Value: .\n"+
+						"Error: OID \".\": must contain at least one sub-identifier",
+				),
+			},
+		},
+		"invalid OID - first sub-identifier": {
+			oid: snmptypes.NewOIDValue("3.6.1"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid OID String Value",
+					"A string value was provided that is not valid SNMP object identifier string format.\n\n"+
+						"Given Value: 3.6.1\n"+
+						"Error: OID \"3.6.1\": first sub-identifier must be 0, 1 or 2, got 3",
+				),
+			},
+		},
+		"invalid OID - second sub-identifier": {
+			oid: snmptypes.NewOIDValue("1.40.1"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid OID String Value",
+					"A string value was provided that is not valid SNMP object identifier string format.\n\n"+
+						"Given Value: 1.40.1\n"+
+						"Error: OID \"1.40.1\": second sub-identifier must be less than 40 when the first is 0 or 1, got 40",
+				),
+			},
+		},
+		"invalid OID - leading zeroes": {
+			oid: snmptypes.NewOIDValue("1.3.06.1"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid OID String Value",
+					"A string value was provided that is not valid SNMP object identifier string format.\n\n"+
+						"Given Value: 1.3.06.1\n"+
+						"Error: OID \"1.3.06.1\": sub-identifier \"06\" must not contain leading zeroes",
+				),
+			},
+		},
+		"invalid OID - empty sub-identifier": {
+			oid: snmptypes.NewOIDValue("1.3..6"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid OID String Value",
+					"A string value was provided that is not valid SNMP object identifier string format.\n\n"+
+						"Given Value: 1.3..6\n"+
+						"Error: OID \"1.3..6\": sub-identifier \"\" is not an unsigned 32-bit decimal integer",
+				),
+			},
+		},
+		"invalid OID - sub-identifier exceeds 32 bits": {
+			oid: snmptypes.NewOIDValue("1.3.6.1.4.1.4294967296"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid OID String Value",
+					"A string value was provided that is not valid SNMP object identifier string format.\n\n"+
+						"Given Value: 1.3.6.1.4.1.4294967296\n"+
+						"Error: OID \"1.3.6.1.4.1.4294967296\": sub-identifier \"4294967296\" is not an unsigned 32-bit decimal integer",
+				),
+			},
+		},
+		"invalid OID - too many sub-identifiers": {
+			oid: snmptypes.NewOIDValue(tooLong),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid OID String Value",
+					"A string value was provided that is not valid SNMP object identifier string format.\n\n"+
+						"Given Value: "+tooLong+"\n"+
+						"Error: OID \""+tooLong+"\": must not contain more than 128 sub-identifiers, got 129",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.oid.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{Path: path.Root("test")},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestOIDValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		oid             snmptypes.OID
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			oid: snmptypes.OID{},
+		},
+		"null": {
+			oid: snmptypes.NewOIDNull(),
+		},
+		"unknown": {
+			oid: snmptypes.NewOIDUnknown(),
+		},
+		"valid OID": {
+			oid: snmptypes.NewOIDValue(".1.3.6.1.2.1"),
+		},
+		"invalid OID - not numeric": {
+			oid: snmptypes.NewOIDValue("iso.3.6.1"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid OID String Value: "+
+					"A string value was provided that is not valid SNMP object identifier string format.\n\n"+
+					"Given Value: iso.3.6.1\n"+
+					"Error: OID \"iso.3.6.1\": sub-identifier \"iso\" is not an unsigned 32-bit decimal integer",
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.oid.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestOIDValueOID(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		oid           snmptypes.OID
+		expectedOID   snmptypes.ObjectIdentifier
+		expectedDiags diag.Diagnostics
+	}{
+		"OID value is null": {
+			oid: snmptypes.NewOIDNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"OID ValueOID Error",
+					"OID string value is null",
+				),
+			},
+		},
+		"OID value is unknown": {
+			oid: snmptypes.NewOIDUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"OID ValueOID Error",
+					"OID string value is unknown",
+				),
+			},
+		},
+		"valid OID": {
+			oid:         snmptypes.NewOIDValue(".1.3.6.1.2.1"),
+			expectedOID: snmptypes.ObjectIdentifier{1, 3, 6, 1, 2, 1},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			oid, diags := testCase.oid.ValueOID()
+
+			if !oid.Equal(testCase.expectedOID) {
+				t.Errorf("Unexpected difference in ObjectIdentifier, got: %s, expected: %s", oid, testCase.expectedOID)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}